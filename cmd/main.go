@@ -30,11 +30,14 @@ func main() {
 
 	router := gin.New()
 
-	close := di.MustConfigureApp(router, config, log)
+	closeStreams, close := di.MustConfigureApp(router, config, log)
 	defer close()
 
 	server := listenRESTServer(router, log, config.RestConfig.Port)
 
+	// streams never end by themselves, so they are closed before shutdown waits for active requests
+	server.RegisterOnShutdown(closeStreams)
+
 	GracefullShutdown(server, log)
 }
 
@@ -34,13 +34,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/pullRequest/create": {
+        "/integrations/github/webhook": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
+                "description": "События ` + "`" + `pull_request` + "`" + ` с действиями opened, ready_for_review, reopened и closed переносятся на PR\nс идентификатором ` + "`" + `gh-\u003cpull_request.id\u003e` + "`" + `. Логины GitHub сопоставляются пользователям по ` + "`" + `integrations.github.users` + "`" + `.\nЗапрос подписывается секретом вебхука в заголовке X-Hub-Signature-256, остальные события игнорируются.",
                 "consumes": [
                     "application/json"
                 ],
@@ -48,41 +44,73 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PullRequests"
+                    "Integrations"
                 ],
-                "summary": "Создать PR и автоматически назначить до 2 ревьюверов из команды авторы",
+                "summary": "Принять webhook GitHub о pull request",
                 "parameters": [
                     {
-                        "description": "Данные для создания",
+                        "type": "string",
+                        "description": "Тип события",
+                        "name": "X-GitHub-Event",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Подпись тела запроса",
+                        "name": "X-Hub-Signature-256",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор доставки, повторная доставка игнорируется",
+                        "name": "X-GitHub-Delivery",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Тело события GitHub",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/docs.CreatePRRequest"
+                            "type": "object"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "PR создан",
+                    "200": {
+                        "description": "Событие применено или проигнорировано",
                         "schema": {
-                            "$ref": "#/definitions/docs.CreatePRResponse"
+                            "$ref": "#/definitions/docs.IngestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректное тело",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Нет/неверный админский токен",
+                        "description": "Неверная подпись",
                         "schema": {
                             "$ref": "#/definitions/docs.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Автор/команда не найдены",
+                        "description": "PR или автор не найден",
                         "schema": {
                             "$ref": "#/definitions/docs.ErrorResponse"
                         }
                     },
                     "409": {
-                        "description": "PR уже существует",
+                        "description": "Переход статуса запрещен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Логин не сопоставлен пользователю",
                         "schema": {
                             "$ref": "#/definitions/docs.ErrorResponse"
                         }
@@ -90,13 +118,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/pullRequest/merge": {
+        "/integrations/gitlab/webhook": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
+                "description": "События ` + "`" + `Merge Request Hook` + "`" + ` с действиями open, reopen, close, merge и update (снятие draft) переносятся на PR\nс идентификатором ` + "`" + `gl-\u003cobject_attributes.id\u003e` + "`" + `. Логины GitLab сопоставляются пользователям по ` + "`" + `integrations.gitlab.users` + "`" + `,\nавтором считается пользователь, открывший MR. Запрос подтверждается секретным токеном в заголовке X-Gitlab-Token,\nповторная доставка с тем же Idempotency-Key игнорируется, остальные события игнорируются.",
                 "consumes": [
                     "application/json"
                 ],
@@ -104,35 +128,73 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PullRequests"
+                    "Integrations"
                 ],
-                "summary": "Пометить PR как MERGED (идемпотентная операция)",
+                "summary": "Принять webhook GitLab о merge request",
                 "parameters": [
                     {
-                        "description": "Идентификатор PR",
+                        "type": "string",
+                        "description": "Тип события",
+                        "name": "X-Gitlab-Event",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Секретный токен вебхука",
+                        "name": "X-Gitlab-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор доставки, повторная доставка игнорируется",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Тело события GitLab",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/docs.MergePRRequest"
+                            "type": "object"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "PR в состоянии MERGED",
+                        "description": "Событие применено или проигнорировано",
                         "schema": {
-                            "$ref": "#/definitions/docs.MergePRResponse"
+                            "$ref": "#/definitions/docs.IngestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректное тело",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Нет/неверный админский токен",
+                        "description": "Неверный токен",
                         "schema": {
                             "$ref": "#/definitions/docs.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "PR не найден",
+                        "description": "PR или автор не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Переход статуса запрещен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Логин не сопоставлен пользователю",
                         "schema": {
                             "$ref": "#/definitions/docs.ErrorResponse"
                         }
@@ -140,13 +202,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/pullRequest/reassign": {
+        "/pools/add": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
                 "consumes": [
                     "application/json"
                 ],
@@ -154,29 +211,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PullRequests"
+                    "Pools"
                 ],
-                "summary": "Переназначить конкретного ревьювера на другого из его команды",
+                "summary": "Создать пул ревьюеров или заменить его участников",
+                "description": "Участники пула могут состоять в разных командах.",
                 "parameters": [
                     {
-                        "description": "Данные для переназначения",
+                        "description": "Имя пула и идентификаторы участников",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/docs.ReassignRequest"
+                            "$ref": "#/definitions/docs.AddPoolRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Переназначение выполнено",
+                        "description": "Пул сохранен",
                         "schema": {
-                            "$ref": "#/definitions/docs.ReassignResponse"
+                            "$ref": "#/definitions/docs.PoolResponse"
                         }
                     },
                     "400": {
-                        "description": "Не достаточно активных членов для переназначения",
+                        "description": "Некорректное имя пула",
                         "schema": {
                             "$ref": "#/definitions/docs.ErrorResponse"
                         }
@@ -188,56 +246,77 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "PR или пользователь найден",
-                        "schema": {
-                            "$ref": "#/definitions/docs.ErrorResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Нарушение доменных правил переназначения",
+                        "description": "Пользователь не найден",
                         "schema": {
                             "$ref": "#/definitions/docs.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/stats/assignmentsPerMember": {
-            "get": {
+        "/pools/attachTeam": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Stats"
+                    "Pools"
                 ],
-                "summary": "Получить статистику назначений пользователей ревьюверами",
+                "summary": "Подключить пул ревьюеров к команде",
+                "description": "На каждый PR команды из пула назначается reviewers_count ревьюеров сверх ревьюеров команды.\nПовторный вызов изменяет число ревьюеров.",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Количество записей в результате",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Отступ в статистике",
-                        "name": "offset",
-                        "in": "query",
-                        "required": true
+                        "description": "Пул, команда и число ревьюеров из пула",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.AttachTeamRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Статистика по назначениям",
+                        "description": "Пул с подключенными командами",
                         "schema": {
-                            "$ref": "#/definitions/docs.AssignmentsStats"
+                            "$ref": "#/definitions/docs.PoolResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Число ревьюеров не положительное",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пул или команда не найдены",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/team/add": {
+        "/pools/delete": {
             "post": {
                 "consumes": [
                     "application/json"
@@ -246,49 +325,50 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Teams"
+                    "Pools"
                 ],
-                "summary": "Создать команду с участниками (создает/обновляет пользователей)",
+                "summary": "Удалить пул ревьюеров",
+                "description": "Команды перестают получать ревьюеров из пула, уже назначенные ревьюеры сохраняются.",
                 "parameters": [
                     {
-                        "description": "Данные для создания/обновления",
+                        "description": "Имя пула",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/docs.AddTeamRequest"
+                            "$ref": "#/definitions/docs.DeletePoolRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Команда создана",
+                    "200": {
+                        "description": "Пул удален",
                         "schema": {
-                            "$ref": "#/definitions/docs.AddTeamResponse"
+                            "$ref": "#/definitions/docs.DeletePoolResponse"
                         }
                     },
-                    "400": {
-                        "description": "Команда уже существует",
+                    "401": {
+                        "description": "Нет/неверный админский токен",
                         "schema": {
                             "$ref": "#/definitions/docs.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Пользователь является членом другой команды",
+                    "404": {
+                        "description": "Пул не найден",
                         "schema": {
                             "$ref": "#/definitions/docs.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/team/deactivateAll": {
-            "post": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
+                ]
+            }
+        },
+        "/pools/detachTeam": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -296,25 +376,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Teams"
+                    "Pools"
                 ],
-                "summary": "Сделать всех участников в команде неактивными",
+                "summary": "Отключить пул ревьюеров от команды",
+                "description": "Уже назначенные из пула ревьюеры сохраняются.",
                 "parameters": [
                     {
-                        "description": "Имя команды",
+                        "description": "Пул и команда",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/docs.DeactivateAllRequest"
+                            "$ref": "#/definitions/docs.DetachTeamRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Участникам установлен статус 'не активен'",
+                        "description": "Пул с подключенными командами",
                         "schema": {
-                            "$ref": "#/definitions/docs.DeactivateAllResponse"
+                            "$ref": "#/definitions/docs.PoolResponse"
                         }
                     },
                     "401": {
@@ -324,42 +405,48 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Команда не найдена",
+                        "description": "Пул не найден или команда к нему не подключена",
                         "schema": {
                             "$ref": "#/definitions/docs.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/team/get": {
-            "get": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
+                ]
+            }
+        },
+        "/pools/get": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Teams"
+                    "Pools"
                 ],
-                "summary": "Получить команду с участниками",
+                "summary": "Получить пул ревьюеров с участниками и командами",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Уникальное имя команды",
-                        "name": "team_name",
+                        "description": "Уникальное имя пула",
+                        "name": "pool_name",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Объект команды",
+                        "description": "Объект пула",
                         "schema": {
-                            "$ref": "#/definitions/docs.GetTeamResponse"
+                            "$ref": "#/definitions/docs.PoolResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Не указано имя пула",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
                         }
                     },
                     "401": {
@@ -369,42 +456,33 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Команда не найдена",
+                        "description": "Пул не найден",
                         "schema": {
                             "$ref": "#/definitions/docs.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/users/getReview": {
-            "get": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
+                ]
+            }
+        },
+        "/pools/list": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
-                ],
-                "summary": "Получить PR'ы, где пользователь установлен ревьювером",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Идентификатор пользователя",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Pools"
                 ],
+                "summary": "Получить все пулы ревьюеров",
                 "responses": {
                     "200": {
-                        "description": "Список PR'ов пользователя",
+                        "description": "Пулы в порядке имен",
                         "schema": {
-                            "$ref": "#/definitions/docs.GetReviewResponse"
+                            "$ref": "#/definitions/docs.ListPoolsResponse"
                         }
                     },
                     "401": {
@@ -413,16 +491,16 @@ const docTemplate = `{
                             "$ref": "#/definitions/docs.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/users/setIsActive": {
-            "post": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
+                ]
+            }
+        },
+        "/pullRequest/close": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -430,25 +508,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "PullRequests"
                 ],
-                "summary": "Установить флаг активности пользователя",
+                "summary": "Закрыть PR без мерджа (идемпотентная операция)",
                 "parameters": [
                     {
-                        "description": "Данные для обновления",
+                        "description": "Идентификатор PR",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/docs.SetIsActiveRequest"
+                            "$ref": "#/definitions/docs.ChangePRStatusRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Обновленный пользователь",
+                        "description": "PR в состоянии CLOSED",
                         "schema": {
-                            "$ref": "#/definitions/docs.SetIsActiveResponse"
+                            "$ref": "#/definitions/docs.ChangePRStatusResponse"
                         }
                     },
                     "401": {
@@ -458,315 +536,4216 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Пользователь не найден",
+                        "description": "PR или его команда не найдены",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "PR уже смерджен",
                         "schema": {
                             "$ref": "#/definitions/docs.ErrorResponse"
                         }
-                    }
-                }
-            }
-        }
-    },
-    "definitions": {
-        "docs.AddTeamRequest": {
-            "type": "object",
-            "properties": {
-                "members": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/docs.TeamMember"
                     }
                 },
-                "team_name": {
-                    "type": "string"
-                }
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/pullRequest/create": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Создать PR и автоматически назначить до 2 ревьюверов из команды автора, владельцы измененных файлов по CODEOWNERS и участники с навыками под метки PR назначаются в первую очередь (черновик создается без ревьюверов)",
+                "parameters": [
+                    {
+                        "description": "Данные для создания",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.CreatePRRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "PR создан",
+                        "schema": {
+                            "$ref": "#/definitions/docs.CreatePRResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные метки",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Автор/команда не найдены или автор не состоит в указанной команде",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "PR уже существует, команда в архиве или не хватает ревьюверов со свободным лимитом",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/pullRequest/get": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Получить PR по идентификатору",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор PR",
+                        "name": "pull_request_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PR",
+                        "schema": {
+                            "$ref": "#/definitions/docs.GetPRResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "PR не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/pullRequest/list": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Получить список PR с фильтрами и курсорной пагинацией",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Автор PR",
+                        "name": "author_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Команда автора PR",
+                        "name": "team_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Статус PR: DRAFT, OPEN, MERGED или CLOSED",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Назначенный ревьювер",
+                        "name": "reviewer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Создан не раньше (RFC3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Создан раньше (RFC3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Поле сортировки: created_at (по умолчанию) или name",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Порядок сортировки: asc (по умолчанию) или desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию максимальный",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из предыдущего ответа",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница PR",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ListPRResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры запроса или курсор",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/pullRequest/merge": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Пометить PR как MERGED (идемпотентная операция)",
+                "parameters": [
+                    {
+                        "description": "Идентификатор PR и пользователь, который мерджит",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.MergePRRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PR в состоянии MERGED",
+                        "schema": {
+                            "$ref": "#/definitions/docs.MergePRResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "PR или его команда не найдены",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "PR не в состоянии OPEN или не выполнены условия политики мерджа",
+                        "schema": {
+                            "$ref": "#/definitions/docs.MergePolicyErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/pullRequest/ready": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Перевести черновик PR в состояние OPEN и назначить ревьюверов",
+                "parameters": [
+                    {
+                        "description": "Идентификатор PR",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ChangePRStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PR в состоянии OPEN",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ChangePRStatusResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "PR или его команда не найдены",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "PR не в состоянии DRAFT или не хватает ревьюверов со свободным лимитом",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/pullRequest/reassign": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Переназначить конкретного ревьювера на другого из его команды",
+                "parameters": [
+                    {
+                        "description": "Данные для переназначения",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ReassignRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Переназначение выполнено",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ReassignResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Не достаточно активных членов для переназначения",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "PR или пользователь найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Нарушение доменных правил переназначения (PR смерджен или закрыт)",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/pullRequest/reopen": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Переоткрыть закрытый PR, недостающие ревьюверы назначаются заново",
+                "parameters": [
+                    {
+                        "description": "Идентификатор PR",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ChangePRStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PR в состоянии OPEN",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ChangePRStatusResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "PR или его команда не найдены",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "PR не в состоянии CLOSED или не хватает ревьюверов со свободным лимитом",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/pullRequest/review": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Оставить вердикт ревьювера по PR (повторный вердикт заменяет предыдущий)",
+                "parameters": [
+                    {
+                        "description": "PR, ревьювер и вердикт",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ReviewPRRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Вердикт сохранен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ReviewPRResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неизвестный вердикт",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "PR не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Пользователь не назначен ревьювером или PR смерджен/закрыт",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/scim/v2/Groups": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "SCIM: список групп",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Фильтр вида displayName eq \"value\"",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер первого группы, начиная с 1",
+                        "name": "startIndex",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, не больше 100",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница групп",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimGroupListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неподдерживаемый фильтр или неверная страница",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "SCIM: создать группу",
+                "description": "Группа соответствует команде, участники должны быть созданы заранее.",
+                "parameters": [
+                    {
+                        "description": "Группа SCIM",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimGroup"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Группа создана",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimGroup"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректное имя или неизвестные участники",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Группа уже существует",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/scim/v2/Groups/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "SCIM: получить группу",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Id группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Группа",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimGroup"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Группа не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "SCIM: заменить группу",
+                "description": "Группа переименовывается и ее состав заменяется так же, как в /team/add.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Id группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Группа SCIM",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimGroup"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Группа после изменения",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimGroup"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректное имя или неизвестные участники",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Группа не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Группа с таким именем уже существует",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "SCIM: удалить группу",
+                "description": "Команда с OPEN или DRAFT PR не удаляется, завершенные PR сохраняются без команды.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Id группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Группа удалена"
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Группа не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "У команды есть незавершенные PR",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "patch": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "SCIM: изменить группу",
+                "description": "Поддерживаются изменение displayName и добавление, удаление и замена members.\nУдаление участника снимает его с ревью OPEN PR команды, как /team/members/remove.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Id группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Операции PATCH",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimPatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Группа после изменения",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimGroup"
+                        }
+                    },
+                    "400": {
+                        "description": "Неподдерживаемая операция или неизвестные участники",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Группа не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Группа с таким именем уже существует",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/scim/v2/Users": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "SCIM: список пользователей",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Фильтр вида userName eq \"value\"",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер первого пользователя, начиная с 1",
+                        "name": "startIndex",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, не больше 100",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница пользователей",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimUserListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неподдерживаемый фильтр или неверная страница",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "SCIM: создать пользователя",
+                "description": "externalId становится id пользователя, без него id генерируется. Пользователь создается без команды.",
+                "parameters": [
+                    {
+                        "description": "Пользователь SCIM",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Пользователь создан",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimUser"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный пользователь",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Пользователь с таким id уже существует",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/scim/v2/Users/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "SCIM: получить пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Id пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Пользователь",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimUser"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "SCIM: заменить пользователя",
+                "description": "Пропущенный active считается true. Деактивация выполняется так же, как /users/setIsActive.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Id пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Пользователь SCIM",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Пользователь после изменения",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimUser"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный пользователь",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "SCIM: удалить пользователя",
+                "description": "Пользователь деактивируется, а не удаляется, так как на него ссылаются PR.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Id пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Пользователь деактивирован"
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "patch": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "SCIM: изменить пользователя",
+                "description": "Поддерживаются атрибуты active и userName, остальные атрибуты игнорируются.\nДеактивация выполняется так же, как /users/setIsActive.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Id пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Операции PATCH",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimPatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Пользователь после изменения",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimUser"
+                        }
+                    },
+                    "400": {
+                        "description": "Неподдерживаемая операция",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ScimErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/stats/assignmentsPerMember": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Получить статистику назначений пользователей ревьюверами",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию максимальный",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из предыдущего ответа",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Только участники команды и назначения на PR этой команды",
+                        "name": "team_name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Статистика по назначениям, упорядоченная по убыванию числа назначений",
+                        "schema": {
+                            "$ref": "#/definitions/docs.AssignmentsStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры запроса или курсор",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Удалить команду",
+                "description": "Удаление отклоняется, пока у команды есть OPEN или DRAFT PR. Режим force=CLOSE закрывает их,\nforce=REASSIGN переносит их в команду target_team_name, ревьюверы не из нее заменяются ее участниками.\nЗавершенные PR сохраняются без команды.",
+                "parameters": [
+                    {
+                        "description": "Имя команды и режим обработки незавершенных PR",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.DeleteTeamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Команда удалена, закрытые и перенесенные PR, замены ревьюверов",
+                        "schema": {
+                            "$ref": "#/definitions/docs.DeleteTeamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный режим или целевая команда",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда или целевая команда не найдены",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "У команды есть незавершенные PR или целевая команда в архиве",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/team/add": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Создать команду с участниками (создает/обновляет пользователей)",
+                "description": "Ответ содержит изменения состава: добавленных, удаленных участников, добавленных участников других команд\nи снятые назначения на OPEN PR. С dry_run=true изменения только вычисляются и не сохраняются,\nдля совпадающего состава возвращается пустой diff.",
+                "parameters": [
+                    {
+                        "description": "Данные для создания/обновления",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.AddTeamRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Только вычислить изменения состава, ничего не сохраняя",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Изменения, которые будут внесены (dry_run)",
+                        "schema": {
+                            "$ref": "#/definitions/docs.AddTeamResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Команда создана",
+                        "schema": {
+                            "$ref": "#/definitions/docs.AddTeamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Команда уже существует или неверный dry_run",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/archive": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Архивировать команду или вернуть ее из архива",
+                "description": "В архивной команде нельзя создавать PR, участники и история PR сохраняются.",
+                "parameters": [
+                    {
+                        "description": "Имя команды и признак архива",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ArchiveTeamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Состояние команды",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ArchiveTeamResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/team/codeowners": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Получить правила CODEOWNERS, по которым выбираются ревьюверы команды",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Уникальное имя команды",
+                        "name": "team_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Правила и их источник: api, config или none",
+                        "schema": {
+                            "$ref": "#/definitions/docs.CodeownersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Загрузить правила CODEOWNERS команды (заменяют правила из конфига)",
+                "parameters": [
+                    {
+                        "description": "Имя команды и текст CODEOWNERS",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.SetCodeownersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Сохраненные правила",
+                        "schema": {
+                            "$ref": "#/definitions/docs.CodeownersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат правил",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/team/deactivateAll": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Сделать всех участников в команде, кроме перечисленных, неактивными",
+                "parameters": [
+                    {
+                        "description": "Имя команды и участники, которые остаются активными",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.DeactivateAllRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Отчет: число деактивированных, переназначения и PR с нехваткой ревьюверов",
+                        "schema": {
+                            "$ref": "#/definitions/docs.DeactivateAllResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Превышен бюджет времени, изменения отменены",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/team/get": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Получить команду с участниками",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Уникальное имя команды",
+                        "name": "team_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Объект команды",
+                        "schema": {
+                            "$ref": "#/definitions/docs.GetTeamResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/team/members/add": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Добавить участников в команду (создает неизвестных пользователей)",
+                "parameters": [
+                    {
+                        "description": "Имя команды и добавляемые участники",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.AddTeamMembersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Команда после изменения",
+                        "schema": {
+                            "$ref": "#/definitions/docs.TeamMembersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Пустой список участников",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/team/members/move": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Перевести участников в другую команду",
+                "description": "Участники удаляются из команды так же, как в /team/members/remove, и добавляются в target_team_name.\nДля тех, у кого исходная команда была основной, основной становится целевая.",
+                "parameters": [
+                    {
+                        "description": "Исходная и целевая команды, id переводимых участников",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.MoveTeamMembersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Обе команды после изменения",
+                        "schema": {
+                            "$ref": "#/definitions/docs.MoveTeamMembersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Пустой список участников или неверная целевая команда",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена или пользователь не состоит в исходной команде",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/team/members/remove": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Удалить участников из команды",
+                "description": "Назначения удаляемых участников ревьюверами OPEN PR команды снимаются.",
+                "parameters": [
+                    {
+                        "description": "Имя команды и id удаляемых участников",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.RemoveTeamMembersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Команда после изменения",
+                        "schema": {
+                            "$ref": "#/definitions/docs.TeamMembersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Пустой список участников",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена или пользователь не состоит в ней",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/team/rename": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Переименовать команду",
+                "parameters": [
+                    {
+                        "description": "Текущее и новое имя команды",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.RenameTeamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Команда переименована",
+                        "schema": {
+                            "$ref": "#/definitions/docs.RenameTeamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректное новое имя",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Команда с новым именем уже существует",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/addSkills": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Добавить навыки пользователю",
+                "parameters": [
+                    {
+                        "description": "Добавляемые навыки",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.MemberSkillsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Навыки пользователя после изменения",
+                        "schema": {
+                            "$ref": "#/definitions/docs.MemberSkillsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные навыки",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/addUnavailability": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Добавить период недоступности пользователя (отпуск, больничный, дежурство)",
+                "parameters": [
+                    {
+                        "description": "Период недоступности",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.AddUnavailabilityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Созданный период",
+                        "schema": {
+                            "$ref": "#/definitions/docs.UnavailabilityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный период",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/deleteSkills": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Удалить навыки пользователя",
+                "parameters": [
+                    {
+                        "description": "Удаляемые навыки",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.MemberSkillsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Навыки пользователя после изменения",
+                        "schema": {
+                            "$ref": "#/definitions/docs.MemberSkillsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные навыки",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/deleteUnavailability": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Удалить период недоступности пользователя",
+                "parameters": [
+                    {
+                        "description": "Идентификатор периода",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.DeleteUnavailabilityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Период удален",
+                        "schema": {
+                            "$ref": "#/definitions/docs.DeleteUnavailabilityResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Период не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/getReview": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Получить PR'ы, где пользователь установлен ревьювером",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Статус PR: OPEN (по умолчанию), DRAFT, MERGED, CLOSED или ALL для всех статусов",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Порядок по времени создания: asc (по умолчанию, сначала старые) или desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию максимальный",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из предыдущего ответа",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница PR'ов пользователя в порядке создания",
+                        "schema": {
+                            "$ref": "#/definitions/docs.GetReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры запроса или курсор",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/getSkills": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Получить навыки пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Навыки пользователя",
+                        "schema": {
+                            "$ref": "#/definitions/docs.MemberSkillsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/getUnavailability": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Получить периоды недоступности пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Периоды недоступности, упорядоченные по началу",
+                        "schema": {
+                            "$ref": "#/definitions/docs.GetUnavailabilityResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/reviewStream": {
+            "get": {
+                "description": "Открывает поток Server-Sent Events. Событие ` + "`" + `assigned` + "`" + ` отправляется при назначении пользователя ревьювером,\n` + "`" + `unassigned` + "`" + ` - при снятии с PR, ` + "`" + `merged` + "`" + ` - при мердже PR, где пользователь ревьювер.\nПоле ` + "`" + `id` + "`" + ` события можно передать в заголовке Last-Event-ID при переподключении, чтобы получить пропущенные события.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Подписаться на изменения очереди ревью пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор последнего полученного события",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Поток событий, в data передается объект",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ReviewEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Не указан пользователь или неверный Last-Event-ID",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/setIsActive": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Установить флаг активности пользователя",
+                "parameters": [
+                    {
+                        "description": "Данные для обновления",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.SetIsActiveRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Обновленный пользователь и результат переназначения его открытых ревью",
+                        "schema": {
+                            "$ref": "#/definitions/docs.SetIsActiveResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/setMaxOpenReviews": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Установить лимит открытых ревью для пользователя",
+                "parameters": [
+                    {
+                        "description": "Данные для обновления (null снимает лимит)",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.SetMaxOpenReviewsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Обновленный пользователь",
+                        "schema": {
+                            "$ref": "#/definitions/docs.SetMaxOpenReviewsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный лимит",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/setSkills": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Заменить навыки пользователя, ревьюверы с навыками под метки PR назначаются в первую очередь",
+                "parameters": [
+                    {
+                        "description": "Новый набор навыков",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.MemberSkillsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Навыки пользователя после изменения",
+                        "schema": {
+                            "$ref": "#/definitions/docs.MemberSkillsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные навыки",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/updateUnavailability": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Изменить период недоступности пользователя",
+                "parameters": [
+                    {
+                        "description": "Новые данные периода",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.UpdateUnavailabilityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Обновленный период",
+                        "schema": {
+                            "$ref": "#/definitions/docs.UnavailabilityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный период",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Период не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/webhooks/delete": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Удалить вебхук вместе с его доставками",
+                "parameters": [
+                    {
+                        "description": "Идентификатор вебхука",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.DeleteWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Вебхук удален",
+                        "schema": {
+                            "$ref": "#/definitions/docs.DeleteWebhookResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Вебхук не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/webhooks/deliveries": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Получить доставки событий вебхуку",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор вебхука",
+                        "name": "webhook_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "PENDING, DELIVERED или DEAD, по умолчанию DEAD",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Последние доставки с указанным статусом",
+                        "schema": {
+                            "$ref": "#/definitions/docs.GetDeliveriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный статус",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Вебхук не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/webhooks/list": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Получить зарегистрированные вебхуки",
+                "responses": {
+                    "200": {
+                        "description": "Вебхуки без секретов",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ListWebhooksResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/webhooks/register": {
+            "post": {
+                "description": "Тело запроса доставки подписывается HMAC-SHA256 от \"\u003cX-Webhook-Timestamp\u003e.\u003cтело\u003e\",\nподпись передается в заголовке X-Webhook-Signature в виде sha256=\u003chex\u003e.\nДоставка выполняется не менее одного раза, получатель должен отбрасывать повторы по X-Webhook-Event-Id.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Зарегистрировать вебхук для доставки событий",
+                "parameters": [
+                    {
+                        "description": "Адрес, секрет и типы событий",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.RegisterWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Зарегистрированный вебхук и его секрет",
+                        "schema": {
+                            "$ref": "#/definitions/docs.RegisterWebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный адрес или тип события",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/webhooks/replay": {
+            "post": {
+                "description": "Подходящие события ставятся в очередь заново, попытки доставки обнуляются.\nНужно указать хотя бы один критерий: event_ids, since или dead_only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Повторно доставить события вебхуку",
+                "parameters": [
+                    {
+                        "description": "Вебхук и критерии отбора событий",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ReplayRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Число поставленных в очередь доставок",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ReplayResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Не указан ни один критерий",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет/неверный админский токен",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Вебхук не найден",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        }
+    },
+    "definitions": {
+        "docs.AddPoolRequest": {
+            "type": "object",
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pool_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.AddTeamMembersRequest": {
+            "type": "object",
+            "properties": {
+                "members": {
+                    "description": "unknown users are created, current members are kept",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.TeamMember"
+                    }
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.AddTeamRequest": {
+            "type": "object",
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.TeamMember"
+                    }
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.AddTeamResponse": {
+            "type": "object",
+            "properties": {
+                "diff": {
+                    "$ref": "#/definitions/docs.TeamDiffResponse"
+                },
+                "dry_run": {
+                    "description": "set when team was not changed and diff is only planned",
+                    "type": "boolean"
+                },
+                "team": {
+                    "$ref": "#/definitions/docs.AddTeamResponseObject"
+                }
+            }
+        },
+        "docs.AddTeamResponseObject": {
+            "type": "object",
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.TeamMember"
+                    }
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.AddUnavailabilityRequest": {
+            "type": "object",
+            "properties": {
+                "ends_at": {
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "one of: VACATION, SICK_LEAVE, ON_CALL",
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.ArchiveTeamRequest": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "description": "false returns team from archive, team is archived when omitted",
+                    "type": "boolean"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.ArchiveTeamResponse": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "type": "boolean"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.AssignmentsPerMember": {
+            "type": "object",
+            "properties": {
+                "assignments_count": {
+                    "type": "integer"
+                },
+                "member_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.AssignmentsStats": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "description": "empty on the last page",
+                    "type": "string"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.AssignmentsPerMember"
+                    }
+                }
+            }
+        },
+        "docs.AttachTeamRequest": {
+            "type": "object",
+            "properties": {
+                "pool_name": {
+                    "type": "string"
+                },
+                "reviewers_count": {
+                    "type": "integer"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.ChangePRStatusRequest": {
+            "type": "object",
+            "properties": {
+                "pull_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.ChangePRStatusResponse": {
+            "type": "object",
+            "properties": {
+                "pr": {
+                    "$ref": "#/definitions/docs.PRResponseObject"
+                }
+            }
+        },
+        "docs.CodeownersResponse": {
+            "type": "object",
+            "properties": {
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.CodeownersRuleResponse"
+                    }
+                },
+                "source": {
+                    "description": "api, config or none",
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.CodeownersRuleResponse": {
+            "type": "object",
+            "properties": {
+                "owners": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pattern": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.CreatePRRequest": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "changed_files": {
+                    "description": "paths changed by pr, their codeowners are preferred as reviewers",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "draft": {
+                    "description": "draft is created without reviewers, they are assigned by /pullRequest/ready",
+                    "type": "boolean"
+                },
+                "labels": {
+                    "description": "members with skills matching labels are preferred as reviewers",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "team_name": {
+                    "description": "team of pr, author must be its member, primary team of author is used when omitted",
+                    "type": "string"
+                }
+            }
+        },
+        "docs.CreatePRResponse": {
+            "type": "object",
+            "properties": {
+                "pr": {
+                    "$ref": "#/definitions/docs.PRResponseObject"
+                }
+            }
+        },
+        "docs.DeactivateAllRequest": {
+            "type": "object",
+            "properties": {
+                "keep_active": {
+                    "description": "ids of members, who stay active",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reassign_reviews": {
+                    "description": "reassign open reviews of deactivated members, config default is used when omitted",
+                    "type": "boolean"
+                }
+            }
+        },
+        "docs.DeactivateAllResponse": {
+            "type": "object",
+            "properties": {
+                "deactivated": {
+                    "type": "integer"
+                },
+                "reassigned": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ReassignmentResponse"
+                    }
+                },
+                "result": {
+                    "type": "string"
+                },
+                "short_of_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "docs.DeletePoolRequest": {
+            "type": "object",
+            "properties": {
+                "pool_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.DeletePoolResponse": {
+            "type": "object",
+            "properties": {
+                "result": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.DeleteTeamRequest": {
+            "type": "object",
+            "properties": {
+                "force": {
+                    "description": "CLOSE or REASSIGN for team with OPEN and DRAFT pull requests, deletion is refused when omitted",
+                    "type": "string"
+                },
+                "target_team_name": {
+                    "description": "team, which takes unfinished pull requests in REASSIGN mode",
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.DeleteTeamResponse": {
+            "type": "object",
+            "properties": {
+                "closed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "moved": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reassigned": {
+                    "description": "reviewers of moved pull requests replaced by members of target team",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ReassignmentResponse"
+                    }
+                },
+                "short_of_reviewers": {
+                    "description": "moved pull requests left with less reviewers",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.DeleteUnavailabilityRequest": {
+            "type": "object",
+            "properties": {
+                "unavailability_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.DeleteUnavailabilityResponse": {
+            "type": "object",
+            "properties": {
+                "result": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.DeleteWebhookRequest": {
+            "type": "object",
+            "properties": {
+                "webhook_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.DeleteWebhookResponse": {
+            "type": "object",
+            "properties": {
+                "result": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.DeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.DetachTeamRequest": {
+            "type": "object",
+            "properties": {
+                "pool_name": {
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.DroppedReviewResponse": {
+            "type": "object",
+            "properties": {
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "reviewer_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/docs.ErrorResponseObject"
+                }
+            }
+        },
+        "docs.ErrorResponseObject": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.GetDeliveriesResponse": {
+            "type": "object",
+            "properties": {
+                "deliveries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.DeliveryResponse"
+                    }
+                },
+                "webhook_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.GetPRResponse": {
+            "type": "object",
+            "properties": {
+                "pr": {
+                    "$ref": "#/definitions/docs.PRDetailsResponseObject"
+                }
+            }
+        },
+        "docs.GetReviewPRResponse": {
+            "type": "object",
+            "properties": {
+                "age_seconds": {
+                    "description": "seconds since pr creation",
+                    "type": "integer"
+                },
+                "assigned_at": {
+                    "description": "time of assignment of the user as reviewer",
+                    "type": "string"
+                },
+                "author_id": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.GetReviewResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "description": "empty on the last page",
+                    "type": "string"
+                },
+                "pull_requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.GetReviewPRResponse"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.GetTeamResponse": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "description": "archived team does not accept new pull requests",
+                    "type": "boolean"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.TeamMember"
+                    }
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.GetUnavailabilityResponse": {
+            "type": "object",
+            "properties": {
+                "unavailability": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.UnavailabilityResponse"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.IngestResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "action of pr, which event is mapped to",
+                    "type": "string"
+                },
+                "pr": {
+                    "$ref": "#/definitions/docs.PRResponseObject"
+                },
+                "reason": {
+                    "description": "why event is ignored",
+                    "type": "string"
+                },
+                "result": {
+                    "description": "applied or ignored",
+                    "type": "string"
+                },
+                "unmet_conditions": {
+                    "description": "merge policy conditions, which merge done on hosting does not meet, merge is recorded anyway",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.UnmetConditionResponse"
+                    }
+                }
+            }
+        },
+        "docs.ListPRResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "description": "empty on the last page",
+                    "type": "string"
+                },
+                "pull_requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.PRDetailsResponseObject"
+                    }
+                }
+            }
+        },
+        "docs.ListPoolsResponse": {
+            "type": "object",
+            "properties": {
+                "pools": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.PoolResponseObject"
+                    }
+                }
+            }
+        },
+        "docs.ListWebhooksResponse": {
+            "type": "object",
+            "properties": {
+                "webhooks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.WebhookResponse"
+                    }
+                }
+            }
+        },
+        "docs.MemberOfOtherTeamsResponse": {
+            "type": "object",
+            "properties": {
+                "teams": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.MemberSkillsRequest": {
+            "type": "object",
+            "properties": {
+                "skills": {
+                    "description": "free-form tags, compared case-insensitively",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.MemberSkillsResponse": {
+            "type": "object",
+            "properties": {
+                "skills": {
+                    "description": "all skills of user after the change, sorted",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.MergePRRequest": {
+            "type": "object",
+            "properties": {
+                "merged_by": {
+                    "description": "id of user, who merges pr, required by author_cannot_merge rule of merge policy",
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.MergePRResponse": {
+            "type": "object",
+            "properties": {
+                "pr": {
+                    "$ref": "#/definitions/docs.MergePRResponseObject"
+                }
+            }
+        },
+        "docs.MergePRResponseObject": {
+            "type": "object",
+            "properties": {
+                "assigned_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "author_id": {
+                    "type": "string"
+                },
+                "mergedAt": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "reviewer_states": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ReviewerStateResponse"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.MergePolicyErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/docs.MergePolicyErrorResponseObject"
+                }
+            }
+        },
+        "docs.MergePolicyErrorResponseObject": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "unmet_conditions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.UnmetConditionResponse"
+                    }
+                }
             }
         },
-        "docs.AddTeamResponse": {
+        "docs.MoveTeamMembersRequest": {
+            "type": "object",
+            "properties": {
+                "target_team_name": {
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "user_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "docs.MoveTeamMembersResponse": {
             "type": "object",
             "properties": {
+                "target_team": {
+                    "$ref": "#/definitions/docs.GetTeamResponse"
+                },
                 "team": {
-                    "$ref": "#/definitions/docs.AddTeamResponseObject"
+                    "$ref": "#/definitions/docs.GetTeamResponse"
                 }
             }
         },
-        "docs.AddTeamResponseObject": {
+        "docs.PRDetailsResponseObject": {
+            "type": "object",
+            "properties": {
+                "assigned_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "author_id": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mergedAt": {
+                    "description": "only for MERGED pr",
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "reviewer_states": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ReviewerStateResponse"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.PRResponseObject": {
+            "type": "object",
+            "properties": {
+                "assigned_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "author_id": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "reviewer_states": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ReviewerStateResponse"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.PoolResponse": {
+            "type": "object",
+            "properties": {
+                "pool": {
+                    "$ref": "#/definitions/docs.PoolResponseObject"
+                }
+            }
+        },
+        "docs.PoolResponseObject": {
             "type": "object",
             "properties": {
                 "members": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/docs.TeamMember"
+                        "type": "string"
+                    }
+                },
+                "pool_name": {
+                    "type": "string"
+                },
+                "teams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.PoolTeamResponse"
+                    }
+                }
+            }
+        },
+        "docs.PoolTeamResponse": {
+            "type": "object",
+            "properties": {
+                "reviewers_count": {
+                    "type": "integer"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.ReassignRequest": {
+            "type": "object",
+            "properties": {
+                "old_reviewer_id": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.ReassignResponse": {
+            "type": "object",
+            "properties": {
+                "pr": {
+                    "$ref": "#/definitions/docs.PRResponseObject"
+                },
+                "replaced_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.ReassignmentResponse": {
+            "type": "object",
+            "properties": {
+                "new_reviewer_id": {
+                    "type": "string"
+                },
+                "old_reviewer_id": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.RegisterWebhookRequest": {
+            "type": "object",
+            "properties": {
+                "event_types": {
+                    "description": "subscribes to every event type when omitted",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
+                    "description": "key of HMAC signature, generated when omitted",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.RegisterWebhookResponse": {
+            "type": "object",
+            "properties": {
+                "secret": {
+                    "description": "secret is returned only on registration",
+                    "type": "string"
+                },
+                "webhook": {
+                    "$ref": "#/definitions/docs.WebhookResponse"
+                }
+            }
+        },
+        "docs.RemoveTeamMembersRequest": {
+            "type": "object",
+            "properties": {
+                "team_name": {
+                    "type": "string"
+                },
+                "user_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     }
+                }
+            }
+        },
+        "docs.RenameTeamRequest": {
+            "type": "object",
+            "properties": {
+                "new_team_name": {
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.RenameTeamResponse": {
+            "type": "object",
+            "properties": {
+                "previous_team_name": {
+                    "type": "string"
                 },
                 "team_name": {
                     "type": "string"
                 }
             }
         },
-        "docs.AssignmentsPerMember": {
+        "docs.ReplayRequest": {
+            "type": "object",
+            "properties": {
+                "dead_only": {
+                    "description": "replay only dead deliveries",
+                    "type": "boolean"
+                },
+                "event_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "since": {
+                    "description": "replay events created since the time",
+                    "type": "string"
+                },
+                "webhook_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.ReplayResponse": {
+            "type": "object",
+            "properties": {
+                "queued": {
+                    "type": "integer"
+                }
+            }
+        },
+        "docs.ReviewEventResponse": {
+            "type": "object",
+            "properties": {
+                "at": {
+                    "type": "string"
+                },
+                "author_id": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "docs.ReviewPRRequest": {
+            "type": "object",
+            "properties": {
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "reviewer_id": {
+                    "type": "string"
+                },
+                "verdict": {
+                    "description": "APPROVED, CHANGES_REQUESTED or COMMENTED",
+                    "type": "string"
+                }
+            }
+        },
+        "docs.ReviewPRResponse": {
+            "type": "object",
+            "properties": {
+                "pr": {
+                    "$ref": "#/definitions/docs.PRResponseObject"
+                }
+            }
+        },
+        "docs.ReviewerStateResponse": {
             "type": "object",
             "properties": {
-                "assignments_count": {
-                    "type": "integer"
+                "reviewed_at": {
+                    "type": "string"
                 },
-                "member_id": {
+                "reviewer_id": {
+                    "type": "string"
+                },
+                "state": {
+                    "description": "PENDING, APPROVED, CHANGES_REQUESTED or COMMENTED",
                     "type": "string"
                 }
             }
         },
-        "docs.AssignmentsStats": {
+        "docs.ScimErrorResponse": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "detail": {
+                    "type": "string"
                 },
-                "results": {
+                "schemas": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/docs.AssignmentsPerMember"
+                        "type": "string"
                     }
+                },
+                "scimType": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
                 }
             }
         },
-        "docs.CreatePRRequest": {
+        "docs.ScimGroup": {
             "type": "object",
             "properties": {
-                "author_id": {
+                "displayName": {
                     "type": "string"
                 },
-                "pull_request_id": {
+                "id": {
                     "type": "string"
                 },
-                "pull_request_name": {
-                    "type": "string"
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ScimGroupMember"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/docs.ScimMeta"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "docs.CreatePRResponse": {
+        "docs.ScimGroupListResponse": {
             "type": "object",
             "properties": {
-                "pr": {
-                    "$ref": "#/definitions/docs.PRResponseObject"
+                "Resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ScimGroup"
+                    }
+                },
+                "itemsPerPage": {
+                    "type": "integer"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startIndex": {
+                    "type": "integer"
+                },
+                "totalResults": {
+                    "type": "integer"
                 }
             }
         },
-        "docs.DeactivateAllRequest": {
+        "docs.ScimGroupMember": {
             "type": "object",
             "properties": {
-                "name": {
+                "display": {
+                    "type": "string"
+                },
+                "value": {
+                    "description": "id of user",
                     "type": "string"
                 }
             }
         },
-        "docs.DeactivateAllResponse": {
+        "docs.ScimMeta": {
             "type": "object",
             "properties": {
-                "result": {
+                "resourceType": {
                     "type": "string"
                 }
             }
         },
-        "docs.ErrorResponse": {
+        "docs.ScimPatchOperation": {
             "type": "object",
             "properties": {
-                "error": {
-                    "$ref": "#/definitions/docs.ErrorResponseObject"
+                "op": {
+                    "description": "add, remove or replace in any case",
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "value": {
+                    "description": "value of attribute from path or object with attributes, when path is omitted"
                 }
             }
         },
-        "docs.ErrorResponseObject": {
+        "docs.ScimPatchRequest": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "string"
+                "Operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ScimPatchOperation"
+                    }
                 },
-                "message": {
-                    "type": "string"
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "docs.GetReviewPRResponse": {
+        "docs.ScimUser": {
             "type": "object",
             "properties": {
-                "author_id": {
-                    "type": "string"
+                "active": {
+                    "description": "user is created active, when omitted",
+                    "type": "boolean"
                 },
-                "pull_request_id": {
+                "externalId": {
+                    "description": "used as id of created user, when set, so users keep ids of identity provider",
                     "type": "string"
                 },
-                "pull_request_name": {
+                "id": {
                     "type": "string"
                 },
-                "status": {
+                "meta": {
+                    "$ref": "#/definitions/docs.ScimMeta"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "userName": {
                     "type": "string"
                 }
             }
         },
-        "docs.GetReviewResponse": {
+        "docs.ScimUserListResponse": {
             "type": "object",
             "properties": {
-                "pull_requests": {
+                "Resources": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/docs.GetReviewPRResponse"
+                        "$ref": "#/definitions/docs.ScimUser"
                     }
                 },
-                "user_id": {
-                    "type": "string"
+                "itemsPerPage": {
+                    "type": "integer"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startIndex": {
+                    "type": "integer"
+                },
+                "totalResults": {
+                    "type": "integer"
                 }
             }
         },
-        "docs.GetTeamResponse": {
+        "docs.SetCodeownersRequest": {
             "type": "object",
             "properties": {
-                "members": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/docs.TeamMember"
-                    }
+                "rules": {
+                    "description": "CODEOWNERS text: pattern followed by owner ids on every line, replaces previous rules",
+                    "type": "string"
                 },
                 "team_name": {
                     "type": "string"
                 }
             }
         },
-        "docs.HealthResponse": {
+        "docs.SetIsActiveRequest": {
             "type": "object",
             "properties": {
-                "name": {
-                    "type": "string"
+                "is_active": {
+                    "type": "boolean"
                 },
-                "status": {
+                "reassign_reviews": {
+                    "description": "reassign open reviews on deactivation, config default is used when omitted",
+                    "type": "boolean"
+                },
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "docs.MergePRRequest": {
+        "docs.SetIsActiveResponse": {
             "type": "object",
             "properties": {
-                "pull_request_id": {
+                "is_active": {
+                    "type": "boolean"
+                },
+                "not_reassigned": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reassigned": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ReassignmentResponse"
+                    }
+                },
+                "team_name": {
+                    "description": "primary team of user",
+                    "type": "string"
+                },
+                "teams": {
+                    "description": "all teams of user, including primary one",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
                     "type": "string"
                 }
             }
         },
-        "docs.MergePRResponse": {
+        "docs.SetMaxOpenReviewsRequest": {
             "type": "object",
             "properties": {
-                "pr": {
-                    "$ref": "#/definitions/docs.MergePRResponseObject"
+                "max_open_reviews": {
+                    "description": "null removes the limit",
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
-        "docs.MergePRResponseObject": {
+        "docs.SetMaxOpenReviewsResponse": {
             "type": "object",
             "properties": {
-                "assigned_reviewers": {
+                "is_active": {
+                    "type": "boolean"
+                },
+                "max_open_reviews": {
+                    "type": "integer"
+                },
+                "team_name": {
+                    "description": "primary team of user",
+                    "type": "string"
+                },
+                "teams": {
+                    "description": "all teams of user, including primary one",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "author_id": {
+                "user_id": {
                     "type": "string"
                 },
-                "mergedAt": {
+                "username": {
                     "type": "string"
+                }
+            }
+        },
+        "docs.TeamDiffResponse": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "description": "users, which were not in team, including members of other teams",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "pull_request_id": {
-                    "type": "string"
+                "also_member_of": {
+                    "description": "added users, which already belong to other teams, they keep those memberships",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.MemberOfOtherTeamsResponse"
+                    }
                 },
-                "pull_request_name": {
-                    "type": "string"
+                "dropped_reviews": {
+                    "description": "reviews of removed users on OPEN pull requests of team",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.DroppedReviewResponse"
+                    }
                 },
-                "status": {
-                    "type": "string"
+                "removed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "docs.PRResponseObject": {
+        "docs.TeamMember": {
             "type": "object",
             "properties": {
-                "assigned_reviewers": {
+                "is_active": {
+                    "type": "boolean"
+                },
+                "teams": {
+                    "description": "all teams of user, ignored in requests",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "author_id": {
-                    "type": "string"
-                },
-                "pull_request_id": {
-                    "type": "string"
-                },
-                "pull_request_name": {
+                "user_id": {
                     "type": "string"
                 },
-                "status": {
+                "username": {
                     "type": "string"
                 }
             }
         },
-        "docs.ReassignRequest": {
+        "docs.TeamMembersResponse": {
             "type": "object",
             "properties": {
-                "old_reviewer_id": {
-                    "type": "string"
-                },
-                "pull_request_id": {
-                    "type": "string"
+                "team": {
+                    "$ref": "#/definitions/docs.GetTeamResponse"
                 }
             }
         },
-        "docs.ReassignResponse": {
+        "docs.UnavailabilityResponse": {
             "type": "object",
             "properties": {
-                "pr": {
-                    "$ref": "#/definitions/docs.PRResponseObject"
+                "ends_at": {
+                    "type": "string"
                 },
-                "replaced_by": {
+                "kind": {
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "unavailability_id": {
+                    "type": "string"
+                },
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "docs.SetIsActiveRequest": {
+        "docs.UnmetConditionResponse": {
             "type": "object",
             "properties": {
-                "is_active": {
-                    "type": "boolean"
+                "condition": {
+                    "description": "MIN_APPROVALS, NO_CHANGES_REQUESTED, REVIEWER_FROM_TEAM or AUTHOR_CANNOT_MERGE",
+                    "type": "string"
                 },
-                "user_id": {
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "docs.SetIsActiveResponse": {
+        "docs.UpdateUnavailabilityRequest": {
             "type": "object",
             "properties": {
-                "is_active": {
-                    "type": "boolean"
+                "ends_at": {
+                    "type": "string"
                 },
-                "team_name": {
+                "kind": {
                     "type": "string"
                 },
-                "user_id": {
+                "starts_at": {
                     "type": "string"
                 },
-                "username": {
+                "unavailability_id": {
                     "type": "string"
                 }
             }
         },
-        "docs.TeamMember": {
+        "docs.WebhookResponse": {
             "type": "object",
             "properties": {
-                "is_active": {
-                    "type": "boolean"
+                "created_at": {
+                    "type": "string"
                 },
-                "user_id": {
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
                     "type": "string"
                 },
-                "username": {
+                "webhook_id": {
                     "type": "string"
                 }
             }
@@ -1,11 +1,18 @@
 package docs
 
 import (
+	"strconv"
 	"time"
 
+	codeownersEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/entity"
+	integrationEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	poolEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/entity"
 	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	reviewStreamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/entity"
 	statsEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/statistics/entity"
+	teamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
+	webhookEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
 )
 
 type ErrorResponseObject struct {
@@ -26,52 +33,223 @@ func NewErrorResponse(code, message string) ErrorResponse {
 	}
 }
 
+type UnmetConditionResponse struct {
+	// MIN_APPROVALS, NO_CHANGES_REQUESTED, REVIEWER_FROM_TEAM or AUTHOR_CANNOT_MERGE
+	Condition string `json:"condition"`
+	Message   string `json:"message"`
+}
+
+type MergePolicyErrorResponseObject struct {
+	Code            string                   `json:"code"`
+	Message         string                   `json:"message"`
+	UnmetConditions []UnmetConditionResponse `json:"unmet_conditions"`
+}
+
+type MergePolicyErrorResponse struct {
+	Error MergePolicyErrorResponseObject `json:"error"`
+}
+
+func ToUnmetConditionsResponse(unmet []prEntity.UnmetCondition) []UnmetConditionResponse {
+	conditions := make([]UnmetConditionResponse, 0, len(unmet))
+
+	for _, condition := range unmet {
+		conditions = append(conditions, UnmetConditionResponse{
+			Condition: string(condition.Condition),
+			Message:   condition.Message,
+		})
+	}
+
+	return conditions
+}
+
+func NewMergePolicyErrorResponse(unmet []prEntity.UnmetCondition) MergePolicyErrorResponse {
+	return MergePolicyErrorResponse{
+		Error: MergePolicyErrorResponseObject{
+			Code:            "MERGE_POLICY_VIOLATION",
+			Message:         "merge policy conditions are not met",
+			UnmetConditions: ToUnmetConditionsResponse(unmet),
+		},
+	}
+}
+
 type SetIsActiveRequest struct {
 	UserId   string `json:"user_id"`
 	IsActive bool   `json:"is_active"`
+	// reassign open reviews on deactivation, config default is used when omitted
+	ReassignReviews *bool `json:"reassign_reviews,omitempty"`
 }
 
 type SetIsActiveResponse struct {
 	UserId   string `json:"user_id"`
 	Username string `json:"username"`
+	// primary team of user
 	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+	// all teams of user, including primary one
+	Teams    []string `json:"teams,omitempty"`
+	IsActive bool     `json:"is_active"`
+
+	Reassigned    []ReassignmentResponse `json:"reassigned,omitempty"`
+	NotReassigned []string               `json:"not_reassigned,omitempty"`
 }
 
-func ToSetIsActiveResponse(member memberEntity.Member) SetIsActiveResponse {
+func ToSetIsActiveResponse(member memberEntity.Member, report prEntity.ReassignReport) SetIsActiveResponse {
 	return SetIsActiveResponse{
-		UserId:   member.Id,
-		Username: member.Username,
-		TeamName: member.TeamName,
-		IsActive: member.Activity == memberEntity.MemberActive,
+		UserId:        member.Id,
+		Username:      member.Username,
+		TeamName:      member.TeamName,
+		Teams:         member.Teams,
+		IsActive:      member.Activity == memberEntity.MemberActive,
+		Reassigned:    ToReassignmentsResponse(report.Reassigned),
+		NotReassigned: report.NotReassigned,
+	}
+}
+
+type ReassignmentResponse struct {
+	PullRequestId string `json:"pull_request_id"`
+	OldReviewerId string `json:"old_reviewer_id"`
+	NewReviewerId string `json:"new_reviewer_id"`
+}
+
+func ToReassignmentsResponse(reassignments []prEntity.Reassignment) []ReassignmentResponse {
+	res := make([]ReassignmentResponse, 0, len(reassignments))
+
+	for _, r := range reassignments {
+		res = append(res, ReassignmentResponse{
+			PullRequestId: r.PullRequestId,
+			OldReviewerId: r.OldReviewerId,
+			NewReviewerId: r.NewReviewerId,
+		})
+	}
+
+	return res
+}
+
+type SetMaxOpenReviewsRequest struct {
+	UserId string `json:"user_id"`
+	// null removes the limit
+	MaxOpenReviews *int `json:"max_open_reviews"`
+}
+
+type SetMaxOpenReviewsResponse struct {
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+	// primary team of user
+	TeamName string `json:"team_name"`
+	// all teams of user, including primary one
+	Teams          []string `json:"teams,omitempty"`
+	IsActive       bool     `json:"is_active"`
+	MaxOpenReviews *int     `json:"max_open_reviews"`
+}
+
+func ToSetMaxOpenReviewsResponse(member memberEntity.Member) SetMaxOpenReviewsResponse {
+	return SetMaxOpenReviewsResponse{
+		UserId:         member.Id,
+		Username:       member.Username,
+		TeamName:       member.TeamName,
+		Teams:          member.Teams,
+		IsActive:       member.Activity == memberEntity.MemberActive,
+		MaxOpenReviews: member.MaxOpenReviews,
+	}
+}
+
+type AddUnavailabilityRequest struct {
+	UserId string `json:"user_id"`
+	// one of: VACATION, SICK_LEAVE, ON_CALL
+	Kind     string    `json:"kind"`
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+}
+
+type UpdateUnavailabilityRequest struct {
+	Id       string    `json:"unavailability_id"`
+	Kind     string    `json:"kind"`
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+}
+
+type DeleteUnavailabilityRequest struct {
+	Id string `json:"unavailability_id"`
+}
+
+type DeleteUnavailabilityResponse struct {
+	Result string `json:"result"`
+}
+
+type UnavailabilityResponse struct {
+	Id       string    `json:"unavailability_id"`
+	UserId   string    `json:"user_id"`
+	Kind     string    `json:"kind"`
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+}
+
+func ToUnavailabilityResponse(u memberEntity.Unavailability) UnavailabilityResponse {
+	return UnavailabilityResponse{
+		Id:       u.Id,
+		UserId:   u.MemberId,
+		Kind:     string(u.Kind),
+		StartsAt: u.StartsAt,
+		EndsAt:   u.EndsAt,
 	}
 }
 
+type GetUnavailabilityResponse struct {
+	UserId         string                   `json:"user_id"`
+	Unavailability []UnavailabilityResponse `json:"unavailability"`
+}
+
+type MemberSkillsRequest struct {
+	UserId string `json:"user_id"`
+	// free-form tags, compared case-insensitively
+	Skills []string `json:"skills"`
+}
+
+type MemberSkillsResponse struct {
+	UserId string `json:"user_id"`
+	// all skills of user after the change, sorted
+	Skills []string `json:"skills"`
+}
+
 type GetReviewPRResponse struct {
 	Id       string `json:"pull_request_id"`
 	Name     string `json:"pull_request_name"`
 	AuthorId string `json:"author_id"`
 	Status   string `json:"status"`
+	// seconds since pr creation
+	AgeSeconds int64 `json:"age_seconds"`
+	// time of assignment of the user as reviewer
+	AssignedAt *time.Time `json:"assigned_at,omitempty"`
 }
 
-func ToReviewPRResponse(pr prEntity.PullRequest) GetReviewPRResponse {
-	return GetReviewPRResponse{
-		Id:       pr.Id,
-		Name:     pr.Name,
-		AuthorId: pr.AuthorId,
-		Status:   string(pr.Status),
+func ToReviewPRResponse(pr prEntity.PullRequest, reviewerId string, now time.Time) GetReviewPRResponse {
+	res := GetReviewPRResponse{
+		Id:         pr.Id,
+		Name:       pr.Name,
+		AuthorId:   pr.AuthorId,
+		Status:     string(pr.Status),
+		AgeSeconds: int64(pr.Age(now).Seconds()),
+	}
+
+	if assignedAt, ok := pr.AssignedAt(reviewerId); ok {
+		res.AssignedAt = &assignedAt
 	}
+
+	return res
 }
 
 type GetReviewResponse struct {
 	UserId       string                `json:"user_id"`
 	PullRequests []GetReviewPRResponse `json:"pull_requests"`
+	// empty on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 type TeamMember struct {
 	UserId   string `json:"user_id"`
 	Username string `json:"username"`
 	IsActive bool   `json:"is_active"`
+	// all teams of user, ignored in requests
+	Teams []string `json:"teams,omitempty"`
 }
 
 func ToTeamMember(member memberEntity.Member) TeamMember {
@@ -79,6 +257,7 @@ func ToTeamMember(member memberEntity.Member) TeamMember {
 		UserId:   member.Id,
 		Username: member.Username,
 		IsActive: member.Activity == memberEntity.MemberActive,
+		Teams:    member.Teams,
 	}
 }
 
@@ -105,27 +284,214 @@ type AddTeamResponseObject struct {
 	Members []TeamMember `json:"members"`
 }
 
+type DroppedReviewResponse struct {
+	PullRequestId string `json:"pull_request_id"`
+	ReviewerId    string `json:"reviewer_id"`
+}
+
+type MemberOfOtherTeamsResponse struct {
+	UserId string   `json:"user_id"`
+	Teams  []string `json:"teams"`
+}
+
+type TeamDiffResponse struct {
+	// users, which were not in team, including members of other teams
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+	// added users, which already belong to other teams, they keep those memberships
+	AlsoMemberOf []MemberOfOtherTeamsResponse `json:"also_member_of"`
+	// reviews of removed users on OPEN pull requests of team
+	DroppedReviews []DroppedReviewResponse `json:"dropped_reviews"`
+}
+
+func ToTeamDiffResponse(diff teamEntity.UpsertDiff) TeamDiffResponse {
+	res := TeamDiffResponse{
+		Added:          make([]string, 0, len(diff.Added)),
+		Removed:        make([]string, 0, len(diff.Removed)),
+		AlsoMemberOf:   make([]MemberOfOtherTeamsResponse, 0, len(diff.AlsoMemberOf)),
+		DroppedReviews: make([]DroppedReviewResponse, 0, len(diff.DroppedReviews)),
+	}
+
+	res.Added = append(res.Added, diff.Added...)
+	res.Removed = append(res.Removed, diff.Removed...)
+
+	for _, member := range diff.AlsoMemberOf {
+		res.AlsoMemberOf = append(res.AlsoMemberOf, MemberOfOtherTeamsResponse{
+			UserId: member.MemberId,
+			Teams:  member.Teams,
+		})
+	}
+
+	for _, review := range diff.DroppedReviews {
+		res.DroppedReviews = append(res.DroppedReviews, DroppedReviewResponse{
+			PullRequestId: review.PullRequestId,
+			ReviewerId:    review.ReviewerId,
+		})
+	}
+
+	return res
+}
+
 type AddTeamResponse struct {
 	Team AddTeamResponseObject `json:"team"`
+	Diff TeamDiffResponse      `json:"diff"`
+	// set when team was not changed and diff is only planned
+	DryRun bool `json:"dry_run,omitempty"`
 }
 
 type GetTeamResponse struct {
 	Name    string       `json:"team_name"`
 	Members []TeamMember `json:"members"`
+	// archived team does not accept new pull requests
+	Archived bool `json:"archived,omitempty"`
+}
+
+func ToGetTeamResponse(team teamEntity.Team) GetTeamResponse {
+	res := GetTeamResponse{
+		Name:     team.Name,
+		Members:  make([]TeamMember, 0, len(team.Members)),
+		Archived: team.Archived,
+	}
+
+	for _, member := range team.Members {
+		res.Members = append(res.Members, ToTeamMember(member))
+	}
+
+	return res
+}
+
+type AddTeamMembersRequest struct {
+	Name string `json:"team_name"`
+	// unknown users are created, current members are kept
+	Members []TeamMember `json:"members"`
+}
+
+type RemoveTeamMembersRequest struct {
+	Name    string   `json:"team_name"`
+	UserIds []string `json:"user_ids"`
+}
+
+type MoveTeamMembersRequest struct {
+	Name       string   `json:"team_name"`
+	TargetTeam string   `json:"target_team_name"`
+	UserIds    []string `json:"user_ids"`
+}
+
+type TeamMembersResponse struct {
+	Team GetTeamResponse `json:"team"`
+}
+
+type MoveTeamMembersResponse struct {
+	Team       GetTeamResponse `json:"team"`
+	TargetTeam GetTeamResponse `json:"target_team"`
+}
+
+type RenameTeamRequest struct {
+	Name    string `json:"team_name"`
+	NewName string `json:"new_team_name"`
+}
+
+type RenameTeamResponse struct {
+	Name         string `json:"team_name"`
+	PreviousName string `json:"previous_team_name"`
+}
+
+type ArchiveTeamRequest struct {
+	Name string `json:"team_name"`
+	// false returns team from archive, team is archived when omitted
+	Archived *bool `json:"archived,omitempty"`
+}
+
+type ArchiveTeamResponse struct {
+	Name     string `json:"team_name"`
+	Archived bool   `json:"archived"`
+}
+
+type DeleteTeamRequest struct {
+	Name string `json:"team_name"`
+	// CLOSE or REASSIGN for team with OPEN and DRAFT pull requests, deletion is refused when omitted
+	Force string `json:"force,omitempty"`
+	// team, which takes unfinished pull requests in REASSIGN mode
+	TargetTeam string `json:"target_team_name,omitempty"`
+}
+
+type DeleteTeamResponse struct {
+	Name   string   `json:"team_name"`
+	Closed []string `json:"closed"`
+	Moved  []string `json:"moved"`
+	// reviewers of moved pull requests replaced by members of target team
+	Reassigned []ReassignmentResponse `json:"reassigned"`
+	// moved pull requests left with less reviewers
+	ShortOfReviewers []string `json:"short_of_reviewers"`
+}
+
+func ToDeleteTeamResponse(name string, report teamEntity.DeletionReport) DeleteTeamResponse {
+	nonNil := func(ids []string) []string {
+		if ids == nil {
+			return []string{}
+		}
+
+		return ids
+	}
+
+	return DeleteTeamResponse{
+		Name:             name,
+		Closed:           nonNil(report.Closed),
+		Moved:            nonNil(report.Moved),
+		Reassigned:       ToReassignmentsResponse(report.Reassigned),
+		ShortOfReviewers: nonNil(report.ShortOfReviewers),
+	}
 }
 
 type CreatePRRequest struct {
 	Id       string `json:"pull_request_id"`
 	Name     string `json:"pull_request_name"`
 	AuthorId string `json:"author_id"`
+	// draft is created without reviewers, they are assigned by /pullRequest/ready
+	Draft bool `json:"draft,omitempty"`
+	// paths changed by pr, their codeowners are preferred as reviewers
+	ChangedFiles []string `json:"changed_files,omitempty"`
+	// members with skills matching labels are preferred as reviewers
+	Labels []string `json:"labels,omitempty"`
+	// team of pr, author must be its member, primary team of author is used when omitted
+	TeamName string `json:"team_name,omitempty"`
+}
+
+type ReviewerStateResponse struct {
+	ReviewerId string `json:"reviewer_id"`
+	// PENDING, APPROVED, CHANGES_REQUESTED or COMMENTED
+	State      string     `json:"state"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+}
+
+func ToReviewerStatesResponse(pr prEntity.PullRequest) []ReviewerStateResponse {
+	states := pr.ReviewerStates()
+	res := make([]ReviewerStateResponse, 0, len(states))
+
+	for _, state := range states {
+		var reviewedAt *time.Time
+		if state.Verdict != prEntity.VerdictPending {
+			reviewedAt = &state.ReviewedAt
+		}
+
+		res = append(res, ReviewerStateResponse{
+			ReviewerId: state.ReviewerId,
+			State:      string(state.Verdict),
+			ReviewedAt: reviewedAt,
+		})
+	}
+
+	return res
 }
 
 type PRResponseObject struct {
-	Id                string   `json:"pull_request_id"`
-	Name              string   `json:"pull_request_name"`
-	AuthorId          string   `json:"author_id"`
-	Status            string   `json:"status"`
-	AssignedReviewers []string `json:"assigned_reviewers"`
+	Id                string                  `json:"pull_request_id"`
+	Name              string                  `json:"pull_request_name"`
+	AuthorId          string                  `json:"author_id"`
+	Status            string                  `json:"status"`
+	AssignedReviewers []string                `json:"assigned_reviewers"`
+	ReviewerStates    []ReviewerStateResponse `json:"reviewer_states"`
+	Labels            []string                `json:"labels,omitempty"`
 }
 
 type CreatePRResponse struct {
@@ -134,21 +500,98 @@ type CreatePRResponse struct {
 
 type MergePRRequest struct {
 	Id string `json:"pull_request_id"`
+	// id of user, who merges pr, required by author_cannot_merge rule of merge policy
+	MergedBy string `json:"merged_by,omitempty"`
 }
 
 type MergePRResponseObject struct {
-	Id                string    `json:"pull_request_id"`
-	Name              string    `json:"pull_request_name"`
-	AuthorId          string    `json:"author_id"`
-	Status            string    `json:"status"`
-	AssignedReviewers []string  `json:"assigned_reviewers"`
-	MergedAt          time.Time `json:"mergedAt"`
+	Id                string                  `json:"pull_request_id"`
+	Name              string                  `json:"pull_request_name"`
+	AuthorId          string                  `json:"author_id"`
+	Status            string                  `json:"status"`
+	AssignedReviewers []string                `json:"assigned_reviewers"`
+	ReviewerStates    []ReviewerStateResponse `json:"reviewer_states"`
+	MergedAt          time.Time               `json:"mergedAt"`
 }
 
 type MergePRResponse struct {
 	Pr MergePRResponseObject `json:"pr"`
 }
 
+type ChangePRStatusRequest struct {
+	Id string `json:"pull_request_id"`
+}
+
+type ChangePRStatusResponse struct {
+	Pr PRResponseObject `json:"pr"`
+}
+
+func ToPRResponseObject(pr prEntity.PullRequest) PRResponseObject {
+	return PRResponseObject{
+		Id:                pr.Id,
+		Name:              pr.Name,
+		AuthorId:          pr.AuthorId,
+		Status:            string(pr.Status),
+		AssignedReviewers: pr.Reviewers,
+		ReviewerStates:    ToReviewerStatesResponse(pr),
+		Labels:            pr.Labels,
+	}
+}
+
+type PRDetailsResponseObject struct {
+	Id                string                  `json:"pull_request_id"`
+	Name              string                  `json:"pull_request_name"`
+	AuthorId          string                  `json:"author_id"`
+	Status            string                  `json:"status"`
+	AssignedReviewers []string                `json:"assigned_reviewers"`
+	ReviewerStates    []ReviewerStateResponse `json:"reviewer_states"`
+	Labels            []string                `json:"labels,omitempty"`
+	CreatedAt         time.Time               `json:"createdAt"`
+	// only for MERGED pr
+	MergedAt *time.Time `json:"mergedAt,omitempty"`
+}
+
+func ToPRDetailsResponseObject(pr prEntity.PullRequest) PRDetailsResponseObject {
+	res := PRDetailsResponseObject{
+		Id:                pr.Id,
+		Name:              pr.Name,
+		AuthorId:          pr.AuthorId,
+		Status:            string(pr.Status),
+		AssignedReviewers: pr.Reviewers,
+		ReviewerStates:    ToReviewerStatesResponse(pr),
+		Labels:            pr.Labels,
+		CreatedAt:         pr.CreatedAt,
+	}
+
+	if pr.Status == prEntity.PRMerged {
+		mergedAt := pr.MergedAt
+		res.MergedAt = &mergedAt
+	}
+
+	return res
+}
+
+type GetPRResponse struct {
+	Pr PRDetailsResponseObject `json:"pr"`
+}
+
+type ListPRResponse struct {
+	PullRequests []PRDetailsResponseObject `json:"pull_requests"`
+	// empty on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type ReviewPRRequest struct {
+	Id         string `json:"pull_request_id"`
+	ReviewerId string `json:"reviewer_id"`
+	// APPROVED, CHANGES_REQUESTED or COMMENTED
+	Verdict string `json:"verdict"`
+}
+
+type ReviewPRResponse struct {
+	Pr PRResponseObject `json:"pr"`
+}
+
 type ReassignRequest struct {
 	Id            string `json:"pull_request_id"`
 	OldReviewerId string `json:"old_reviewer_id"`
@@ -179,12 +622,402 @@ func ToAssignmentsPerMemberResponse(a statsEntity.AssignmentsPerMember) Assignme
 type AssignmentsStats struct {
 	Count   int                    `json:"count"`
 	Results []AssignmentsPerMember `json:"results"`
+	// empty on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 type DeactivateAllRequest struct {
 	Name string `json:"name"`
+	// ids of members, who stay active
+	KeepActive []string `json:"keep_active,omitempty"`
+	// reassign open reviews of deactivated members, config default is used when omitted
+	ReassignReviews *bool `json:"reassign_reviews,omitempty"`
 }
 
 type DeactivateAllResponse struct {
+	Result           string                 `json:"result"`
+	Deactivated      int                    `json:"deactivated"`
+	Reassigned       []ReassignmentResponse `json:"reassigned"`
+	ShortOfReviewers []string               `json:"short_of_reviewers"`
+}
+
+func ToDeactivateAllResponse(report teamEntity.DeactivationReport) DeactivateAllResponse {
+	shortOfReviewers := report.ShortOfReviewers
+	if shortOfReviewers == nil {
+		shortOfReviewers = []string{}
+	}
+
+	return DeactivateAllResponse{
+		Result:           "ok",
+		Deactivated:      report.Deactivated,
+		Reassigned:       ToReassignmentsResponse(report.Reassigned),
+		ShortOfReviewers: shortOfReviewers,
+	}
+}
+
+type SetCodeownersRequest struct {
+	TeamName string `json:"team_name"`
+	// CODEOWNERS text: pattern followed by owner ids on every line, replaces previous rules
+	Rules string `json:"rules"`
+}
+
+type CodeownersRuleResponse struct {
+	Pattern string   `json:"pattern"`
+	Owners  []string `json:"owners"`
+}
+
+type CodeownersResponse struct {
+	TeamName string `json:"team_name"`
+	// api, config or none
+	Source string                   `json:"source"`
+	Rules  []CodeownersRuleResponse `json:"rules"`
+}
+
+func ToCodeownersResponse(
+	teamName string,
+	ruleset codeownersEntity.Ruleset,
+	source codeownersEntity.Source,
+) CodeownersResponse {
+	rules := make([]CodeownersRuleResponse, 0, len(ruleset.Rules))
+
+	for _, rule := range ruleset.Rules {
+		rules = append(rules, CodeownersRuleResponse{
+			Pattern: rule.Pattern,
+			Owners:  rule.Owners,
+		})
+	}
+
+	return CodeownersResponse{
+		TeamName: teamName,
+		Source:   string(source),
+		Rules:    rules,
+	}
+}
+
+type RegisterWebhookRequest struct {
+	Url string `json:"url"`
+	// key of HMAC signature, generated when omitted
+	Secret string `json:"secret,omitempty"`
+	// subscribes to every event type when omitted
+	EventTypes []string `json:"event_types,omitempty"`
+}
+
+type WebhookResponse struct {
+	Id         string    `json:"webhook_id"`
+	Url        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func ToWebhookResponse(webhook webhookEntity.Webhook) WebhookResponse {
+	eventTypes := make([]string, 0, len(webhook.EventTypes))
+
+	for _, eventType := range webhook.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	return WebhookResponse{
+		Id:         webhook.Id,
+		Url:        webhook.Url,
+		EventTypes: eventTypes,
+		CreatedAt:  webhook.CreatedAt,
+	}
+}
+
+type RegisterWebhookResponse struct {
+	Webhook WebhookResponse `json:"webhook"`
+	// secret is returned only on registration
+	Secret string `json:"secret"`
+}
+
+type ListWebhooksResponse struct {
+	Webhooks []WebhookResponse `json:"webhooks"`
+}
+
+type DeleteWebhookRequest struct {
+	Id string `json:"webhook_id"`
+}
+
+type DeleteWebhookResponse struct {
+	Result string `json:"result"`
+}
+
+type DeliveryResponse struct {
+	EventId       string     `json:"event_id"`
+	EventType     string     `json:"event_type"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	LastError     string     `json:"last_error,omitempty"`
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty"`
+}
+
+func ToDeliveryResponse(delivery webhookEntity.Delivery) DeliveryResponse {
+	res := DeliveryResponse{
+		EventId:       delivery.Event.Id,
+		EventType:     string(delivery.Event.Type),
+		Status:        string(delivery.Status),
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		LastError:     delivery.LastError,
+	}
+
+	if delivery.Status == webhookEntity.DeliveryDelivered {
+		deliveredAt := delivery.DeliveredAt
+		res.DeliveredAt = &deliveredAt
+	}
+
+	return res
+}
+
+type GetDeliveriesResponse struct {
+	WebhookId  string             `json:"webhook_id"`
+	Deliveries []DeliveryResponse `json:"deliveries"`
+}
+
+type ReplayRequest struct {
+	WebhookId string   `json:"webhook_id"`
+	EventIds  []string `json:"event_ids,omitempty"`
+	// replay events created since the time
+	Since *time.Time `json:"since,omitempty"`
+	// replay only dead deliveries
+	DeadOnly bool `json:"dead_only,omitempty"`
+}
+
+type ReplayResponse struct {
+	Queued int `json:"queued"`
+}
+
+// data of review stream event, event id and type are sent in SSE fields
+type ReviewEventResponse struct {
+	UserId   string    `json:"user_id"`
+	Id       string    `json:"pull_request_id"`
+	Name     string    `json:"pull_request_name"`
+	AuthorId string    `json:"author_id"`
+	At       time.Time `json:"at"`
+}
+
+func ToReviewEventResponse(event reviewStreamEntity.ReviewEvent) ReviewEventResponse {
+	return ReviewEventResponse{
+		UserId:   event.ReviewerId,
+		Id:       event.PullRequestId,
+		Name:     event.PullRequestName,
+		AuthorId: event.AuthorId,
+		At:       event.CreatedAt,
+	}
+}
+
+type IngestResponse struct {
+	// applied or ignored
+	Result string `json:"result"`
+	// action of pr, which event is mapped to
+	Action string `json:"action,omitempty"`
+	// why event is ignored
+	Reason string            `json:"reason,omitempty"`
+	Pr     *PRResponseObject `json:"pr,omitempty"`
+	// merge policy conditions, which merge done on hosting does not meet, merge is recorded anyway
+	UnmetConditions []UnmetConditionResponse `json:"unmet_conditions,omitempty"`
+}
+
+func ToIngestResponse(res integrationEntity.IngestResult) IngestResponse {
+	if res.Ignored {
+		return IngestResponse{
+			Result: "ignored",
+			Action: string(res.Action),
+			Reason: res.Reason,
+		}
+	}
+
+	pr := ToPRResponseObject(res.PullRequest)
+
+	resp := IngestResponse{
+		Result: "applied",
+		Action: string(res.Action),
+		Pr:     &pr,
+	}
+
+	if len(res.UnmetConditions) > 0 {
+		resp.UnmetConditions = ToUnmetConditionsResponse(res.UnmetConditions)
+	}
+
+	return resp
+}
+
+func NewIgnoredResponse(reason string) IngestResponse {
+	return IngestResponse{
+		Result: "ignored",
+		Reason: reason,
+	}
+}
+
+type AddPoolRequest struct {
+	Name      string   `json:"pool_name"`
+	MemberIds []string `json:"members"`
+}
+
+type PoolTeamResponse struct {
+	TeamName       string `json:"team_name"`
+	ReviewersCount int    `json:"reviewers_count"`
+}
+
+type PoolResponseObject struct {
+	Name      string             `json:"pool_name"`
+	MemberIds []string           `json:"members"`
+	Teams     []PoolTeamResponse `json:"teams"`
+}
+
+func ToPoolResponseObject(pool poolEntity.Pool) PoolResponseObject {
+	teams := make([]PoolTeamResponse, 0, len(pool.Teams))
+
+	for _, team := range pool.Teams {
+		teams = append(teams, PoolTeamResponse{
+			TeamName:       team.TeamName,
+			ReviewersCount: team.ReviewersCount,
+		})
+	}
+
+	return PoolResponseObject{
+		Name:      pool.Name,
+		MemberIds: pool.MemberIds,
+		Teams:     teams,
+	}
+}
+
+type PoolResponse struct {
+	Pool PoolResponseObject `json:"pool"`
+}
+
+type ListPoolsResponse struct {
+	Pools []PoolResponseObject `json:"pools"`
+}
+
+type DeletePoolRequest struct {
+	Name string `json:"pool_name"`
+}
+
+type DeletePoolResponse struct {
 	Result string `json:"result"`
 }
+
+type AttachTeamRequest struct {
+	PoolName       string `json:"pool_name"`
+	TeamName       string `json:"team_name"`
+	ReviewersCount int    `json:"reviewers_count"`
+}
+
+type DetachTeamRequest struct {
+	PoolName string `json:"pool_name"`
+	TeamName string `json:"team_name"`
+}
+
+const (
+	ScimUserSchema  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	ScimGroupSchema = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	ScimListSchema  = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	ScimErrorSchema = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+type ScimMeta struct {
+	ResourceType string `json:"resourceType"`
+}
+
+type ScimUser struct {
+	Schemas []string `json:"schemas"`
+	Id      string   `json:"id,omitempty"`
+	// used as id of created user, when set, so users keep ids of identity provider
+	ExternalId string `json:"externalId,omitempty"`
+	UserName   string `json:"userName"`
+	// user is created active, when omitted
+	Active *bool     `json:"active,omitempty"`
+	Meta   *ScimMeta `json:"meta,omitempty"`
+}
+
+func ToScimUser(member memberEntity.Member) ScimUser {
+	active := member.Activity == memberEntity.MemberActive
+
+	return ScimUser{
+		Schemas:  []string{ScimUserSchema},
+		Id:       member.Id,
+		UserName: member.Username,
+		Active:   &active,
+		Meta:     &ScimMeta{ResourceType: "User"},
+	}
+}
+
+type ScimGroupMember struct {
+	// id of user
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type ScimGroup struct {
+	Schemas     []string          `json:"schemas"`
+	Id          string            `json:"id,omitempty"`
+	DisplayName string            `json:"displayName"`
+	Members     []ScimGroupMember `json:"members"`
+	Meta        *ScimMeta         `json:"meta,omitempty"`
+}
+
+func ToScimGroup(team teamEntity.Team) ScimGroup {
+	members := make([]ScimGroupMember, 0, len(team.Members))
+
+	for _, member := range team.Members {
+		members = append(members, ScimGroupMember{
+			Value:   member.Id,
+			Display: member.Username,
+		})
+	}
+
+	return ScimGroup{
+		Schemas:     []string{ScimGroupSchema},
+		Id:          team.Id,
+		DisplayName: team.Name,
+		Members:     members,
+		Meta:        &ScimMeta{ResourceType: "Group"},
+	}
+}
+
+type ScimUserListResponse struct {
+	Schemas      []string   `json:"schemas"`
+	TotalResults int        `json:"totalResults"`
+	StartIndex   int        `json:"startIndex"`
+	ItemsPerPage int        `json:"itemsPerPage"`
+	Resources    []ScimUser `json:"Resources"`
+}
+
+type ScimGroupListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    []ScimGroup `json:"Resources"`
+}
+
+type ScimPatchOperation struct {
+	// add, remove or replace in any case
+	Op   string `json:"op"`
+	Path string `json:"path,omitempty"`
+	// value of attribute from path or object with attributes, when path is omitted
+	Value any `json:"value,omitempty"`
+}
+
+type ScimPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []ScimPatchOperation `json:"Operations"`
+}
+
+type ScimErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+func NewScimErrorResponse(status int, scimType, detail string) ScimErrorResponse {
+	return ScimErrorResponse{
+		Schemas:  []string{ScimErrorSchema},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	}
+}
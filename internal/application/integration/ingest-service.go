@@ -0,0 +1,152 @@
+package ingestservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
+	integrationErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/interfaces"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
+	prInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+)
+
+type IngestService struct {
+	pullRequestService prInterfaces.PullRequestService
+	deliveryRepo       interfaces.DeliveryRepo
+	hostLinkRepo       interfaces.HostLinkRepo
+	// name of hosting, deliveries are deduplicated per source
+	source string
+	// login in hosting -> member id
+	users map[string]string
+}
+
+// CreateIngestService creates service for one hosting, logins are mapped to members with users
+func CreateIngestService(
+	pullRequestService prInterfaces.PullRequestService,
+	deliveryRepo interfaces.DeliveryRepo,
+	hostLinkRepo interfaces.HostLinkRepo,
+	source string,
+	users map[string]string,
+) interfaces.IngestService {
+	return &IngestService{
+		pullRequestService: pullRequestService,
+		deliveryRepo:       deliveryRepo,
+		hostLinkRepo:       hostLinkRepo,
+		source:             source,
+		users:              users,
+	}
+}
+
+// hosting redelivers events, so repeated ones are reported as ignored instead of errors.
+// Delivery, which is already ingested, is skipped, so replay of old event does not revert later changes
+func (s *IngestService) Ingest(ctx context.Context, event entity.PREvent) (entity.IngestResult, error) {
+	if event.DeliveryId == "" {
+		return s.apply(ctx, event)
+	}
+
+	claimed, err := s.deliveryRepo.Claim(ctx, s.source, event.DeliveryId)
+
+	if err != nil {
+		return entity.IngestResult{}, fmt.Errorf("failed to claim delivery: %w", err)
+	}
+
+	if !claimed {
+		return ignored(event, "duplicate delivery"), nil
+	}
+
+	res, err := s.apply(ctx, event)
+
+	if err != nil {
+		if releaseErr := s.deliveryRepo.Release(ctx, s.source, event.DeliveryId); releaseErr != nil {
+			return entity.IngestResult{}, errors.Join(err, fmt.Errorf("failed to release delivery: %w", releaseErr))
+		}
+
+		return entity.IngestResult{}, err
+	}
+
+	return res, nil
+}
+
+func (s *IngestService) apply(ctx context.Context, event entity.PREvent) (entity.IngestResult, error) {
+	var (
+		pr    prEntity.PullRequest
+		unmet []prEntity.UnmetCondition
+		err   error
+	)
+
+	switch event.Action {
+	case entity.PROpened:
+		authorId, ok := s.users[event.AuthorLogin]
+
+		if !ok {
+			return entity.IngestResult{}, fmt.Errorf("%w: %s", integrationErrors.ErrUnknownUser, event.AuthorLogin)
+		}
+
+		// hosting events carry no changed files and their labels are not ingested,
+		// so reviewers are picked from the whole team
+		pr, err = s.pullRequestService.Create(ctx, event.PullRequestId, event.Name, authorId, "", event.Draft, nil, nil)
+
+		if errors.Is(err, prErrors.ErrTeamArchived) {
+			return ignored(event, "team is archived"), nil
+		}
+
+		alreadyExists := errors.Is(err, prErrors.ErrAlreadyExists)
+
+		// link is saved after pr is committed, so redelivery of event saves link missed by failed delivery.
+		// Reviewers assigned on creation are published together with the link
+		if err == nil || alreadyExists {
+			if saveErr := s.hostLinkRepo.Save(ctx, entity.HostLink{
+				PullRequestId: event.PullRequestId,
+				Source:        s.source,
+				Repository:    event.Repository,
+				Number:        event.Number,
+			}); saveErr != nil {
+				return entity.IngestResult{}, fmt.Errorf("failed to save host link: %w", saveErr)
+			}
+		}
+
+		if alreadyExists {
+			return ignored(event, "pr already exists"), nil
+		}
+
+	case entity.PRReady:
+		pr, err = s.pullRequestService.Ready(ctx, event.PullRequestId)
+
+	case entity.PRReopened:
+		pr, err = s.pullRequestService.Reopen(ctx, event.PullRequestId)
+
+	case entity.PRClosed:
+		pr, err = s.pullRequestService.Close(ctx, event.PullRequestId)
+
+	case entity.PRMerged:
+		// pr is already merged on hosting, so merge policy can not reject it, unmet conditions are only reported.
+		// Unmapped merger is passed as empty, so author_cannot_merge rule is not met
+		mergedBy := s.users[event.MergedByLogin]
+
+		pr, unmet, err = s.pullRequestService.RecordMerge(ctx, event.PullRequestId, mergedBy)
+
+	default:
+		return ignored(event, "unsupported action"), nil
+	}
+
+	if err != nil {
+		return entity.IngestResult{}, err
+	}
+
+	return entity.IngestResult{
+		Action:          event.Action,
+		PullRequest:     pr,
+		UnmetConditions: unmet,
+	}, nil
+}
+
+func ignored(event entity.PREvent, reason string) entity.IngestResult {
+	return entity.IngestResult{
+		Action:  event.Action,
+		Ignored: true,
+		Reason:  reason,
+	}
+}
@@ -0,0 +1,313 @@
+package ingestservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	ingestservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/integration"
+	pullrequestservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/pull-request"
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
+	integrationErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/errors"
+	integrationMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/mocks"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
+	prInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+	prMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngest(t *testing.T) {
+	errDbDown := errors.New("db is down")
+
+	prConfig := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+		MergePolicy: config.MergePolicyConfig{
+			MinApprovals:      1,
+			AuthorCannotMerge: true,
+		},
+	}
+
+	users := map[string]string{
+		"octocat": "u1",
+	}
+
+	openPR := prEntity.PullRequest{
+		Id:        "gh-1",
+		Name:      "pr",
+		AuthorId:  "u1",
+		Status:    prEntity.PROpen,
+		Reviewers: []string{"u2"},
+	}
+
+	mergedPR := openPR
+	mergedPR.Status = prEntity.PRMerged
+
+	type testCase struct {
+		what string
+
+		event          entity.PREvent
+		callClaim      bool
+		claimed        bool
+		callRelease    bool
+		callCreate     bool
+		repoError      error
+		callSave       bool
+		saveError      error
+		callUpdate     bool
+		expectedResult entity.IngestResult
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			what: "opened pr is created for mapped author",
+
+			event: entity.PREvent{
+				Action:        entity.PROpened,
+				PullRequestId: "gh-1",
+				Name:          "pr",
+				AuthorLogin:   "octocat",
+				Repository:    "acme/pr-service",
+				Number:        42,
+			},
+			callCreate: true,
+			callSave:   true,
+			expectedResult: entity.IngestResult{
+				Action:      entity.PROpened,
+				PullRequest: openPR,
+			},
+		},
+
+		{
+			what: "failed to save host link of created pr",
+
+			event: entity.PREvent{
+				Action:        entity.PROpened,
+				PullRequestId: "gh-1",
+				Name:          "pr",
+				AuthorLogin:   "octocat",
+				Repository:    "acme/pr-service",
+				Number:        42,
+			},
+			callCreate:    true,
+			callSave:      true,
+			saveError:     errDbDown,
+			expectedError: errDbDown,
+		},
+
+		{
+			what: "author is not mapped",
+
+			event: entity.PREvent{
+				Action:        entity.PROpened,
+				PullRequestId: "gh-1",
+				Name:          "pr",
+				AuthorLogin:   "stranger",
+			},
+			expectedError: integrationErrors.ErrUnknownUser,
+		},
+
+		{
+			what: "pr already exists",
+
+			event: entity.PREvent{
+				Action:        entity.PROpened,
+				PullRequestId: "gh-1",
+				Name:          "pr",
+				AuthorLogin:   "octocat",
+				Repository:    "acme/pr-service",
+				Number:        42,
+			},
+			callCreate: true,
+			repoError:  prErrors.ErrAlreadyExists,
+			callSave:   true,
+			expectedResult: entity.IngestResult{
+				Action:  entity.PROpened,
+				Ignored: true,
+				Reason:  "pr already exists",
+			},
+		},
+
+		{
+			what: "new delivery is applied",
+
+			event: entity.PREvent{
+				Action:        entity.PROpened,
+				PullRequestId: "gh-1",
+				Name:          "pr",
+				AuthorLogin:   "octocat",
+				Repository:    "acme/pr-service",
+				Number:        42,
+				DeliveryId:    "d1",
+			},
+			callClaim:  true,
+			claimed:    true,
+			callCreate: true,
+			callSave:   true,
+			expectedResult: entity.IngestResult{
+				Action:      entity.PROpened,
+				PullRequest: openPR,
+			},
+		},
+
+		{
+			what: "duplicate delivery is skipped",
+
+			event: entity.PREvent{
+				Action:        entity.PRClosed,
+				PullRequestId: "gh-1",
+				DeliveryId:    "d1",
+			},
+			callClaim: true,
+			claimed:   false,
+			expectedResult: entity.IngestResult{
+				Action:  entity.PRClosed,
+				Ignored: true,
+				Reason:  "duplicate delivery",
+			},
+		},
+
+		{
+			what: "failed delivery is released",
+
+			event: entity.PREvent{
+				Action:        entity.PROpened,
+				PullRequestId: "gh-1",
+				Name:          "pr",
+				AuthorLogin:   "stranger",
+				DeliveryId:    "d1",
+			},
+			callClaim:     true,
+			claimed:       true,
+			callRelease:   true,
+			expectedError: integrationErrors.ErrUnknownUser,
+		},
+
+		{
+			what: "merge on hosting is recorded despite merge policy",
+
+			event: entity.PREvent{
+				Action:        entity.PRMerged,
+				PullRequestId: "gh-1",
+				MergedByLogin: "stranger",
+			},
+			callUpdate: true,
+			expectedResult: entity.IngestResult{
+				Action:      entity.PRMerged,
+				PullRequest: mergedPR,
+				UnmetConditions: []prEntity.UnmetCondition{
+					{
+						Condition: prEntity.ConditionMinApprovals,
+						Message:   "1 approvals required, got 0",
+					},
+					{
+						Condition: prEntity.ConditionAuthorCannotMerge,
+						Message:   "pr must be merged by somebody other than author",
+					},
+				},
+			},
+		},
+
+		{
+			what: "unsupported action",
+
+			event: entity.PREvent{
+				Action:        entity.PRAction("labeled"),
+				PullRequestId: "gh-1",
+			},
+			expectedResult: entity.IngestResult{
+				Action:  entity.PRAction("labeled"),
+				Ignored: true,
+				Reason:  "unsupported action",
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+			mockDeliveryRepo := integrationMocks.NewMockDeliveryRepo(ctrl)
+			mockHostLinkRepo := integrationMocks.NewMockHostLinkRepo(ctrl)
+
+			if tc.callClaim {
+				mockDeliveryRepo.EXPECT().Claim(gomock.Any(), entity.SourceGithub, tc.event.DeliveryId).Return(tc.claimed, nil)
+			}
+
+			if tc.callRelease {
+				mockDeliveryRepo.EXPECT().Release(gomock.Any(), entity.SourceGithub, tc.event.DeliveryId).Return(nil)
+			}
+
+			if tc.callCreate {
+				mockPullRequestRepo.EXPECT().Create(
+					gomock.Any(),
+					prEntity.Matcher(prEntity.NewPullRequest("gh-1", "pr", "u1")),
+					"",
+					gomock.Any(),
+				).Return(openPR, tc.repoError)
+			}
+
+			if tc.callSave {
+				mockHostLinkRepo.EXPECT().Save(gomock.Any(), entity.HostLink{
+					PullRequestId: "gh-1",
+					Source:        entity.SourceGithub,
+					Repository:    "acme/pr-service",
+					Number:        42,
+				}).Return(tc.saveError)
+			}
+
+			if tc.callUpdate {
+				mockPullRequestRepo.EXPECT().UpdateStatus(gomock.Any(), "gh-1", gomock.Any()).DoAndReturn(
+					func(
+						_ context.Context,
+						_ string,
+						handler prInterfaces.UpdateStatusHandler,
+					) (prEntity.PullRequest, error) {
+						pr, updated, err := handler(openPR, "backend", nil, nil, nil)
+
+						assert.True(t, updated)
+
+						// merged_at is set by service, it is not compared
+						pr.MergedAt = time.Time{}
+
+						return pr, err
+					},
+				)
+			}
+
+			pullRequestService := pullrequestservice.CreatePullRequestService(
+				mockPullRequestRepo,
+				reviewerpicker.CreateRandomPicker(),
+				nil,
+				&prConfig,
+			)
+
+			service := ingestservice.CreateIngestService(
+				pullRequestService,
+				mockDeliveryRepo,
+				mockHostLinkRepo,
+				entity.SourceGithub,
+				users,
+			)
+
+			result, err := service.Ingest(context.Background(), tc.event)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
@@ -4,37 +4,221 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
 	memberErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/errors"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/interfaces"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
 )
 
 type MemberService struct {
-	repo interfaces.MemberRepo
+	repo    interfaces.MemberRepo
+	replace prInterfaces.ReplaceHandler
+	cfg     *config.PullRequestConfig
 }
 
-func CreateMemberService(repo interfaces.MemberRepo) interfaces.MemberService {
+func CreateMemberService(
+	repo interfaces.MemberRepo,
+	picker prInterfaces.ReviewerPicker,
+	cfg *config.PullRequestConfig,
+) interfaces.MemberService {
 	return &MemberService{
-		repo: repo,
+		repo:    repo,
+		replace: reviewerpicker.CreateReplaceHandler(picker),
+		cfg:     cfg,
 	}
 }
 
-func (s *MemberService) SetIsActive(ctx context.Context, userId string, isActive bool) (memberEntity.Member, error) {
+func (s *MemberService) SetIsActive(
+	ctx context.Context,
+	userId string,
+	isActive bool,
+	reassignReviews *bool,
+) (memberEntity.Member, prEntity.ReassignReport, error) {
 	activity := memberEntity.MemberInactive
 	if isActive {
 		activity = memberEntity.MemberActive
 	}
 
-	member, err := s.repo.SetActivity(ctx, userId, activity)
+	reassign := s.cfg.ReassignOnDeactivate
+	if reassignReviews != nil {
+		reassign = *reassignReviews
+	}
+
+	var replace prInterfaces.ReplaceHandler
+	if reassign && !isActive {
+		replace = s.replace
+	}
+
+	member, report, err := s.repo.SetActivity(ctx, userId, activity, replace)
+
+	if err != nil {
+		if errors.Is(err, memberErrors.ErrMemberNotFound) {
+			return memberEntity.Member{}, prEntity.ReassignReport{}, err
+		}
+
+		return memberEntity.Member{}, prEntity.ReassignReport{}, fmt.Errorf("failed to set active in repo: %w", err)
+	}
+
+	return member, report, nil
+}
+
+func (s *MemberService) SetMaxOpenReviews(
+	ctx context.Context,
+	userId string,
+	maxOpenReviews *int,
+) (memberEntity.Member, error) {
+	if maxOpenReviews != nil && *maxOpenReviews < 0 {
+		return memberEntity.Member{}, memberErrors.ErrInvalidMaxOpenReviews
+	}
+
+	member, err := s.repo.SetMaxOpenReviews(ctx, userId, maxOpenReviews)
 
 	if err != nil {
 		if errors.Is(err, memberErrors.ErrMemberNotFound) {
 			return memberEntity.Member{}, err
 		}
 
-		return memberEntity.Member{}, fmt.Errorf("failed to set active in repo: %w", err)
+		return memberEntity.Member{}, fmt.Errorf("failed to set max open reviews in repo: %w", err)
 	}
 
 	return member, nil
 }
+
+func (s *MemberService) AddUnavailability(
+	ctx context.Context,
+	userId string,
+	kind memberEntity.UnavailabilityKind,
+	startsAt, endsAt time.Time,
+) (memberEntity.Unavailability, error) {
+	unavailability := memberEntity.NewUnavailability(userId, kind, startsAt, endsAt)
+
+	if !unavailability.IsValid() {
+		return memberEntity.Unavailability{}, memberErrors.ErrInvalidUnavailability
+	}
+
+	created, err := s.repo.AddUnavailability(ctx, unavailability)
+
+	if err != nil {
+		if errors.Is(err, memberErrors.ErrMemberNotFound) {
+			return memberEntity.Unavailability{}, err
+		}
+
+		return memberEntity.Unavailability{}, fmt.Errorf("failed to add unavailability in repo: %w", err)
+	}
+
+	return created, nil
+}
+
+func (s *MemberService) GetUnavailability(ctx context.Context, userId string) ([]memberEntity.Unavailability, error) {
+	unavailability, err := s.repo.GetUnavailability(ctx, userId)
+
+	if err != nil {
+		if errors.Is(err, memberErrors.ErrMemberNotFound) {
+			return []memberEntity.Unavailability{}, err
+		}
+
+		return []memberEntity.Unavailability{}, fmt.Errorf("failed to get unavailability from repo: %w", err)
+	}
+
+	return unavailability, nil
+}
+
+func (s *MemberService) UpdateUnavailability(
+	ctx context.Context,
+	unavailabilityId string,
+	kind memberEntity.UnavailabilityKind,
+	startsAt, endsAt time.Time,
+) (memberEntity.Unavailability, error) {
+	unavailability := memberEntity.Unavailability{
+		Id:       unavailabilityId,
+		Kind:     kind,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+	}
+
+	if !unavailability.IsValid() {
+		return memberEntity.Unavailability{}, memberErrors.ErrInvalidUnavailability
+	}
+
+	updated, err := s.repo.UpdateUnavailability(ctx, unavailability)
+
+	if err != nil {
+		if errors.Is(err, memberErrors.ErrUnavailabilityNotFound) {
+			return memberEntity.Unavailability{}, err
+		}
+
+		return memberEntity.Unavailability{}, fmt.Errorf("failed to update unavailability in repo: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (s *MemberService) DeleteUnavailability(ctx context.Context, unavailabilityId string) error {
+	if err := s.repo.DeleteUnavailability(ctx, unavailabilityId); err != nil {
+		if errors.Is(err, memberErrors.ErrUnavailabilityNotFound) {
+			return err
+		}
+
+		return fmt.Errorf("failed to delete unavailability in repo: %w", err)
+	}
+
+	return nil
+}
+
+func (s *MemberService) GetSkills(ctx context.Context, userId string) ([]string, error) {
+	skills, err := s.repo.GetSkills(ctx, userId)
+
+	if err != nil {
+		if errors.Is(err, memberErrors.ErrMemberNotFound) {
+			return []string{}, err
+		}
+
+		return []string{}, fmt.Errorf("failed to get skills from repo: %w", err)
+	}
+
+	return skills, nil
+}
+
+func (s *MemberService) SetSkills(ctx context.Context, userId string, skills []string) ([]string, error) {
+	return s.changeSkills(ctx, userId, skills, "set", s.repo.SetSkills)
+}
+
+func (s *MemberService) AddSkills(ctx context.Context, userId string, skills []string) ([]string, error) {
+	return s.changeSkills(ctx, userId, skills, "add", s.repo.AddSkills)
+}
+
+func (s *MemberService) DeleteSkills(ctx context.Context, userId string, skills []string) ([]string, error) {
+	return s.changeSkills(ctx, userId, skills, "delete", s.repo.DeleteSkills)
+}
+
+// skills are normalized before change, so tags differing only in case are the same skill
+func (s *MemberService) changeSkills(
+	ctx context.Context,
+	userId string,
+	skills []string,
+	action string,
+	change func(ctx context.Context, userId string, skills []string) ([]string, error),
+) ([]string, error) {
+	skills, ok := memberEntity.NormalizeTags(skills)
+
+	if !ok {
+		return []string{}, memberErrors.ErrInvalidSkills
+	}
+
+	res, err := change(ctx, userId, skills)
+
+	if err != nil {
+		if errors.Is(err, memberErrors.ErrMemberNotFound) {
+			return []string{}, err
+		}
+
+		return []string{}, fmt.Errorf("failed to %s skills in repo: %w", action, err)
+	}
+
+	return res, nil
+}
@@ -4,12 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	memberservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/member"
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
 	memberErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/errors"
 	memberMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/mocks"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -17,10 +23,24 @@ import (
 func TestSetIsActive(t *testing.T) {
 	userId := "u1"
 
+	enabled := true
+	disabled := false
+
+	teamMembers := []memberEntity.Member{
+		{
+			Id:       "u2",
+			Activity: memberEntity.MemberActive,
+		},
+	}
+
 	type testCase struct {
 		what     string
 		isActive bool
 
+		reassignOnDeactivate bool
+		reassignReviews      *bool
+		expectReplace        bool
+
 		expectedActivity memberEntity.MemberActivity
 		expectedMember   memberEntity.Member
 		repoError        error
@@ -72,6 +92,63 @@ func TestSetIsActive(t *testing.T) {
 			repoError: nil,
 			noError:   true,
 		},
+
+		{
+			what: "reassign reviews by config",
+
+			isActive:             false,
+			reassignOnDeactivate: true,
+			expectReplace:        true,
+			expectedActivity:     memberEntity.MemberInactive,
+			expectedMember: memberEntity.Member{
+				Id:       userId,
+				Activity: memberEntity.MemberInactive,
+			},
+			noError: true,
+		},
+
+		{
+			what: "reassign reviews by request",
+
+			isActive:         false,
+			reassignReviews:  &enabled,
+			expectReplace:    true,
+			expectedActivity: memberEntity.MemberInactive,
+			expectedMember: memberEntity.Member{
+				Id:       userId,
+				Activity: memberEntity.MemberInactive,
+			},
+			noError: true,
+		},
+
+		{
+			what: "request overrides config",
+
+			isActive:             false,
+			reassignOnDeactivate: true,
+			reassignReviews:      &disabled,
+			expectReplace:        false,
+			expectedActivity:     memberEntity.MemberInactive,
+			expectedMember: memberEntity.Member{
+				Id:       userId,
+				Activity: memberEntity.MemberInactive,
+			},
+			noError: true,
+		},
+
+		{
+			what: "nothing to reassign on activation",
+
+			isActive:         true,
+			reassignReviews:  &enabled,
+			expectReplace:    false,
+			expectedActivity: memberEntity.MemberActive,
+			expectedMember: memberEntity.Member{
+				Id:       userId,
+				Activity: memberEntity.MemberActive,
+			},
+			noError: true,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -85,11 +162,42 @@ func TestSetIsActive(t *testing.T) {
 				gomock.Any(),
 				userId,
 				tc.expectedActivity,
-			).Return(tc.expectedMember, tc.repoError)
+				gomock.Any(),
+			).DoAndReturn(func(
+				ctx context.Context,
+				userId string,
+				activity memberEntity.MemberActivity,
+				replace prInterfaces.ReplaceHandler,
+			) (memberEntity.Member, prEntity.ReassignReport, error) {
+				if !tc.expectReplace {
+					assert.Nil(t, replace)
+					return tc.expectedMember, prEntity.ReassignReport{}, tc.repoError
+				}
+
+				assert.NotNil(t, replace)
 
-			service := memberservice.CreateMemberService(mockMemberRepo)
+				pr := prEntity.PullRequest{
+					Id:        "pr1",
+					AuthorId:  "u3",
+					Status:    prEntity.PROpen,
+					Reviewers: []string{userId},
+				}
+
+				newReviewer, err := replace(pr, "team1", teamMembers)
+
+				assert.NoError(t, err)
+				assert.Equal(t, "u2", newReviewer)
 
-			member, err := service.SetIsActive(context.Background(), userId, tc.isActive)
+				return tc.expectedMember, prEntity.ReassignReport{}, tc.repoError
+			})
+
+			cfg := config.PullRequestConfig{
+				ReassignOnDeactivate: tc.reassignOnDeactivate,
+			}
+
+			service := memberservice.CreateMemberService(mockMemberRepo, reviewerpicker.CreateRandomPicker(), &cfg)
+
+			member, _, err := service.SetIsActive(context.Background(), userId, tc.isActive, tc.reassignReviews)
 
 			if tc.noError {
 				assert.NoError(t, err)
@@ -100,3 +208,316 @@ func TestSetIsActive(t *testing.T) {
 		})
 	}
 }
+
+func TestSetMaxOpenReviews(t *testing.T) {
+	userId := "u1"
+
+	limit := 3
+	negative := -1
+
+	type testCase struct {
+		what           string
+		maxOpenReviews *int
+
+		callRepo       bool
+		expectedMember memberEntity.Member
+		repoError      error
+		expectedError  string
+		noError        bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "negative limit",
+
+			maxOpenReviews: &negative,
+			callRepo:       false,
+			expectedError:  memberErrors.ErrInvalidMaxOpenReviews.Error(),
+		},
+
+		{
+			what: "member not found",
+
+			maxOpenReviews: &limit,
+			callRepo:       true,
+			repoError:      memberErrors.ErrMemberNotFound,
+			expectedError:  memberErrors.ErrMemberNotFound.Error(),
+		},
+
+		{
+			what: "failed to set max open reviews in repo",
+
+			maxOpenReviews: &limit,
+			callRepo:       true,
+			repoError:      errors.New("db is down"),
+			expectedError:  "failed to set max open reviews in repo: db is down",
+		},
+
+		{
+			what: "successfully set limit",
+
+			maxOpenReviews: &limit,
+			callRepo:       true,
+			expectedMember: memberEntity.Member{
+				Id:             userId,
+				Activity:       memberEntity.MemberActive,
+				MaxOpenReviews: &limit,
+			},
+			repoError: nil,
+			noError:   true,
+		},
+
+		{
+			what: "successfully remove limit",
+
+			maxOpenReviews: nil,
+			callRepo:       true,
+			expectedMember: memberEntity.Member{
+				Id:       userId,
+				Activity: memberEntity.MemberActive,
+			},
+			repoError: nil,
+			noError:   true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMemberRepo := memberMocks.NewMockMemberRepo(ctrl)
+
+			if tc.callRepo {
+				mockMemberRepo.EXPECT().SetMaxOpenReviews(
+					gomock.Any(),
+					userId,
+					tc.maxOpenReviews,
+				).Return(tc.expectedMember, tc.repoError)
+			}
+
+			service := memberservice.CreateMemberService(mockMemberRepo, reviewerpicker.CreateRandomPicker(), &config.PullRequestConfig{})
+
+			member, err := service.SetMaxOpenReviews(context.Background(), userId, tc.maxOpenReviews)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedMember, member)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestUpdateUnavailability(t *testing.T) {
+	unavailabilityId := "un1"
+
+	startsAt := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		what string
+
+		kind     memberEntity.UnavailabilityKind
+		startsAt time.Time
+		endsAt   time.Time
+
+		callRepo       bool
+		expectedResult memberEntity.Unavailability
+		repoError      error
+		expectedError  string
+		noError        bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "unknown kind",
+
+			kind:          "DAY_OFF",
+			startsAt:      startsAt,
+			endsAt:        endsAt,
+			callRepo:      false,
+			expectedError: memberErrors.ErrInvalidUnavailability.Error(),
+		},
+
+		{
+			what: "empty range",
+
+			kind:          memberEntity.UnavailabilityVacation,
+			startsAt:      startsAt,
+			endsAt:        startsAt,
+			callRepo:      false,
+			expectedError: memberErrors.ErrInvalidUnavailability.Error(),
+		},
+
+		{
+			what: "unavailability not found",
+
+			kind:          memberEntity.UnavailabilitySickLeave,
+			startsAt:      startsAt,
+			endsAt:        endsAt,
+			callRepo:      true,
+			repoError:     memberErrors.ErrUnavailabilityNotFound,
+			expectedError: memberErrors.ErrUnavailabilityNotFound.Error(),
+		},
+
+		{
+			what: "failed to update in repo",
+
+			kind:          memberEntity.UnavailabilitySickLeave,
+			startsAt:      startsAt,
+			endsAt:        endsAt,
+			callRepo:      true,
+			repoError:     errors.New("db is down"),
+			expectedError: "failed to update unavailability in repo: db is down",
+		},
+
+		{
+			what: "successfully updated",
+
+			kind:     memberEntity.UnavailabilityOnCall,
+			startsAt: startsAt,
+			endsAt:   endsAt,
+			callRepo: true,
+			expectedResult: memberEntity.Unavailability{
+				Id:       unavailabilityId,
+				MemberId: "u1",
+				Kind:     memberEntity.UnavailabilityOnCall,
+				StartsAt: startsAt,
+				EndsAt:   endsAt,
+			},
+			noError: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMemberRepo := memberMocks.NewMockMemberRepo(ctrl)
+
+			if tc.callRepo {
+				mockMemberRepo.EXPECT().UpdateUnavailability(
+					gomock.Any(),
+					memberEntity.Unavailability{
+						Id:       unavailabilityId,
+						Kind:     tc.kind,
+						StartsAt: tc.startsAt,
+						EndsAt:   tc.endsAt,
+					},
+				).Return(tc.expectedResult, tc.repoError)
+			}
+
+			service := memberservice.CreateMemberService(mockMemberRepo, reviewerpicker.CreateRandomPicker(), &config.PullRequestConfig{})
+
+			updated, err := service.UpdateUnavailability(
+				context.Background(),
+				unavailabilityId,
+				tc.kind,
+				tc.startsAt,
+				tc.endsAt,
+			)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, updated)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestChangeSkills(t *testing.T) {
+	userId := "u1"
+
+	type testCase struct {
+		what   string
+		skills []string
+
+		callRepo       bool
+		expectedSkills []string
+		repoSkills     []string
+		repoError      error
+		expectedError  string
+		noError        bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "empty skill",
+
+			skills:        []string{"postgres", "  "},
+			callRepo:      false,
+			expectedError: memberErrors.ErrInvalidSkills.Error(),
+		},
+
+		{
+			what: "too long skill",
+
+			skills:        []string{strings.Repeat("a", memberEntity.MaxTagLength+1)},
+			callRepo:      false,
+			expectedError: memberErrors.ErrInvalidSkills.Error(),
+		},
+
+		{
+			what: "member not found",
+
+			skills:         []string{"postgres"},
+			callRepo:       true,
+			expectedSkills: []string{"postgres"},
+			repoError:      memberErrors.ErrMemberNotFound,
+			expectedError:  memberErrors.ErrMemberNotFound.Error(),
+		},
+
+		{
+			what: "failed to add skills in repo",
+
+			skills:         []string{"postgres"},
+			callRepo:       true,
+			expectedSkills: []string{"postgres"},
+			repoError:      errors.New("db is down"),
+			expectedError:  "failed to add skills in repo: db is down",
+		},
+
+		{
+			what: "skills are normalized",
+
+			skills:         []string{" Security", "postgres", "SECURITY"},
+			callRepo:       true,
+			expectedSkills: []string{"postgres", "security"},
+			repoSkills:     []string{"frontend", "postgres", "security"},
+			noError:        true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMemberRepo := memberMocks.NewMockMemberRepo(ctrl)
+
+			if tc.callRepo {
+				mockMemberRepo.EXPECT().AddSkills(
+					gomock.Any(),
+					userId,
+					tc.expectedSkills,
+				).Return(tc.repoSkills, tc.repoError)
+			}
+
+			service := memberservice.CreateMemberService(mockMemberRepo, reviewerpicker.CreateRandomPicker(), &config.PullRequestConfig{})
+
+			skills, err := service.AddSkills(context.Background(), userId, tc.skills)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.repoSkills, skills)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package mergepolicy
+
+import (
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+)
+
+// TeamPolicies chooses merge policy by team name, teams override only some conditions
+type TeamPolicies struct {
+	defaultPolicy prEntity.MergePolicy
+	teamPolicies  map[string]prEntity.MergePolicy
+}
+
+func CreateTeamPolicies(cfg *config.MergePolicyConfig) *TeamPolicies {
+	defaultPolicy := prEntity.MergePolicy{
+		MinApprovals:         cfg.MinApprovals,
+		NoChangesRequested:   cfg.NoChangesRequested,
+		RequiredReviewerTeam: cfg.RequiredReviewerTeam,
+		AuthorCannotMerge:    cfg.AuthorCannotMerge,
+	}
+
+	teamPolicies := make(map[string]prEntity.MergePolicy, len(cfg.Teams))
+
+	for teamName, override := range cfg.Teams {
+		policy := defaultPolicy
+
+		if override.MinApprovals != nil {
+			policy.MinApprovals = *override.MinApprovals
+		}
+
+		if override.NoChangesRequested != nil {
+			policy.NoChangesRequested = *override.NoChangesRequested
+		}
+
+		if override.RequiredReviewerTeam != nil {
+			policy.RequiredReviewerTeam = *override.RequiredReviewerTeam
+		}
+
+		if override.AuthorCannotMerge != nil {
+			policy.AuthorCannotMerge = *override.AuthorCannotMerge
+		}
+
+		teamPolicies[teamName] = policy
+	}
+
+	return &TeamPolicies{
+		defaultPolicy: defaultPolicy,
+		teamPolicies:  teamPolicies,
+	}
+}
+
+func (p *TeamPolicies) ForTeam(teamName string) prEntity.MergePolicy {
+	if policy, ok := p.teamPolicies[teamName]; ok {
+		return policy
+	}
+
+	return p.defaultPolicy
+}
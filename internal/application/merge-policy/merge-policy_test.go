@@ -0,0 +1,50 @@
+package mergepolicy_test
+
+import (
+	"testing"
+
+	mergepolicy "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/merge-policy"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamPolicies(t *testing.T) {
+	minApprovals := 2
+	noChangesRequested := false
+	reviewerTeam := ""
+
+	policies := mergepolicy.CreateTeamPolicies(&config.MergePolicyConfig{
+		MinApprovals:         1,
+		NoChangesRequested:   true,
+		RequiredReviewerTeam: "security",
+		Teams: map[string]config.MergePolicyOverride{
+			"backend": {
+				MinApprovals: &minApprovals,
+			},
+			"frontend": {
+				NoChangesRequested:   &noChangesRequested,
+				RequiredReviewerTeam: &reviewerTeam,
+			},
+		},
+	})
+
+	// default policy
+	assert.Equal(t, prEntity.MergePolicy{
+		MinApprovals:         1,
+		NoChangesRequested:   true,
+		RequiredReviewerTeam: "security",
+	}, policies.ForTeam("analytics"))
+
+	// omitted fields are taken from default policy
+	assert.Equal(t, prEntity.MergePolicy{
+		MinApprovals:         2,
+		NoChangesRequested:   true,
+		RequiredReviewerTeam: "security",
+	}, policies.ForTeam("backend"))
+
+	// rules can be disabled for team
+	assert.Equal(t, prEntity.MergePolicy{
+		MinApprovals: 1,
+	}, policies.ForTeam("frontend"))
+}
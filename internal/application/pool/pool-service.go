@@ -0,0 +1,116 @@
+package poolservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	poolEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/entity"
+	poolErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/interfaces"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
+)
+
+type PoolService struct {
+	repo interfaces.PoolRepo
+}
+
+func CreatePoolService(repo interfaces.PoolRepo) interfaces.PoolService {
+	return &PoolService{
+		repo: repo,
+	}
+}
+
+func (s *PoolService) Upsert(ctx context.Context, name string, memberIds []string) (poolEntity.Pool, error) {
+	pool := poolEntity.NewPool(name, memberIds)
+
+	if !pool.IsValid() {
+		return poolEntity.Pool{}, poolErrors.ErrInvalidPoolName
+	}
+
+	stored, err := s.repo.Upsert(ctx, pool)
+
+	if err != nil {
+		if errors.Is(err, poolErrors.ErrMemberNotFound) {
+			return poolEntity.Pool{}, err
+		}
+
+		return poolEntity.Pool{}, fmt.Errorf("failed to upsert pool to repo: %w", err)
+	}
+
+	return stored, nil
+}
+
+func (s *PoolService) GetByName(ctx context.Context, name string) (poolEntity.Pool, error) {
+	pool, err := s.repo.GetByName(ctx, name)
+
+	if err != nil {
+		if errors.Is(err, poolErrors.ErrPoolNotFound) {
+			return poolEntity.Pool{}, err
+		}
+
+		return poolEntity.Pool{}, fmt.Errorf("failed to get pool from repo: %w", err)
+	}
+
+	return pool, nil
+}
+
+func (s *PoolService) List(ctx context.Context) ([]poolEntity.Pool, error) {
+	pools, err := s.repo.List(ctx)
+
+	if err != nil {
+		return []poolEntity.Pool{}, fmt.Errorf("failed to list pools in repo: %w", err)
+	}
+
+	return pools, nil
+}
+
+func (s *PoolService) Delete(ctx context.Context, name string) error {
+	err := s.repo.Delete(ctx, name)
+
+	if errors.Is(err, poolErrors.ErrPoolNotFound) {
+		return err
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to delete pool in repo: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PoolService) AttachTeam(
+	ctx context.Context,
+	poolName, teamName string,
+	reviewersCount int,
+) (poolEntity.Pool, error) {
+	if reviewersCount < 1 {
+		return poolEntity.Pool{}, poolErrors.ErrInvalidReviewersCount
+	}
+
+	pool, err := s.repo.AttachTeam(ctx, poolName, teamName, reviewersCount)
+
+	if err != nil {
+		if errors.Is(err, poolErrors.ErrPoolNotFound) || errors.Is(err, teamErrors.ErrTeamNotFound) {
+			return poolEntity.Pool{}, err
+		}
+
+		return poolEntity.Pool{}, fmt.Errorf("failed to attach team to pool in repo: %w", err)
+	}
+
+	return pool, nil
+}
+
+func (s *PoolService) DetachTeam(ctx context.Context, poolName, teamName string) (poolEntity.Pool, error) {
+	pool, err := s.repo.DetachTeam(ctx, poolName, teamName)
+
+	if err != nil {
+		if errors.Is(err, poolErrors.ErrPoolNotFound) || errors.Is(err, poolErrors.ErrTeamNotAttached) {
+			return poolEntity.Pool{}, err
+		}
+
+		return poolEntity.Pool{}, fmt.Errorf("failed to detach team from pool in repo: %w", err)
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,206 @@
+package poolservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	poolservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/pool"
+	poolEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/entity"
+	poolErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/errors"
+	poolMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/mocks"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpsert(t *testing.T) {
+	type testCase struct {
+		what string
+
+		name              string
+		memberIds         []string
+		callRepo          bool
+		repoError         error
+		expectedMemberIds []string
+		expectedError     string
+		noError           bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "empty pool name",
+
+			name:          "",
+			memberIds:     []string{"u1"},
+			expectedError: poolErrors.ErrInvalidPoolName.Error(),
+		},
+
+		{
+			what: "too long pool name",
+
+			name:          strings.Repeat("p", 65),
+			memberIds:     []string{"u1"},
+			expectedError: poolErrors.ErrInvalidPoolName.Error(),
+		},
+
+		{
+			what: "unknown member",
+
+			name:              "platform",
+			memberIds:         []string{"u1", "u404"},
+			callRepo:          true,
+			repoError:         poolErrors.ErrMemberNotFound,
+			expectedMemberIds: []string{"u1", "u404"},
+			expectedError:     poolErrors.ErrMemberNotFound.Error(),
+		},
+
+		{
+			what: "failed to upsert pool",
+
+			name:              "platform",
+			memberIds:         []string{"u1"},
+			callRepo:          true,
+			repoError:         errors.New("db is down"),
+			expectedMemberIds: []string{"u1"},
+			expectedError:     "failed to upsert pool to repo: db is down",
+		},
+
+		{
+			what: "members are sorted and deduplicated",
+
+			name:              "platform",
+			memberIds:         []string{"u3", "u1", "u3"},
+			callRepo:          true,
+			expectedMemberIds: []string{"u1", "u3"},
+			noError:           true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPoolRepo := poolMocks.NewMockPoolRepo(ctrl)
+
+			if tc.callRepo {
+				mockPoolRepo.EXPECT().Upsert(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, pool poolEntity.Pool) (poolEntity.Pool, error) {
+						assert.Equal(t, tc.name, pool.Name)
+						assert.Equal(t, tc.expectedMemberIds, pool.MemberIds)
+
+						return pool, tc.repoError
+					},
+				)
+			}
+
+			service := poolservice.CreatePoolService(mockPoolRepo)
+
+			pool, err := service.Upsert(context.Background(), tc.name, tc.memberIds)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedMemberIds, pool.MemberIds)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestAttachTeam(t *testing.T) {
+	type testCase struct {
+		what string
+
+		reviewersCount int
+		callRepo       bool
+		repoError      error
+		expectedError  string
+		noError        bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "zero reviewers",
+
+			reviewersCount: 0,
+			expectedError:  poolErrors.ErrInvalidReviewersCount.Error(),
+		},
+
+		{
+			what: "pool not found",
+
+			reviewersCount: 1,
+			callRepo:       true,
+			repoError:      poolErrors.ErrPoolNotFound,
+			expectedError:  poolErrors.ErrPoolNotFound.Error(),
+		},
+
+		{
+			what: "team not found",
+
+			reviewersCount: 1,
+			callRepo:       true,
+			repoError:      teamErrors.ErrTeamNotFound,
+			expectedError:  teamErrors.ErrTeamNotFound.Error(),
+		},
+
+		{
+			what: "failed to attach team",
+
+			reviewersCount: 1,
+			callRepo:       true,
+			repoError:      errors.New("db is down"),
+			expectedError:  "failed to attach team to pool in repo: db is down",
+		},
+
+		{
+			what: "successfully attached",
+
+			reviewersCount: 2,
+			callRepo:       true,
+			noError:        true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPoolRepo := poolMocks.NewMockPoolRepo(ctrl)
+
+			stored := poolEntity.Pool{
+				Name:      "platform",
+				MemberIds: []string{"u1"},
+				Teams: []poolEntity.TeamQuota{
+					{
+						TeamName:       "backend",
+						ReviewersCount: tc.reviewersCount,
+					},
+				},
+			}
+
+			if tc.callRepo {
+				mockPoolRepo.
+					EXPECT().
+					AttachTeam(gomock.Any(), "platform", "backend", tc.reviewersCount).
+					Return(stored, tc.repoError)
+			}
+
+			service := poolservice.CreatePoolService(mockPoolRepo)
+
+			pool, err := service.AttachTeam(context.Background(), "platform", "backend", tc.reviewersCount)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, stored, pool)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
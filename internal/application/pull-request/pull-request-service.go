@@ -4,65 +4,220 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math/rand"
+	"slices"
 	"time"
 
+	mergepolicy "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/merge-policy"
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	codeownersEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/entity"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
+	poolEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/entity"
 	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
 	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
 )
 
 type PullRequestService struct {
-	repo interfaces.PullRequestRepo
-	cfg  *config.PullRequestConfig
+	repo          interfaces.PullRequestRepo
+	picker        interfaces.ReviewerPicker
+	replace       interfaces.ReplaceHandler
+	mergePolicies *mergepolicy.TeamPolicies
+	// team name -> rules from config, used when team has no uploaded ones
+	codeowners map[string]codeownersEntity.Ruleset
+	cfg        *config.PullRequestConfig
 }
 
-func CreatePullRequestService(repo interfaces.PullRequestRepo, cfg *config.PullRequestConfig) interfaces.PullRequestService {
+func CreatePullRequestService(
+	repo interfaces.PullRequestRepo,
+	picker interfaces.ReviewerPicker,
+	codeowners map[string]codeownersEntity.Ruleset,
+	cfg *config.PullRequestConfig,
+) interfaces.PullRequestService {
 	return &PullRequestService{
-		repo: repo,
-		cfg:  cfg,
+		repo:          repo,
+		picker:        picker,
+		replace:       reviewerpicker.CreateReplaceHandler(picker),
+		mergePolicies: mergepolicy.CreateTeamPolicies(&cfg.MergePolicy),
+		codeowners:    codeowners,
+		cfg:           cfg,
 	}
 }
 
-func (s *PullRequestService) GetByReviewer(ctx context.Context, reviewerId string) ([]prEntity.PullRequest, error) {
-	prs, err := s.repo.GetByReviewer(ctx, reviewerId, s.cfg.OutLimit)
+func (s *PullRequestService) GetById(ctx context.Context, prId string) (prEntity.PullRequest, error) {
+	pr, err := s.repo.GetById(ctx, prId)
+
+	if errors.Is(err, prErrors.ErrNotFound) {
+		return prEntity.PullRequest{}, err
+	}
+
+	if err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to get pull request from repo: %w", err)
+	}
+
+	return pr, nil
+}
+
+func (s *PullRequestService) List(
+	ctx context.Context,
+	query prEntity.PRListQuery,
+) ([]prEntity.PullRequest, *pagination.Cursor, error) {
+	query, err := s.normalizeListQuery(query)
+
+	if err != nil {
+		return []prEntity.PullRequest{}, nil, err
+	}
+
+	pageSize := query.Limit
+
+	// one extra item tells whether the next page exists
+	query.Limit++
+
+	prs, err := s.repo.List(ctx, query)
+
+	if errors.Is(err, pagination.ErrInvalidCursor) {
+		return []prEntity.PullRequest{}, nil, err
+	}
 
 	if err != nil {
-		return []prEntity.PullRequest{}, fmt.Errorf("failed to get pull requests from repo: %w", err)
+		return []prEntity.PullRequest{}, nil, fmt.Errorf("failed to list pull requests in repo: %w", err)
 	}
 
-	return prs, nil
+	prs, next := pagination.NextPage(prs, pageSize, func(pr prEntity.PullRequest) pagination.Cursor {
+		return pr.Cursor(query.SortBy)
+	})
+
+	return prs, next, nil
 }
 
-func (s *PullRequestService) Create(ctx context.Context, prId, prName, authorId string) (prEntity.PullRequest, error) {
+// fills defaults and validates list query
+func (s *PullRequestService) normalizeListQuery(query prEntity.PRListQuery) (prEntity.PRListQuery, error) {
+	if query.SortBy == "" {
+		query.SortBy = prEntity.PRSortCreatedAt
+	}
+
+	if query.Order == "" {
+		query.Order = pagination.OrderAsc
+	}
+
+	if query.Limit == 0 {
+		query.Limit = s.cfg.OutLimit
+	}
+
+	if !query.SortBy.IsValid() {
+		return query, fmt.Errorf("%w: unknown sort field %s", prErrors.ErrInvalidListQuery, query.SortBy)
+	}
+
+	if !query.Order.IsValid() {
+		return query, fmt.Errorf("%w: unknown order %s", prErrors.ErrInvalidListQuery, query.Order)
+	}
+
+	if query.Filter.Status != "" && !query.Filter.Status.IsValid() {
+		return query, fmt.Errorf("%w: unknown status %s", prErrors.ErrInvalidListQuery, query.Filter.Status)
+	}
+
+	if query.Limit < 1 || query.Limit > s.cfg.OutLimit {
+		return query, fmt.Errorf("%w: limit must be between 1 and %d", prErrors.ErrInvalidListQuery, s.cfg.OutLimit)
+	}
+
+	from, to := query.Filter.CreatedFrom, query.Filter.CreatedTo
+	if from != nil && to != nil && !from.Before(*to) {
+		return query, fmt.Errorf("%w: created_from must be before created_to", prErrors.ErrInvalidListQuery)
+	}
+
+	return query, nil
+}
+
+// review queue of reviewer in stable (created_at, id) order, only OPEN PRs by default
+func (s *PullRequestService) GetByReviewer(
+	ctx context.Context,
+	query prEntity.ReviewQueueQuery,
+) ([]prEntity.PullRequest, *pagination.Cursor, error) {
+	status := query.Status
+
+	switch status {
+	case "":
+		status = prEntity.PROpen
+
+	case prEntity.ReviewQueueAnyStatus:
+		status = ""
+	}
+
+	return s.List(ctx, prEntity.PRListQuery{
+		Filter: prEntity.PRFilter{
+			ReviewerId: query.ReviewerId,
+			Status:     status,
+		},
+		SortBy: prEntity.PRSortCreatedAt,
+		Order:  query.Order,
+		After:  query.After,
+		Limit:  query.Limit,
+	})
+}
+
+// owners of changedFiles are preferred as reviewers, changed files of draft are not kept.
+// labels are kept, so they are matched with skills on every assignment
+func (s *PullRequestService) Create(
+	ctx context.Context,
+	prId, prName, authorId, teamName string,
+	draft bool,
+	changedFiles []string,
+	labels []string,
+) (prEntity.PullRequest, error) {
+	labels, ok := memberEntity.NormalizeTags(labels)
+
+	if !ok {
+		return prEntity.PullRequest{}, prErrors.ErrInvalidLabels
+	}
+
 	pr := prEntity.NewPullRequest(prId, prName, authorId)
+	if draft {
+		pr = prEntity.NewDraftPullRequest(prId, prName, authorId)
+	}
 
-	prWithReviewers, err := s.repo.Create(ctx, pr, func(authorId string, members []memberEntity.Member) []string {
-		activeMembers := make([]string, 0, len(members))
+	pr.Labels = labels
+
+	prWithReviewers, err := s.repo.Create(ctx, pr, teamName, func(
+		authorId string,
+		teamName string,
+		members []memberEntity.Member,
+		codeowners *codeownersEntity.Ruleset,
+		pools []poolEntity.PoolQuota,
+	) ([]string, error) {
+		// reviewers are assigned only on entering OPEN
+		if pr.Status != prEntity.PROpen {
+			return []string{}, nil
+		}
 
-		for _, member := range members {
-			if member.Activity == memberEntity.MemberActive && member.Id != authorId {
-				activeMembers = append(activeMembers, member.Id)
-			}
+		if codeowners == nil {
+			ruleset := s.codeowners[teamName]
+			codeowners = &ruleset
 		}
 
-		resultLen := min(s.cfg.TargetReviewersCount, len(activeMembers))
+		picked, err := s.pickOwnersFirst(
+			interfaces.TeamScope(teamName),
+			reviewCandidates(pr, members),
+			codeowners.Owners(changedFiles),
+			pr.Labels,
+			s.cfg.TargetReviewersCount,
+		)
 
-		if len(activeMembers) > resultLen {
-			// get resultLen random activeMembers with O(resultLen) complexity
-			for i := range resultLen {
-				index := rand.Intn(len(activeMembers)-i) + i
-				activeMembers[i], activeMembers[index] = activeMembers[index], activeMembers[i]
-			}
+		if err != nil {
+			return nil, err
 		}
 
-		return activeMembers[:resultLen]
+		prWithTeamReviewers := pr
+		prWithTeamReviewers.Reviewers = picked
+
+		return s.pickFromPools(teamName, prWithTeamReviewers, pools)
 	})
 
 	if err != nil {
-		if errors.Is(err, prErrors.ErrAlreadyExists) {
+		if errors.Is(err, prErrors.ErrAlreadyExists) ||
+			errors.Is(err, prErrors.ErrNoReviewerCapacity) ||
+			errors.Is(err, prErrors.ErrTeamArchived) {
+
 			return prEntity.PullRequest{}, err
 		}
 
@@ -72,26 +227,140 @@ func (s *PullRequestService) Create(ctx context.Context, prId, prName, authorId
 	return prWithReviewers, nil
 }
 
-func (s *PullRequestService) Merge(ctx context.Context, prId string) (prEntity.PullRequest, error) {
-	mergedPr, err := s.repo.UpdateStatus(ctx, prId, func(pr prEntity.PullRequest) (prEntity.PullRequest, bool) {
-		if pr.Status == prEntity.PRMerged {
-			return pr, false
+// mergedBy is id of user, who merges pr, it is checked by merge policy of pr team
+func (s *PullRequestService) Merge(ctx context.Context, prId string, mergedBy string) (prEntity.PullRequest, error) {
+	checkPolicy := func(pr prEntity.PullRequest, teamName string, reviewers []memberEntity.Member) error {
+		unmet := s.mergePolicies.ForTeam(teamName).Check(pr, reviewers, mergedBy)
+
+		if len(unmet) > 0 {
+			return &prErrors.MergePolicyError{Unmet: unmet}
 		}
 
-		pr.MergedAt = time.Now()
-		pr.Status = prEntity.PRMerged
-		return pr, true
+		return nil
+	}
+
+	return s.changeStatus(ctx, prId, prEntity.PRMerged, "merge", checkPolicy)
+}
+
+// merge is already done outside of service (on code host), so merge policy is not enforced,
+// unmet conditions are only returned. They are empty, when pr is already merged
+func (s *PullRequestService) RecordMerge(
+	ctx context.Context,
+	prId string,
+	mergedBy string,
+) (prEntity.PullRequest, []prEntity.UnmetCondition, error) {
+	unmet := make([]prEntity.UnmetCondition, 0)
+
+	reportPolicy := func(pr prEntity.PullRequest, teamName string, reviewers []memberEntity.Member) error {
+		unmet = s.mergePolicies.ForTeam(teamName).Check(pr, reviewers, mergedBy)
+		return nil
+	}
+
+	pr, err := s.changeStatus(ctx, prId, prEntity.PRMerged, "record merge", reportPolicy)
+
+	if err != nil {
+		return prEntity.PullRequest{}, nil, err
+	}
+
+	return pr, unmet, nil
+}
+
+func (s *PullRequestService) Close(ctx context.Context, prId string) (prEntity.PullRequest, error) {
+	return s.changeStatus(ctx, prId, prEntity.PRClosed, "close", nil)
+}
+
+func (s *PullRequestService) Reopen(ctx context.Context, prId string) (prEntity.PullRequest, error) {
+	return s.changeStatus(ctx, prId, prEntity.PROpen, "reopen", nil, prEntity.PRClosed)
+}
+
+func (s *PullRequestService) Ready(ctx context.Context, prId string) (prEntity.PullRequest, error) {
+	return s.changeStatus(ctx, prId, prEntity.PROpen, "mark ready", nil, prEntity.PRDraft)
+}
+
+// checked before transition, when pr status is going to change
+type transitionPrecondition func(pr prEntity.PullRequest, teamName string, reviewers []memberEntity.Member) error
+
+// moves pr to the status, repeated transition is a no-op. allowedFrom narrows
+// status machine for operations with the same target status (reopen and ready)
+func (s *PullRequestService) changeStatus(
+	ctx context.Context,
+	prId string,
+	to prEntity.PRStatus,
+	action string,
+	precondition transitionPrecondition,
+	allowedFrom ...prEntity.PRStatus,
+) (prEntity.PullRequest, error) {
+	updatedPr, err := s.repo.UpdateStatus(ctx, prId, func(
+		pr prEntity.PullRequest,
+		teamName string,
+		teamMembers []memberEntity.Member,
+		reviewers []memberEntity.Member,
+		pools []poolEntity.PoolQuota,
+	) (prEntity.PullRequest, bool, error) {
+		if pr.Status == to {
+			return pr, false, nil
+		}
+
+		if !pr.Status.CanTransitionTo(to) || (len(allowedFrom) > 0 && !slices.Contains(allowedFrom, pr.Status)) {
+			return pr, false, prErrors.ErrInvalidTransition
+		}
+
+		if precondition != nil {
+			if err := precondition(pr, teamName, reviewers); err != nil {
+				return pr, false, err
+			}
+		}
+
+		pr.Status = to
+
+		switch to {
+		case prEntity.PRMerged:
+			pr.MergedAt = time.Now()
+
+		case prEntity.PROpen:
+			count := s.cfg.TargetReviewersCount - teamReviewersCount(pr.Reviewers, teamMembers, pools)
+
+			if count > 0 {
+				assigned, err := s.pickWithCapacity(
+					interfaces.TeamScope(teamName),
+					reviewCandidates(pr, teamMembers),
+					pr.Labels,
+					count,
+				)
+
+				if err != nil {
+					return pr, false, err
+				}
+
+				pr.Reviewers = append(slices.Clone(pr.Reviewers), assigned...)
+			}
+
+			withPoolReviewers, err := s.pickFromPools(teamName, pr, pools)
+
+			if err != nil {
+				return pr, false, err
+			}
+
+			pr.Reviewers = withPoolReviewers
+		}
+
+		return pr, true, nil
 	})
 
 	if err != nil {
-		if errors.Is(err, prErrors.ErrNotFound) {
+		if errors.Is(err, prErrors.ErrNotFound) ||
+			errors.Is(err, prErrors.ErrTeamOrUserNotFound) ||
+			errors.Is(err, prErrors.ErrInvalidTransition) ||
+			errors.Is(err, prErrors.ErrNoReviewerCapacity) ||
+			errors.Is(err, prErrors.ErrMergePolicy) {
+
 			return prEntity.PullRequest{}, err
 		}
 
-		return prEntity.PullRequest{}, fmt.Errorf("failed to merge pr in repo: %w", err)
+		return prEntity.PullRequest{}, fmt.Errorf("failed to %s pr in repo: %w", action, err)
 	}
 
-	return mergedPr, nil
+	return updatedPr, nil
 }
 
 func (s *PullRequestService) Reassign(
@@ -103,42 +372,25 @@ func (s *PullRequestService) Reassign(
 		ctx,
 		prId,
 		oldReviewerId,
-		func(authorId string, pr prEntity.PullRequest, teamMembers []memberEntity.Member) (string, error) {
+		func(
+			authorId string,
+			pr prEntity.PullRequest,
+			teamName string,
+			teamMembers []memberEntity.Member,
+		) (string, error) {
 			if pr.Status == prEntity.PRMerged {
 				return "", prErrors.ErrAlreadyMerged
 			}
 
-			currentReviewersMap := make(map[string]struct{})
-
-			for _, member := range pr.Reviewers {
-				currentReviewersMap[member] = struct{}{}
+			if pr.Status == prEntity.PRClosed {
+				return "", prErrors.ErrAlreadyClosed
 			}
 
-			if _, ok := currentReviewersMap[oldReviewerId]; !ok {
+			if !slices.Contains(pr.Reviewers, oldReviewerId) {
 				return "", prErrors.ErrTeamOrUserNotFound
 			}
 
-			activeMembers := make([]string, 0, len(teamMembers))
-
-			for _, member := range teamMembers {
-				if member.Activity != memberEntity.MemberActive || member.Id == authorId {
-					continue
-				}
-
-				if _, ok := currentReviewersMap[member.Id]; ok {
-					continue
-				}
-
-				activeMembers = append(activeMembers, member.Id)
-			}
-
-			if len(activeMembers) == 0 {
-				return "", prErrors.ErrCannotReassign
-			}
-
-			idx := rand.Intn(len(activeMembers))
-
-			return activeMembers[idx], nil
+			return s.replace(pr, teamName, teamMembers)
 		},
 	)
 
@@ -146,7 +398,8 @@ func (s *PullRequestService) Reassign(
 		if errors.Is(err, prErrors.ErrCannotReassign) ||
 			errors.Is(err, prErrors.ErrTeamOrUserNotFound) ||
 			errors.Is(err, prErrors.ErrNotFound) ||
-			errors.Is(err, prErrors.ErrAlreadyMerged) {
+			errors.Is(err, prErrors.ErrAlreadyMerged) ||
+			errors.Is(err, prErrors.ErrAlreadyClosed) {
 
 			return prEntity.PullRequest{}, "", err
 		}
@@ -156,3 +409,236 @@ func (s *PullRequestService) Reassign(
 
 	return updatedPr, newReviewer, nil
 }
+
+func (s *PullRequestService) Review(
+	ctx context.Context,
+	prId string,
+	reviewerId string,
+	verdict prEntity.ReviewVerdict,
+) (prEntity.PullRequest, error) {
+	if !verdict.IsValid() {
+		return prEntity.PullRequest{}, prErrors.ErrInvalidVerdict
+	}
+
+	reviewedPr, err := s.repo.AddReview(ctx, prId, func(pr prEntity.PullRequest) (prEntity.Review, error) {
+		switch pr.Status {
+		case prEntity.PRMerged:
+			return prEntity.Review{}, prErrors.ErrAlreadyMerged
+
+		case prEntity.PRClosed:
+			return prEntity.Review{}, prErrors.ErrAlreadyClosed
+		}
+
+		// drafts have no reviewers, so they are refused here too
+		if !slices.Contains(pr.Reviewers, reviewerId) {
+			return prEntity.Review{}, prErrors.ErrNotAssigned
+		}
+
+		return prEntity.NewReview(reviewerId, verdict), nil
+	})
+
+	if err != nil {
+		if errors.Is(err, prErrors.ErrNotFound) ||
+			errors.Is(err, prErrors.ErrAlreadyMerged) ||
+			errors.Is(err, prErrors.ErrAlreadyClosed) ||
+			errors.Is(err, prErrors.ErrNotAssigned) {
+
+			return prEntity.PullRequest{}, err
+		}
+
+		return prEntity.PullRequest{}, fmt.Errorf("failed to add review in repo: %w", err)
+	}
+
+	return reviewedPr, nil
+}
+
+func (s *PullRequestService) ReassignOpenReviews(ctx context.Context, reviewerId string) (prEntity.ReassignReport, error) {
+	report := prEntity.ReassignReport{
+		Reassigned:    []prEntity.Reassignment{},
+		NotReassigned: []string{},
+	}
+
+	prIds, err := s.repo.GetOpenIdsByReviewer(ctx, reviewerId)
+
+	if err != nil {
+		return report, fmt.Errorf("failed to get open pull requests from repo: %w", err)
+	}
+
+	for _, prId := range prIds {
+		_, newReviewer, err := s.Reassign(ctx, prId, reviewerId)
+
+		if err != nil {
+			switch {
+			case errors.Is(err, prErrors.ErrCannotReassign):
+				report.NotReassigned = append(report.NotReassigned, prId)
+
+			// pr was merged, closed or reviewer was replaced since ids were read, nothing to do
+			case errors.Is(err, prErrors.ErrAlreadyMerged),
+				errors.Is(err, prErrors.ErrAlreadyClosed),
+				errors.Is(err, prErrors.ErrTeamOrUserNotFound),
+				errors.Is(err, prErrors.ErrNotFound):
+
+			default:
+				return report, err
+			}
+
+			continue
+		}
+
+		report.Reassigned = append(report.Reassigned, prEntity.Reassignment{
+			PullRequestId: prId,
+			OldReviewerId: reviewerId,
+			NewReviewerId: newReviewer,
+		})
+	}
+
+	return report, nil
+}
+
+// members, who can be assigned to pr: available, not an author and not a reviewer already
+func reviewCandidates(pr prEntity.PullRequest, members []memberEntity.Member) []memberEntity.Member {
+	candidates := make([]memberEntity.Member, 0, len(members))
+
+	for _, member := range members {
+		if member.IsAvailable() && member.Id != pr.AuthorId && !slices.Contains(pr.Reviewers, member.Id) {
+			candidates = append(candidates, member)
+		}
+	}
+
+	return candidates
+}
+
+// reviewers drawn from pools do not take slots of team reviewers
+func teamReviewersCount(reviewers []string, teamMembers []memberEntity.Member, pools []poolEntity.PoolQuota) int {
+	count := 0
+
+	for _, reviewer := range reviewers {
+		isTeamMember := slices.ContainsFunc(teamMembers, func(member memberEntity.Member) bool {
+			return member.Id == reviewer
+		})
+
+		isPoolMember := slices.ContainsFunc(pools, func(pool poolEntity.PoolQuota) bool {
+			return pool.Contains(reviewer)
+		})
+
+		if isTeamMember || !isPoolMember {
+			count++
+		}
+	}
+
+	return count
+}
+
+// every pool attached to team adds its number of reviewers to ones of pr.
+// reviewers, who are members of pool already, count towards its number
+func (s *PullRequestService) pickFromPools(
+	teamName string,
+	pr prEntity.PullRequest,
+	pools []poolEntity.PoolQuota,
+) ([]string, error) {
+	for _, pool := range pools {
+		count := pool.ReviewersCount
+
+		for _, reviewer := range pr.Reviewers {
+			if pool.Contains(reviewer) {
+				count--
+			}
+		}
+
+		if count <= 0 {
+			continue
+		}
+
+		scope := interfaces.PickScope{TeamName: teamName, PoolName: pool.PoolName}
+
+		picked, err := s.pickWithCapacity(scope, reviewCandidates(pr, pool.Members), pr.Labels, count)
+
+		if err != nil {
+			return nil, err
+		}
+
+		pr.Reviewers = append(slices.Clone(pr.Reviewers), picked...)
+	}
+
+	return pr.Reviewers, nil
+}
+
+// owners with review capacity take slots first, the rest are filled from other candidates
+func (s *PullRequestService) pickOwnersFirst(
+	scope interfaces.PickScope,
+	candidates []memberEntity.Member,
+	owners []string,
+	labels []string,
+	count int,
+) ([]string, error) {
+	ownersWithCapacity := make([]memberEntity.Member, 0, len(owners))
+	others := make([]memberEntity.Member, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		if slices.Contains(owners, candidate.Id) && candidate.HasReviewCapacity() {
+			ownersWithCapacity = append(ownersWithCapacity, candidate)
+		} else {
+			others = append(others, candidate)
+		}
+	}
+
+	picked := reviewerpicker.PickBySkills(s.picker, scope, ownersWithCapacity, labels, count)
+
+	rest, err := s.pickWithCapacity(scope, others, labels, count-len(picked))
+
+	if err != nil {
+		// owners already took some slots, so the pull request is not left without reviewers
+		if errors.Is(err, prErrors.ErrNoReviewerCapacity) && len(picked) > 0 {
+			return picked, nil
+		}
+
+		return nil, err
+	}
+
+	return append(picked, rest...), nil
+}
+
+// members with skills matching labels are preferred inside capacity groups
+func (s *PullRequestService) pickWithCapacity(
+	scope interfaces.PickScope,
+	candidates []memberEntity.Member,
+	labels []string,
+	count int,
+) ([]string, error) {
+	withCapacity := make([]memberEntity.Member, 0, len(candidates))
+	atCapacity := make([]memberEntity.Member, 0)
+
+	for _, candidate := range candidates {
+		if candidate.HasReviewCapacity() {
+			withCapacity = append(withCapacity, candidate)
+		} else {
+			atCapacity = append(atCapacity, candidate)
+		}
+	}
+
+	target := min(count, len(candidates))
+
+	pick := func(members []memberEntity.Member, n int) []string {
+		return reviewerpicker.PickBySkills(s.picker, scope, members, labels, n)
+	}
+
+	if len(withCapacity) >= target {
+		return pick(withCapacity, target), nil
+	}
+
+	switch s.cfg.CapacityFallback {
+	case config.CapacityFallbackFail:
+		if len(withCapacity) == 0 {
+			return nil, prErrors.ErrNoReviewerCapacity
+		}
+
+		return pick(withCapacity, len(withCapacity)), nil
+
+	case config.CapacityFallbackIgnoreCap:
+		picked := pick(withCapacity, len(withCapacity))
+		return append(picked, pick(atCapacity, target-len(picked))...), nil
+
+	default:
+		return pick(withCapacity, len(withCapacity)), nil
+	}
+}
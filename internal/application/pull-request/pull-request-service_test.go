@@ -8,8 +8,12 @@ import (
 	"time"
 
 	pullrequestservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/pull-request"
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	codeownersEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/entity"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
+	poolEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/entity"
 	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
 	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
@@ -26,48 +30,352 @@ func TestGetByReviewer(t *testing.T) {
 		TargetReviewersCount: 2,
 	}
 
+	createdAt := time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	repoPRs := []prEntity.PullRequest{
+		{
+			Id:        "pr1",
+			Name:      "pull request 1",
+			AuthorId:  "u1",
+			Status:    prEntity.PROpen,
+			Reviewers: []string{reviewerId, "u3"},
+			CreatedAt: createdAt,
+		},
+		{
+			Id:        "pr2",
+			Name:      "pull request 2",
+			AuthorId:  "u2",
+			Status:    prEntity.PRMerged,
+			Reviewers: []string{"u4", reviewerId},
+			CreatedAt: createdAt.Add(time.Hour),
+		},
+	}
+
+	after := &pagination.Cursor{Value: "2025-11-01T11:00:00Z", Id: "pr0"}
+
+	type testCase struct {
+		what string
+
+		status         prEntity.PRStatus
+		order          pagination.Order
+		limit          int
+		callRepo       bool
+		expectedFilter prEntity.PRFilter
+		expectedOrder  pagination.Order
+		expectedLimit  int
+		repoPRs        []prEntity.PullRequest
+		repoError      error
+		expectedPRs    []prEntity.PullRequest
+		expectedCursor *pagination.Cursor
+		expectedError  string
+		noError        bool
+	}
+
+	testCases := []testCase{
+		{
+			what:          "limit exceeds max page size",
+			limit:         11,
+			expectedError: "invalid pr list query: limit must be between 1 and 10",
+		},
+
+		{
+			what:          "unknown status",
+			status:        "REVIEWED",
+			expectedError: "invalid pr list query: unknown status REVIEWED",
+		},
+
+		{
+			what:          "unknown order",
+			order:         "newest",
+			expectedError: "invalid pr list query: unknown order newest",
+		},
+
+		{
+			what:           "failed to get pull requests from repo",
+			callRepo:       true,
+			expectedFilter: prEntity.PRFilter{ReviewerId: reviewerId, Status: prEntity.PROpen},
+			expectedOrder:  pagination.OrderAsc,
+			expectedLimit:  11,
+			repoError:      errors.New("db is down"),
+			expectedError:  "failed to list pull requests in repo: db is down",
+		},
+
+		{
+			what:           "open PRs oldest first by default, cut to page with next cursor",
+			limit:          1,
+			callRepo:       true,
+			expectedFilter: prEntity.PRFilter{ReviewerId: reviewerId, Status: prEntity.PROpen},
+			expectedOrder:  pagination.OrderAsc,
+			expectedLimit:  2,
+			repoPRs:        repoPRs,
+			expectedPRs:    repoPRs[:1],
+			expectedCursor: &pagination.Cursor{Value: "2025-11-01T12:00:00Z", Id: "pr1"},
+			noError:        true,
+		},
+
+		{
+			what:           "merged PRs newest first",
+			status:         prEntity.PRMerged,
+			order:          pagination.OrderDesc,
+			callRepo:       true,
+			expectedFilter: prEntity.PRFilter{ReviewerId: reviewerId, Status: prEntity.PRMerged},
+			expectedOrder:  pagination.OrderDesc,
+			expectedLimit:  11,
+			repoPRs:        repoPRs[1:],
+			expectedPRs:    repoPRs[1:],
+			noError:        true,
+		},
+
+		{
+			what:           "PRs of any status",
+			status:         prEntity.ReviewQueueAnyStatus,
+			callRepo:       true,
+			expectedFilter: prEntity.PRFilter{ReviewerId: reviewerId},
+			expectedOrder:  pagination.OrderAsc,
+			expectedLimit:  11,
+			repoPRs:        repoPRs,
+			expectedPRs:    repoPRs,
+			noError:        true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			if tc.callRepo {
+				mockPullRequestRepo.EXPECT().List(gomock.Any(), prEntity.PRListQuery{
+					Filter: tc.expectedFilter,
+					SortBy: prEntity.PRSortCreatedAt,
+					Order:  tc.expectedOrder,
+					After:  after,
+					Limit:  tc.expectedLimit,
+				}).Return(tc.repoPRs, tc.repoError)
+			}
+
+			service := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
+
+			prs, cursor, err := service.GetByReviewer(context.Background(), prEntity.ReviewQueueQuery{
+				ReviewerId: reviewerId,
+				Status:     tc.status,
+				Order:      tc.order,
+				After:      after,
+				Limit:      tc.limit,
+			})
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPRs, prs)
+				assert.Equal(t, tc.expectedCursor, cursor)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestGetById(t *testing.T) {
+	prId := "pr1"
+
+	config := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
 	type testCase struct {
 		what string
 
+		repoPR        prEntity.PullRequest
 		repoError     error
-		expectedPRs   []prEntity.PullRequest
 		expectedError string
 		noError       bool
 	}
 
 	testCases := []testCase{
 		{
-			what:          "failed to get pull requests from repo",
+			what:          "pr not found",
+			repoError:     prErrors.ErrNotFound,
+			expectedError: prErrors.ErrNotFound.Error(),
+		},
+
+		{
+			what:          "failed to get pr from repo",
 			repoError:     errors.New("db is down"),
-			expectedError: "failed to get pull requests from repo: db is down",
+			expectedError: "failed to get pull request from repo: db is down",
 		},
 
 		{
-			what:      "successfully get PRs",
-			repoError: nil,
-			noError:   true,
-			expectedPRs: []prEntity.PullRequest{
-				{
-					Id:       "pr1",
-					Name:     "pull request 1",
-					AuthorId: "u1",
-					Status:   prEntity.PROpen,
-					Reviewers: []string{
-						"u2",
-						"u3",
-					},
-				},
-				{
-					Id:       "pr2",
-					Name:     "pull request 2",
-					AuthorId: "u2",
-					Status:   prEntity.PRMerged,
-					Reviewers: []string{
-						"u4",
-						"u3",
-					},
-				},
+			what: "successfully get pr",
+			repoPR: prEntity.PullRequest{
+				Id:        prId,
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2"},
+			},
+			noError: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			mockPullRequestRepo.EXPECT().GetById(gomock.Any(), prId).Return(tc.repoPR, tc.repoError)
+
+			service := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
+
+			pr, err := service.GetById(context.Background(), prId)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.repoPR, pr)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	config := config.PullRequestConfig{
+		OutLimit:             3,
+		TargetReviewersCount: 2,
+	}
+
+	createdAt := time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	makePRs := func(count int) []prEntity.PullRequest {
+		prs := make([]prEntity.PullRequest, 0, count)
+
+		for i := range count {
+			prs = append(prs, prEntity.PullRequest{
+				Id:        fmt.Sprintf("pr%d", i+1),
+				Name:      fmt.Sprintf("pull request %d", i+1),
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				CreatedAt: createdAt.Add(time.Duration(i) * time.Hour),
+			})
+		}
+
+		return prs
+	}
+
+	type testCase struct {
+		what string
+
+		query          prEntity.PRListQuery
+		callRepo       bool
+		expectedQuery  prEntity.PRListQuery
+		repoPRs        []prEntity.PullRequest
+		repoError      error
+		expectedPRs    []prEntity.PullRequest
+		expectedCursor *pagination.Cursor
+		expectedError  string
+		noError        bool
+	}
+
+	testCases := []testCase{
+		{
+			what:          "unknown sort field",
+			query:         prEntity.PRListQuery{SortBy: "author"},
+			expectedError: "invalid pr list query: unknown sort field author",
+		},
+
+		{
+			what:          "unknown order",
+			query:         prEntity.PRListQuery{Order: "up"},
+			expectedError: "invalid pr list query: unknown order up",
+		},
+
+		{
+			what:          "unknown status",
+			query:         prEntity.PRListQuery{Filter: prEntity.PRFilter{Status: "REVIEWED"}},
+			expectedError: "invalid pr list query: unknown status REVIEWED",
+		},
+
+		{
+			what:          "limit exceeds out limit",
+			query:         prEntity.PRListQuery{Limit: 4},
+			expectedError: "invalid pr list query: limit must be between 1 and 3",
+		},
+
+		{
+			what: "empty created_at range",
+			query: prEntity.PRListQuery{Filter: prEntity.PRFilter{
+				CreatedFrom: &createdAt,
+				CreatedTo:   &createdAt,
+			}},
+			expectedError: "invalid pr list query: created_from must be before created_to",
+		},
+
+		{
+			what:     "cursor rejected by repo",
+			query:    prEntity.PRListQuery{After: &pagination.Cursor{Value: "yesterday", Id: "pr1"}},
+			callRepo: true,
+			expectedQuery: prEntity.PRListQuery{
+				SortBy: prEntity.PRSortCreatedAt,
+				Order:  pagination.OrderAsc,
+				After:  &pagination.Cursor{Value: "yesterday", Id: "pr1"},
+				Limit:  4,
+			},
+			repoError:     pagination.ErrInvalidCursor,
+			expectedError: pagination.ErrInvalidCursor.Error(),
+		},
+
+		{
+			what:     "failed to list prs in repo",
+			callRepo: true,
+			expectedQuery: prEntity.PRListQuery{
+				SortBy: prEntity.PRSortCreatedAt,
+				Order:  pagination.OrderAsc,
+				Limit:  4,
+			},
+			repoError:     errors.New("db is down"),
+			expectedError: "failed to list pull requests in repo: db is down",
+		},
+
+		{
+			what:     "page with next cursor",
+			query:    prEntity.PRListQuery{Limit: 2},
+			callRepo: true,
+			expectedQuery: prEntity.PRListQuery{
+				SortBy: prEntity.PRSortCreatedAt,
+				Order:  pagination.OrderAsc,
+				Limit:  3,
 			},
+			repoPRs:     makePRs(3),
+			expectedPRs: makePRs(2),
+			expectedCursor: &pagination.Cursor{
+				Value: "2025-11-01T13:00:00Z",
+				Id:    "pr2",
+			},
+			noError: true,
+		},
+
+		{
+			what: "last page sorted by name",
+			query: prEntity.PRListQuery{
+				Filter: prEntity.PRFilter{AuthorId: "u1", Status: prEntity.PROpen},
+				SortBy: prEntity.PRSortName,
+				Order:  pagination.OrderDesc,
+			},
+			callRepo: true,
+			expectedQuery: prEntity.PRListQuery{
+				Filter: prEntity.PRFilter{AuthorId: "u1", Status: prEntity.PROpen},
+				SortBy: prEntity.PRSortName,
+				Order:  pagination.OrderDesc,
+				Limit:  4,
+			},
+			repoPRs:     makePRs(2),
+			expectedPRs: makePRs(2),
+			noError:     true,
 		},
 	}
 
@@ -78,19 +386,18 @@ func TestGetByReviewer(t *testing.T) {
 
 			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
 
-			mockPullRequestRepo.EXPECT().GetByReviewer(
-				gomock.Any(),
-				reviewerId,
-				config.OutLimit,
-			).Return(tc.expectedPRs, tc.repoError)
+			if tc.callRepo {
+				mockPullRequestRepo.EXPECT().List(gomock.Any(), tc.expectedQuery).Return(tc.repoPRs, tc.repoError)
+			}
 
-			service := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, &config)
+			service := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
 
-			prs, err := service.GetByReviewer(context.Background(), reviewerId)
+			prs, cursor, err := service.List(context.Background(), tc.query)
 
 			if tc.noError {
 				assert.NoError(t, err)
 				assert.Equal(t, tc.expectedPRs, prs)
+				assert.Equal(t, tc.expectedCursor, cursor)
 			} else {
 				assert.Equal(t, tc.expectedError, err.Error())
 			}
@@ -110,12 +417,59 @@ func TestCreate(t *testing.T) {
 		prId                    string
 		prName                  string
 		authorId                string
+		teamName                string
+		draft                   bool
+		changedFiles            []string
+		labels                  []string
 		teamMembers             []memberEntity.Member
+		uploadedCodeowners      *codeownersEntity.Ruleset
+		pools                   []poolEntity.PoolQuota
+		configCodeowners        map[string]codeownersEntity.Ruleset
+		picker                  interfaces.ReviewerPicker
+		capacityFallback        string
 		expectedPR              prEntity.PullRequest
 		expectedPRWithReviewers prEntity.PullRequest
+		expectedCallbackError   error
 		repoError               error
 		expectedError           string
 		noError                 bool
+		// input is rejected before pr reaches repo
+		noRepoCall bool
+	}
+
+	limit := 1
+
+	parseCodeowners := func(text string) codeownersEntity.Ruleset {
+		ruleset, err := codeownersEntity.Parse(text)
+		assert.NoError(t, err)
+
+		return ruleset
+	}
+
+	uploadedCodeowners := parseCodeowners("/docs/ u2 u3")
+	backendCodeowners := parseCodeowners("# backend\n/backend/ u3 u4\n")
+	goCodeowners := parseCodeowners("*.go @u1 @u3")
+
+	codeownersTeam := []memberEntity.Member{
+		{
+			Id:       "u1",
+			Activity: memberEntity.MemberActive,
+		},
+
+		{
+			Id:       "u2",
+			Activity: memberEntity.MemberActive,
+		},
+
+		{
+			Id:       "u3",
+			Activity: memberEntity.MemberActive,
+		},
+
+		{
+			Id:       "u4",
+			Activity: memberEntity.MemberActive,
+		},
 	}
 
 	testCases := []testCase{
@@ -293,124 +647,1573 @@ func TestCreate(t *testing.T) {
 			repoError: nil,
 			noError:   true,
 		},
-	}
 
-	for i, tc := range testCases {
-		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+		{
+			what: "ignore unavailable",
 
-			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+			prId:     "pr1",
+			prName:   "pull request 1",
+			authorId: "u1",
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
 
-			mockPullRequestRepo.
-				EXPECT().
-				Create(gomock.Any(), prEntity.Matcher(tc.expectedPR), gomock.Any()).
-				DoAndReturn(func(
-					ctx context.Context,
-					pr prEntity.PullRequest,
-					callback interfaces.AssignHandler,
-				) (prEntity.PullRequest, error) {
-					reviewers := callback(tc.authorId, tc.teamMembers)
-					assert.ElementsMatch(t, tc.expectedPRWithReviewers.Reviewers, reviewers)
+				{
+					Id:          "u2",
+					Activity:    memberEntity.MemberActive,
+					Unavailable: true,
+				},
 
-					return tc.expectedPRWithReviewers, tc.repoError
-				})
+				{
+					Id:       "u3",
+					Activity: memberEntity.MemberActive,
+				},
+			},
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u3"},
+			},
+			repoError: nil,
+			noError:   true,
+		},
 
-			service := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, &config)
+		{
+			what: "prefer least busy",
 
-			pr, err := service.Create(context.Background(), tc.prId, tc.prName, tc.authorId)
+			prId:     "pr1",
+			prName:   "pull request 1",
+			authorId: "u1",
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
 
-			if tc.noError {
-				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedPRWithReviewers, pr)
-			} else {
-				assert.Equal(t, tc.expectedError, err.Error())
-			}
-		})
-	}
-}
+				{
+					Id:          "u2",
+					Activity:    memberEntity.MemberActive,
+					OpenReviews: 5,
+				},
 
-func TestMerge(t *testing.T) {
-	config := config.PullRequestConfig{
-		OutLimit:             10,
-		TargetReviewersCount: 2,
-	}
+				{
+					Id:          "u3",
+					Activity:    memberEntity.MemberActive,
+					OpenReviews: 0,
+				},
 
-	type testCase struct {
-		what string
+				{
+					Id:          "u4",
+					Activity:    memberEntity.MemberActive,
+					OpenReviews: 1,
+				},
+			},
+			picker: reviewerpicker.CreateLeastLoadedPicker(),
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u3", "u4"},
+			},
+			repoError: nil,
+			noError:   true,
+		},
+
+		{
+			what: "skip members at capacity",
+
+			prId:     "pr1",
+			prName:   "pull request 1",
+			authorId: "u1",
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:             "u2",
+					Activity:       memberEntity.MemberActive,
+					OpenReviews:    1,
+					MaxOpenReviews: &limit,
+				},
+
+				{
+					Id:             "u3",
+					Activity:       memberEntity.MemberActive,
+					OpenReviews:    0,
+					MaxOpenReviews: &limit,
+				},
+
+				{
+					Id:          "u4",
+					Activity:    memberEntity.MemberActive,
+					OpenReviews: 7,
+				},
+			},
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u3", "u4"},
+			},
+			repoError: nil,
+			noError:   true,
+		},
+
+		{
+			what: "under assign when capacity is not enough",
+
+			prId:     "pr1",
+			prName:   "pull request 1",
+			authorId: "u1",
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:             "u2",
+					Activity:       memberEntity.MemberActive,
+					OpenReviews:    1,
+					MaxOpenReviews: &limit,
+				},
+
+				{
+					Id:       "u3",
+					Activity: memberEntity.MemberActive,
+				},
+			},
+			capacityFallback: "under_assign",
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u3"},
+			},
+			repoError: nil,
+			noError:   true,
+		},
+
+		{
+			what: "ignore cap when capacity is not enough",
+
+			prId:     "pr1",
+			prName:   "pull request 1",
+			authorId: "u1",
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:             "u2",
+					Activity:       memberEntity.MemberActive,
+					OpenReviews:    1,
+					MaxOpenReviews: &limit,
+				},
+
+				{
+					Id:       "u3",
+					Activity: memberEntity.MemberActive,
+				},
+			},
+			capacityFallback: "ignore_cap",
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u3", "u2"},
+			},
+			repoError: nil,
+			noError:   true,
+		},
+
+		{
+			what: "fail mode assigns members with capacity, when they are not enough",
+
+			prId:     "pr1",
+			prName:   "pull request 1",
+			authorId: "u1",
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:             "u2",
+					Activity:       memberEntity.MemberActive,
+					OpenReviews:    1,
+					MaxOpenReviews: &limit,
+				},
+
+				{
+					Id:       "u3",
+					Activity: memberEntity.MemberActive,
+				},
+			},
+			capacityFallback: "fail",
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u3"},
+			},
+			repoError: nil,
+			noError:   true,
+		},
+
+		{
+			what: "fail when no member has capacity",
+
+			prId:     "pr1",
+			prName:   "pull request 1",
+			authorId: "u1",
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:             "u2",
+					Activity:       memberEntity.MemberActive,
+					OpenReviews:    1,
+					MaxOpenReviews: &limit,
+				},
+
+				{
+					Id:             "u3",
+					Activity:       memberEntity.MemberActive,
+					OpenReviews:    1,
+					MaxOpenReviews: &limit,
+				},
+			},
+			capacityFallback: "fail",
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedCallbackError: prErrors.ErrNoReviewerCapacity,
+			repoError:             prErrors.ErrNoReviewerCapacity,
+			expectedError:         prErrors.ErrNoReviewerCapacity.Error(),
+		},
+
+		{
+			what: "draft is created without reviewers",
+
+			prId:     "pr1",
+			prName:   "pull request 1",
+			authorId: "u1",
+			draft:    true,
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:       "u2",
+					Activity: memberEntity.MemberActive,
+				},
+			},
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PRDraft,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PRDraft,
+				Reviewers: []string{},
+			},
+			noError: true,
+		},
+
+		{
+			what: "codeowners of changed files are preferred",
+
+			prId:               "pr1",
+			prName:             "pull request 1",
+			authorId:           "u1",
+			changedFiles:       []string{"backend/api/handlers.go", "README.md"},
+			teamMembers:        codeownersTeam,
+			uploadedCodeowners: &backendCodeowners,
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u3", "u4"},
+			},
+			noError: true,
+		},
+
+		{
+			what: "author is not picked as codeowner",
+
+			prId:         "pr1",
+			prName:       "pull request 1",
+			authorId:     "u1",
+			changedFiles: []string{"main.go"},
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:       "u2",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:       "u3",
+					Activity: memberEntity.MemberActive,
+				},
+			},
+			uploadedCodeowners: &goCodeowners,
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u3", "u2"},
+			},
+			noError: true,
+		},
+
+		{
+			what: "codeowners from config are used without uploaded ones",
+
+			prId:         "pr1",
+			prName:       "pull request 1",
+			authorId:     "u1",
+			changedFiles: []string{"docs/index.md"},
+			teamMembers:  codeownersTeam,
+			configCodeowners: map[string]codeownersEntity.Ruleset{
+				"team1": parseCodeowners("docs/ u3 u4"),
+			},
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u3", "u4"},
+			},
+			noError: true,
+		},
+
+		{
+			what: "codeowners of selected team are used",
+
+			prId:         "pr1",
+			prName:       "pull request 1",
+			authorId:     "u1",
+			teamName:     "team2",
+			changedFiles: []string{"docs/index.md"},
+			teamMembers:  codeownersTeam,
+			configCodeowners: map[string]codeownersEntity.Ruleset{
+				"team1": parseCodeowners("docs/ u3 u4"),
+				"team2": parseCodeowners("docs/ u2 u3"),
+			},
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2", "u3"},
+			},
+			noError: true,
+		},
+
+		{
+			what: "uploaded codeowners take precedence over config",
+
+			prId:               "pr1",
+			prName:             "pull request 1",
+			authorId:           "u1",
+			changedFiles:       []string{"docs/index.md"},
+			teamMembers:        codeownersTeam,
+			uploadedCodeowners: &uploadedCodeowners,
+			configCodeowners: map[string]codeownersEntity.Ruleset{
+				"team1": parseCodeowners("docs/ u3 u4"),
+			},
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2", "u3"},
+			},
+			noError: true,
+		},
+
+		{
+			what: "codeowner at capacity is not preferred",
+
+			prId:         "pr1",
+			prName:       "pull request 1",
+			authorId:     "u1",
+			changedFiles: []string{"docs/index.md"},
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:       "u2",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:             "u3",
+					Activity:       memberEntity.MemberActive,
+					MaxOpenReviews: &limit,
+					OpenReviews:    1,
+				},
+
+				{
+					Id:       "u4",
+					Activity: memberEntity.MemberActive,
+				},
+			},
+			uploadedCodeowners: &uploadedCodeowners,
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2", "u4"},
+			},
+			noError: true,
+		},
+
+		{
+			what: "members with skills matching labels are preferred",
+
+			prId:     "pr1",
+			prName:   "pull request 1",
+			authorId: "u1",
+			labels:   []string{"Security", " postgres"},
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+					Skills:   []string{"postgres", "security"},
+				},
+
+				{
+					Id:       "u2",
+					Activity: memberEntity.MemberActive,
+					Skills:   []string{"frontend"},
+				},
+
+				{
+					Id:       "u3",
+					Activity: memberEntity.MemberActive,
+					Skills:   []string{"security"},
+				},
+
+				{
+					Id:       "u4",
+					Activity: memberEntity.MemberActive,
+					Skills:   []string{"postgres", "security"},
+				},
+
+				{
+					Id:       "u5",
+					Activity: memberEntity.MemberActive,
+				},
+			},
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+				Labels:   []string{"postgres", "security"},
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u4", "u3"},
+				Labels:    []string{"postgres", "security"},
+			},
+			noError: true,
+		},
+
+		{
+			what: "codeowners are preferred over matching skills",
+
+			prId:               "pr1",
+			prName:             "pull request 1",
+			authorId:           "u1",
+			changedFiles:       []string{"docs/index.md"},
+			labels:             []string{"security"},
+			uploadedCodeowners: &uploadedCodeowners,
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:       "u2",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:       "u3",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:       "u4",
+					Activity: memberEntity.MemberActive,
+					Skills:   []string{"security"},
+				},
+			},
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+				Labels:   []string{"security"},
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2", "u3"},
+				Labels:    []string{"security"},
+			},
+			noError: true,
+		},
+
+		{
+			what: "reviewer from attached pool is added to team reviewers",
+
+			prId:     "pr1",
+			prName:   "pull request 1",
+			authorId: "u1",
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:       "u2",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:       "u3",
+					Activity: memberEntity.MemberActive,
+				},
+			},
+			pools: []poolEntity.PoolQuota{
+				{
+					PoolName:       "platform",
+					ReviewersCount: 1,
+					Members: []memberEntity.Member{
+						{
+							Id:       "u1",
+							Activity: memberEntity.MemberActive,
+						},
+
+						{
+							Id:       "p1",
+							Activity: memberEntity.MemberActive,
+						},
+
+						{
+							Id:       "p2",
+							Activity: memberEntity.MemberInactive,
+						},
+					},
+				},
+			},
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2", "u3", "p1"},
+			},
+			noError: true,
+		},
+
+		{
+			what: "team reviewer from pool counts towards pool",
+
+			prId:     "pr1",
+			prName:   "pull request 1",
+			authorId: "u1",
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:       "u2",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:       "u3",
+					Activity: memberEntity.MemberActive,
+				},
+			},
+			pools: []poolEntity.PoolQuota{
+				{
+					PoolName:       "platform",
+					ReviewersCount: 1,
+					Members: []memberEntity.Member{
+						{
+							Id:       "u2",
+							Activity: memberEntity.MemberActive,
+						},
+
+						{
+							Id:       "p1",
+							Activity: memberEntity.MemberActive,
+						},
+					},
+				},
+			},
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2", "u3"},
+			},
+			noError: true,
+		},
+
+		{
+			what: "no capacity in attached pool",
+
+			prId:             "pr1",
+			prName:           "pull request 1",
+			authorId:         "u1",
+			capacityFallback: "fail",
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+
+				{
+					Id:       "u2",
+					Activity: memberEntity.MemberActive,
+				},
+			},
+			pools: []poolEntity.PoolQuota{
+				{
+					PoolName:       "platform",
+					ReviewersCount: 1,
+					Members: []memberEntity.Member{
+						{
+							Id:             "p1",
+							Activity:       memberEntity.MemberActive,
+							OpenReviews:    limit,
+							MaxOpenReviews: &limit,
+						},
+					},
+				},
+			},
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedCallbackError: prErrors.ErrNoReviewerCapacity,
+			repoError:             prErrors.ErrNoReviewerCapacity,
+			expectedError:         prErrors.ErrNoReviewerCapacity.Error(),
+		},
+
+		{
+			what: "invalid labels",
+
+			prId:          "pr1",
+			prName:        "pull request 1",
+			authorId:      "u1",
+			labels:        []string{"security", " "},
+			expectedError: prErrors.ErrInvalidLabels.Error(),
+			noRepoCall:    true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			picker := tc.picker
+			if picker == nil {
+				picker = reviewerpicker.CreateRandomPicker()
+			}
+
+			if !tc.noRepoCall {
+				mockPullRequestRepo.
+					EXPECT().
+					Create(gomock.Any(), prEntity.Matcher(tc.expectedPR), tc.teamName, gomock.Any()).
+					DoAndReturn(func(
+						ctx context.Context,
+						pr prEntity.PullRequest,
+						teamName string,
+						callback interfaces.AssignHandler,
+					) (prEntity.PullRequest, error) {
+						// repo resolves empty team name to primary team of author
+						if teamName == "" {
+							teamName = "team1"
+						}
+
+						reviewers, err := callback(tc.authorId, teamName, tc.teamMembers, tc.uploadedCodeowners, tc.pools)
+
+						if tc.expectedCallbackError == nil {
+							assert.NoError(t, err)
+							assert.ElementsMatch(t, tc.expectedPRWithReviewers.Reviewers, reviewers)
+						} else {
+							assert.ErrorIs(t, err, tc.expectedCallbackError)
+						}
+
+						return tc.expectedPRWithReviewers, tc.repoError
+					})
+			}
+
+			cfg := config
+			cfg.CapacityFallback = tc.capacityFallback
+
+			service := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, picker, tc.configCodeowners, &cfg)
+
+			pr, err := service.Create(
+				context.Background(),
+				tc.prId,
+				tc.prName,
+				tc.authorId,
+				tc.teamName,
+				tc.draft,
+				tc.changedFiles,
+				tc.labels,
+			)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPRWithReviewers, pr)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	config := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	type testCase struct {
+		what string
+
+		prId                  string
+		storedPr              prEntity.PullRequest
+		expectedPr            prEntity.PullRequest
+		expectedUpdated       bool
+		expectedCallbackError error
+		repoError             error
+		expectedError         string
+		noError               bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "pr not found",
+
+			prId: "pr1",
+			storedPr: prEntity.PullRequest{
+				Status:   prEntity.PRMerged,
+				MergedAt: time.Now().Add(-time.Second),
+			},
+			expectedUpdated: false,
+			expectedPr: prEntity.PullRequest{
+				Status: prEntity.PRMerged,
+			},
+			repoError:     prErrors.ErrNotFound,
+			expectedError: prErrors.ErrNotFound.Error(),
+		},
+
+		{
+			what: "failed to merge pr in repo",
+
+			prId: "pr1",
+			storedPr: prEntity.PullRequest{
+				Status:   prEntity.PRMerged,
+				MergedAt: time.Now().Add(-time.Second),
+			},
+			expectedUpdated: false,
+			expectedPr: prEntity.PullRequest{
+				Status: prEntity.PRMerged,
+			},
+			repoError:     errors.New("db is down"),
+			expectedError: "failed to merge pr in repo: db is down",
+		},
+
+		{
+			what: "already merged",
+
+			prId: "pr1",
+			storedPr: prEntity.PullRequest{
+				Status:   prEntity.PRMerged,
+				MergedAt: time.Now().Add(-time.Second),
+			},
+			expectedUpdated: false,
+			expectedPr: prEntity.PullRequest{
+				Status: prEntity.PRMerged,
+			},
+			repoError: nil,
+			noError:   true,
+		},
+
+		{
+			what: "successfully merged",
+
+			prId: "pr1",
+			storedPr: prEntity.PullRequest{
+				Status:   prEntity.PROpen,
+				MergedAt: time.Now().Add(-time.Second),
+			},
+			expectedUpdated: true,
+			expectedPr: prEntity.PullRequest{
+				Status: prEntity.PRMerged,
+			},
+			repoError: nil,
+			noError:   true,
+		},
+
+		{
+			what: "draft cannot be merged",
+
+			prId: "pr1",
+			storedPr: prEntity.PullRequest{
+				Status: prEntity.PRDraft,
+			},
+			expectedUpdated:       false,
+			expectedPr:            prEntity.PullRequest{Status: prEntity.PRDraft},
+			expectedCallbackError: prErrors.ErrInvalidTransition,
+			repoError:             prErrors.ErrInvalidTransition,
+			expectedError:         prErrors.ErrInvalidTransition.Error(),
+		},
+
+		{
+			what: "closed pr cannot be merged",
+
+			prId: "pr1",
+			storedPr: prEntity.PullRequest{
+				Status: prEntity.PRClosed,
+			},
+			expectedUpdated:       false,
+			expectedPr:            prEntity.PullRequest{Status: prEntity.PRClosed},
+			expectedCallbackError: prErrors.ErrInvalidTransition,
+			repoError:             prErrors.ErrInvalidTransition,
+			expectedError:         prErrors.ErrInvalidTransition.Error(),
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			mockPullRequestRepo.
+				EXPECT().
+				UpdateStatus(gomock.Any(), tc.prId, gomock.Any()).
+				DoAndReturn(func(
+					ctx context.Context,
+					pr string,
+					callback interfaces.UpdateStatusHandler,
+				) (prEntity.PullRequest, error) {
+					updatedPr, updated, err := callback(tc.storedPr, "team1", []memberEntity.Member{}, []memberEntity.Member{}, nil)
+
+					assert.ErrorIs(t, err, tc.expectedCallbackError)
+					assert.Equal(t, tc.expectedUpdated, updated)
+					assert.Equal(t, tc.expectedPr.Status, updatedPr.Status)
+
+					if updated {
+						assert.NotEqual(t, tc.storedPr.MergedAt, updatedPr.MergedAt)
+					} else {
+						assert.Equal(t, tc.storedPr.MergedAt, updatedPr.MergedAt)
+					}
+
+					return tc.expectedPr, tc.repoError
+				})
+
+			service := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
+
+			pr, err := service.Merge(context.Background(), tc.prId, "u9")
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPr, pr)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestChangeStatus(t *testing.T) {
+	config := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	teamMembers := []memberEntity.Member{
+		{
+			Id:       "u1",
+			Activity: memberEntity.MemberActive,
+		},
+		{
+			Id:       "u2",
+			Activity: memberEntity.MemberActive,
+		},
+		{
+			Id:       "u3",
+			Activity: memberEntity.MemberInactive,
+		},
+		{
+			Id:       "u4",
+			Activity: memberEntity.MemberActive,
+		},
+	}
+
+	type testCase struct {
+		what string
+
+		operation             string
+		storedPr              prEntity.PullRequest
+		expectedUpdated       bool
+		expectedStatus        prEntity.PRStatus
+		expectedReviewers     []string
+		pools                 []poolEntity.PoolQuota
+		expectedCallbackError error
+		repoError             error
+		expectedError         string
+		noError               bool
+	}
+
+	platformPool := []poolEntity.PoolQuota{
+		{
+			PoolName:       "platform",
+			ReviewersCount: 1,
+			Members: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+				{
+					Id:       "p1",
+					Activity: memberEntity.MemberActive,
+				},
+			},
+		},
+	}
+
+	testCases := []testCase{
+		{
+			what: "close open pr",
+
+			operation: "close",
+			storedPr: prEntity.PullRequest{
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2", "u4"},
+			},
+			expectedUpdated:   true,
+			expectedStatus:    prEntity.PRClosed,
+			expectedReviewers: []string{"u2", "u4"},
+			noError:           true,
+		},
+
+		{
+			what: "close closed pr",
+
+			operation: "close",
+			storedPr: prEntity.PullRequest{
+				AuthorId: "u1",
+				Status:   prEntity.PRClosed,
+			},
+			expectedUpdated: false,
+			expectedStatus:  prEntity.PRClosed,
+			noError:         true,
+		},
+
+		{
+			what: "close merged pr",
+
+			operation: "close",
+			storedPr: prEntity.PullRequest{
+				AuthorId: "u1",
+				Status:   prEntity.PRMerged,
+			},
+			expectedStatus:        prEntity.PRMerged,
+			expectedCallbackError: prErrors.ErrInvalidTransition,
+			repoError:             prErrors.ErrInvalidTransition,
+			expectedError:         prErrors.ErrInvalidTransition.Error(),
+		},
+
+		{
+			what: "ready assigns reviewers",
+
+			operation: "ready",
+			storedPr: prEntity.PullRequest{
+				AuthorId:  "u1",
+				Status:    prEntity.PRDraft,
+				Reviewers: []string{},
+			},
+			expectedUpdated:   true,
+			expectedStatus:    prEntity.PROpen,
+			expectedReviewers: []string{"u2", "u4"},
+			noError:           true,
+		},
+
+		{
+			what: "ready draws reviewer from pool",
+
+			operation: "ready",
+			storedPr: prEntity.PullRequest{
+				AuthorId:  "u1",
+				Status:    prEntity.PRDraft,
+				Reviewers: []string{},
+			},
+			pools:             platformPool,
+			expectedUpdated:   true,
+			expectedStatus:    prEntity.PROpen,
+			expectedReviewers: []string{"u2", "u4", "p1"},
+			noError:           true,
+		},
+
+		{
+			what: "reopen keeps pool reviewer out of team slots",
+
+			operation: "reopen",
+			storedPr: prEntity.PullRequest{
+				AuthorId:  "u1",
+				Status:    prEntity.PRClosed,
+				Reviewers: []string{"u2", "p1"},
+			},
+			pools:             platformPool,
+			expectedUpdated:   true,
+			expectedStatus:    prEntity.PROpen,
+			expectedReviewers: []string{"u2", "p1", "u4"},
+			noError:           true,
+		},
+
+		{
+			what: "ready for closed pr",
+
+			operation: "ready",
+			storedPr: prEntity.PullRequest{
+				AuthorId: "u1",
+				Status:   prEntity.PRClosed,
+			},
+			expectedStatus:        prEntity.PRClosed,
+			expectedCallbackError: prErrors.ErrInvalidTransition,
+			repoError:             prErrors.ErrInvalidTransition,
+			expectedError:         prErrors.ErrInvalidTransition.Error(),
+		},
+
+		{
+			what: "reopen keeps reviewers and assigns missing",
+
+			operation: "reopen",
+			storedPr: prEntity.PullRequest{
+				AuthorId:  "u1",
+				Status:    prEntity.PRClosed,
+				Reviewers: []string{"u4"},
+			},
+			expectedUpdated:   true,
+			expectedStatus:    prEntity.PROpen,
+			expectedReviewers: []string{"u4", "u2"},
+			noError:           true,
+		},
+
+		{
+			what: "reopen draft",
+
+			operation: "reopen",
+			storedPr: prEntity.PullRequest{
+				AuthorId: "u1",
+				Status:   prEntity.PRDraft,
+			},
+			expectedStatus:        prEntity.PRDraft,
+			expectedCallbackError: prErrors.ErrInvalidTransition,
+			repoError:             prErrors.ErrInvalidTransition,
+			expectedError:         prErrors.ErrInvalidTransition.Error(),
+		},
+
+		{
+			what: "failed to reopen pr in repo",
+
+			operation: "reopen",
+			storedPr: prEntity.PullRequest{
+				AuthorId:  "u1",
+				Status:    prEntity.PRClosed,
+				Reviewers: []string{"u2", "u4"},
+			},
+			expectedUpdated:   true,
+			expectedStatus:    prEntity.PROpen,
+			expectedReviewers: []string{"u2", "u4"},
+			repoError:         errors.New("db is down"),
+			expectedError:     "failed to reopen pr in repo: db is down",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			var updatedPr prEntity.PullRequest
+
+			mockPullRequestRepo.
+				EXPECT().
+				UpdateStatus(gomock.Any(), "pr1", gomock.Any()).
+				DoAndReturn(func(
+					ctx context.Context,
+					pr string,
+					callback interfaces.UpdateStatusHandler,
+				) (prEntity.PullRequest, error) {
+					var updated bool
+					var err error
+
+					updatedPr, updated, err = callback(tc.storedPr, "team1", teamMembers, []memberEntity.Member{}, tc.pools)
+
+					assert.ErrorIs(t, err, tc.expectedCallbackError)
+					assert.Equal(t, tc.expectedUpdated, updated)
+					assert.Equal(t, tc.expectedStatus, updatedPr.Status)
+
+					if tc.expectedReviewers != nil {
+						assert.Equal(t, tc.expectedReviewers, updatedPr.Reviewers)
+					}
+
+					return updatedPr, tc.repoError
+				})
+
+			service := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
+
+			operations := map[string]func(ctx context.Context, prId string) (prEntity.PullRequest, error){
+				"close":  service.Close,
+				"reopen": service.Reopen,
+				"ready":  service.Ready,
+			}
+
+			pr, err := operations[tc.operation](context.Background(), "pr1")
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, updatedPr, pr)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestMergePolicy(t *testing.T) {
+	noApprovals := 0
+	noReviewerTeam := ""
+
+	config := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+		MergePolicy: config.MergePolicyConfig{
+			MinApprovals:         1,
+			NoChangesRequested:   true,
+			RequiredReviewerTeam: "security",
+			AuthorCannotMerge:    true,
+			Teams: map[string]config.MergePolicyOverride{
+				"team2": {
+					MinApprovals:         &noApprovals,
+					RequiredReviewerTeam: &noReviewerTeam,
+				},
+			},
+		},
+	}
+
+	type testCase struct {
+		what string
+
+		teamName      string
+		mergedBy      string
+		storedPr      prEntity.PullRequest
+		reviewers     []memberEntity.Member
+		expectedUnmet []prEntity.UnmetCondition
+	}
+
+	testCases := []testCase{
+		{
+			what: "all conditions are unmet",
+
+			teamName: "team1",
+			mergedBy: "u1",
+			storedPr: prEntity.PullRequest{
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2", "u3"},
+				Reviews: []prEntity.Review{
+					{ReviewerId: "u2", Verdict: prEntity.VerdictChangesRequested},
+					// verdict of replaced reviewer is not counted
+					{ReviewerId: "u4", Verdict: prEntity.VerdictApproved},
+				},
+			},
+			reviewers: []memberEntity.Member{
+				{Id: "u2", TeamName: "team1"},
+				{Id: "u3", TeamName: "team1"},
+			},
+			expectedUnmet: []prEntity.UnmetCondition{
+				{
+					Condition: prEntity.ConditionMinApprovals,
+					Message:   "1 approvals required, got 0",
+				},
+				{
+					Condition: prEntity.ConditionNoChangesRequested,
+					Message:   "changes requested by u2",
+				},
+				{
+					Condition: prEntity.ConditionReviewerFromTeam,
+					Message:   "no reviewer from team security",
+				},
+				{
+					Condition: prEntity.ConditionAuthorCannotMerge,
+					Message:   "pr must be merged by somebody other than author",
+				},
+			},
+		},
+
+		{
+			what: "all conditions are met",
+
+			teamName: "team1",
+			mergedBy: "u9",
+			storedPr: prEntity.PullRequest{
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2", "u3"},
+				Reviews: []prEntity.Review{
+					{ReviewerId: "u2", Verdict: prEntity.VerdictApproved},
+					{ReviewerId: "u3", Verdict: prEntity.VerdictCommented},
+				},
+			},
+			reviewers: []memberEntity.Member{
+				{Id: "u2", TeamName: "team1"},
+				{Id: "u3", TeamName: "security"},
+			},
+		},
+
+		{
+			what: "reviewer from required team is member of several teams",
+
+			teamName: "team1",
+			mergedBy: "u9",
+			storedPr: prEntity.PullRequest{
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2"},
+				Reviews: []prEntity.Review{
+					{ReviewerId: "u2", Verdict: prEntity.VerdictApproved},
+				},
+			},
+			reviewers: []memberEntity.Member{
+				{Id: "u2", TeamName: "team1", Teams: []string{"security", "team1"}},
+			},
+		},
+
+		{
+			what: "team overrides policy",
+
+			teamName: "team2",
+			mergedBy: "",
+			storedPr: prEntity.PullRequest{
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2"},
+			},
+			reviewers: []memberEntity.Member{
+				{Id: "u2", TeamName: "team2"},
+			},
+			expectedUnmet: []prEntity.UnmetCondition{
+				{
+					Condition: prEntity.ConditionAuthorCannotMerge,
+					Message:   "pr must be merged by somebody other than author",
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			mockPullRequestRepo.
+				EXPECT().
+				UpdateStatus(gomock.Any(), "pr1", gomock.Any()).
+				DoAndReturn(func(
+					ctx context.Context,
+					pr string,
+					callback interfaces.UpdateStatusHandler,
+				) (prEntity.PullRequest, error) {
+					updatedPr, _, err := callback(tc.storedPr, tc.teamName, []memberEntity.Member{}, tc.reviewers, nil)
+
+					return updatedPr, err
+				})
+
+			service := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
+
+			pr, err := service.Merge(context.Background(), "pr1", tc.mergedBy)
+
+			if tc.expectedUnmet == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, prEntity.PRMerged, pr.Status)
+				return
+			}
+
+			var policyErr *prErrors.MergePolicyError
+
+			assert.ErrorIs(t, err, prErrors.ErrMergePolicy)
+			assert.ErrorAs(t, err, &policyErr)
+			assert.Equal(t, tc.expectedUnmet, policyErr.Unmet)
+		})
+	}
+}
+
+func TestReview(t *testing.T) {
+	config := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	type testCase struct {
+		what string
+
+		reviewerId            string
+		verdict               prEntity.ReviewVerdict
+		storedPr              prEntity.PullRequest
+		expectRepoCall        bool
+		expectedCallbackError error
+		repoError             error
+		expectedError         string
+		noError               bool
+	}
+
+	openPr := prEntity.PullRequest{
+		Id:        "pr1",
+		AuthorId:  "u1",
+		Status:    prEntity.PROpen,
+		Reviewers: []string{"u2", "u3"},
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid verdict",
 
-		prId            string
-		storedPr        prEntity.PullRequest
-		expectedPr      prEntity.PullRequest
-		expectedUpdated bool
-		repoError       error
-		expectedError   string
-		noError         bool
-	}
+			reviewerId:    "u2",
+			verdict:       prEntity.VerdictPending,
+			expectedError: prErrors.ErrInvalidVerdict.Error(),
+		},
 
-	testCases := []testCase{
 		{
 			what: "pr not found",
 
-			prId: "pr1",
-			storedPr: prEntity.PullRequest{
-				Status:   prEntity.PRMerged,
-				MergedAt: time.Now().Add(-time.Second),
-			},
-			expectedUpdated: false,
-			expectedPr: prEntity.PullRequest{
-				Status: prEntity.PRMerged,
-			},
-			repoError:     prErrors.ErrNotFound,
-			expectedError: prErrors.ErrNotFound.Error(),
+			reviewerId:     "u2",
+			verdict:        prEntity.VerdictApproved,
+			expectRepoCall: true,
+			repoError:      prErrors.ErrNotFound,
+			expectedError:  prErrors.ErrNotFound.Error(),
 		},
 
 		{
-			what: "failed to merge pr in repo",
+			what: "reviewer is not assigned",
+
+			reviewerId:            "u4",
+			verdict:               prEntity.VerdictApproved,
+			storedPr:              openPr,
+			expectRepoCall:        true,
+			expectedCallbackError: prErrors.ErrNotAssigned,
+			repoError:             prErrors.ErrNotAssigned,
+			expectedError:         prErrors.ErrNotAssigned.Error(),
+		},
 
-			prId: "pr1",
+		{
+			what: "pr already merged",
+
+			reviewerId: "u2",
+			verdict:    prEntity.VerdictApproved,
 			storedPr: prEntity.PullRequest{
-				Status:   prEntity.PRMerged,
-				MergedAt: time.Now().Add(-time.Second),
-			},
-			expectedUpdated: false,
-			expectedPr: prEntity.PullRequest{
-				Status: prEntity.PRMerged,
+				Id:        "pr1",
+				Status:    prEntity.PRMerged,
+				Reviewers: []string{"u2"},
 			},
-			repoError:     errors.New("db is down"),
-			expectedError: "failed to merge pr in repo: db is down",
+			expectRepoCall:        true,
+			expectedCallbackError: prErrors.ErrAlreadyMerged,
+			repoError:             prErrors.ErrAlreadyMerged,
+			expectedError:         prErrors.ErrAlreadyMerged.Error(),
 		},
 
 		{
-			what: "already merged",
+			what: "pr already closed",
 
-			prId: "pr1",
+			reviewerId: "u2",
+			verdict:    prEntity.VerdictCommented,
 			storedPr: prEntity.PullRequest{
-				Status:   prEntity.PRMerged,
-				MergedAt: time.Now().Add(-time.Second),
-			},
-			expectedUpdated: false,
-			expectedPr: prEntity.PullRequest{
-				Status: prEntity.PRMerged,
+				Id:        "pr1",
+				Status:    prEntity.PRClosed,
+				Reviewers: []string{"u2"},
 			},
-			repoError: nil,
-			noError:   true,
+			expectRepoCall:        true,
+			expectedCallbackError: prErrors.ErrAlreadyClosed,
+			repoError:             prErrors.ErrAlreadyClosed,
+			expectedError:         prErrors.ErrAlreadyClosed.Error(),
 		},
 
 		{
-			what: "successfully merged",
+			what: "failed to add review in repo",
+
+			reviewerId:     "u2",
+			verdict:        prEntity.VerdictApproved,
+			storedPr:       openPr,
+			expectRepoCall: true,
+			repoError:      errors.New("db is down"),
+			expectedError:  "failed to add review in repo: db is down",
+		},
 
-			prId: "pr1",
-			storedPr: prEntity.PullRequest{
-				Status:   prEntity.PROpen,
-				MergedAt: time.Now().Add(-time.Second),
-			},
-			expectedUpdated: true,
-			expectedPr: prEntity.PullRequest{
-				Status: prEntity.PRMerged,
-			},
-			repoError: nil,
-			noError:   true,
+		{
+			what: "successfully reviewed",
+
+			reviewerId:     "u3",
+			verdict:        prEntity.VerdictChangesRequested,
+			storedPr:       openPr,
+			expectRepoCall: true,
+			noError:        true,
 		},
 	}
 
@@ -421,35 +2224,46 @@ func TestMerge(t *testing.T) {
 
 			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
 
-			mockPullRequestRepo.
-				EXPECT().
-				UpdateStatus(gomock.Any(), tc.prId, gomock.Any()).
-				DoAndReturn(func(
-					ctx context.Context,
-					pr string,
-					callback interfaces.UpdateStatusHandler,
-				) (prEntity.PullRequest, error) {
-					updatedPr, updated := callback(tc.storedPr)
-
-					assert.Equal(t, tc.expectedUpdated, updated)
-					assert.Equal(t, tc.expectedPr.Status, updatedPr.Status)
-
-					if updated {
-						assert.NotEqual(t, tc.storedPr.MergedAt, updatedPr.MergedAt)
-					} else {
-						assert.Equal(t, tc.storedPr.MergedAt, updatedPr.MergedAt)
-					}
-
-					return tc.expectedPr, tc.repoError
-				})
+			if tc.expectRepoCall {
+				mockPullRequestRepo.
+					EXPECT().
+					AddReview(gomock.Any(), "pr1", gomock.Any()).
+					DoAndReturn(func(
+						ctx context.Context,
+						prId string,
+						callback interfaces.ReviewHandler,
+					) (prEntity.PullRequest, error) {
+						if tc.repoError == prErrors.ErrNotFound {
+							return prEntity.PullRequest{}, tc.repoError
+						}
+
+						review, err := callback(tc.storedPr)
+
+						if tc.expectedCallbackError == nil {
+							assert.NoError(t, err)
+							assert.Equal(t, tc.reviewerId, review.ReviewerId)
+							assert.Equal(t, tc.verdict, review.Verdict)
+						} else {
+							assert.ErrorIs(t, err, tc.expectedCallbackError)
+						}
+
+						pr := tc.storedPr
+						pr.Reviews = []prEntity.Review{review}
+
+						return pr, tc.repoError
+					})
+			}
 
-			service := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, &config)
+			service := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
 
-			pr, err := service.Merge(context.Background(), tc.prId)
+			pr, err := service.Review(context.Background(), "pr1", tc.reviewerId, tc.verdict)
 
 			if tc.noError {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedPr, pr)
+				assert.Equal(t, []prEntity.Review{
+					{ReviewerId: "u2", Verdict: prEntity.VerdictPending},
+					{ReviewerId: "u3", Verdict: prEntity.VerdictChangesRequested, ReviewedAt: pr.Reviews[0].ReviewedAt},
+				}, pr.ReviewerStates())
 			} else {
 				assert.Equal(t, tc.expectedError, err.Error())
 			}
@@ -479,6 +2293,8 @@ func TestReassign(t *testing.T) {
 		noError               bool
 	}
 
+	limit := 1
+
 	testCases := []testCase{
 		{
 			what: "cannot reassign",
@@ -737,6 +2553,122 @@ func TestReassign(t *testing.T) {
 			repoError:             nil,
 			noError:               true,
 		},
+
+		{
+			what: "ignore members at capacity",
+
+			prId:          "pr1",
+			authorId:      "u1",
+			oldReviewerId: "u2",
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+				{
+					Id:       "u2",
+					Activity: memberEntity.MemberActive,
+				},
+				{
+					Id:       "u3",
+					Activity: memberEntity.MemberActive,
+				},
+				{
+					Id:             "u4",
+					Activity:       memberEntity.MemberActive,
+					OpenReviews:    2,
+					MaxOpenReviews: &limit,
+				},
+				{
+					Id:       "u5",
+					Activity: memberEntity.MemberActive,
+				},
+			},
+			storedPr: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2", "u3"},
+			},
+			expectedPR: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u5", "u3"},
+			},
+			expectedCallbackError: nil,
+			expectedNewReviewer:   "u5",
+			repoError:             nil,
+			noError:               true,
+		},
+
+		{
+			what: "all members at capacity",
+
+			prId:          "pr1",
+			authorId:      "u1",
+			oldReviewerId: "u2",
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+				{
+					Id:       "u2",
+					Activity: memberEntity.MemberActive,
+				},
+				{
+					Id:             "u3",
+					Activity:       memberEntity.MemberActive,
+					OpenReviews:    2,
+					MaxOpenReviews: &limit,
+				},
+			},
+			storedPr: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2"},
+			},
+			expectedCallbackError: prErrors.ErrCannotReassign,
+			repoError:             prErrors.ErrCannotReassign,
+			expectedError:         prErrors.ErrCannotReassign.Error(),
+		},
+
+		{
+			what: "pr closed",
+
+			prId:          "pr1",
+			authorId:      "u1",
+			oldReviewerId: "u2",
+			teamMembers: []memberEntity.Member{
+				{
+					Id:       "u1",
+					Activity: memberEntity.MemberActive,
+				},
+				{
+					Id:       "u2",
+					Activity: memberEntity.MemberActive,
+				},
+				{
+					Id:       "u3",
+					Activity: memberEntity.MemberActive,
+				},
+			},
+			storedPr: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PRClosed,
+				Reviewers: []string{"u2"},
+			},
+			expectedCallbackError: prErrors.ErrAlreadyClosed,
+			repoError:             prErrors.ErrAlreadyClosed,
+			expectedError:         prErrors.ErrAlreadyClosed.Error(),
+		},
 	}
 
 	for i, tc := range testCases {
@@ -755,7 +2687,7 @@ func TestReassign(t *testing.T) {
 					oldReviewerId string,
 					callback interfaces.ReassignHandler,
 				) (prEntity.PullRequest, string, error) {
-					newReviewer, err := callback(tc.authorId, tc.storedPr, tc.teamMembers)
+					newReviewer, err := callback(tc.authorId, tc.storedPr, "team1", tc.teamMembers)
 
 					if tc.expectedCallbackError == nil {
 						assert.NoError(t, err)
@@ -767,7 +2699,7 @@ func TestReassign(t *testing.T) {
 					return tc.expectedPR, newReviewer, tc.repoError
 				})
 
-			service := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, &config)
+			service := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
 
 			pr, new, err := service.Reassign(context.Background(), tc.prId, tc.oldReviewerId)
 
@@ -781,3 +2713,140 @@ func TestReassign(t *testing.T) {
 		})
 	}
 }
+
+func TestReassignOpenReviews(t *testing.T) {
+	reviewerId := "u2"
+
+	config := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	teamMembers := []memberEntity.Member{
+		{
+			Id:       "u1",
+			Activity: memberEntity.MemberActive,
+		},
+		{
+			Id:          "u2",
+			Activity:    memberEntity.MemberActive,
+			Unavailable: true,
+		},
+		{
+			Id:       "u3",
+			Activity: memberEntity.MemberActive,
+		},
+	}
+
+	type testCase struct {
+		what string
+
+		prIds          []string
+		storedPrs      map[string]prEntity.PullRequest
+		idsRepoError   error
+		expectedReport prEntity.ReassignReport
+		expectedError  string
+		noError        bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "failed to get open pull requests",
+
+			idsRepoError:  errors.New("db is down"),
+			expectedError: "failed to get open pull requests from repo: db is down",
+		},
+
+		{
+			what: "no open reviews",
+
+			prIds: []string{},
+			expectedReport: prEntity.ReassignReport{
+				Reassigned:    []prEntity.Reassignment{},
+				NotReassigned: []string{},
+			},
+			noError: true,
+		},
+
+		{
+			what: "reassign and report pull requests without candidates",
+
+			prIds: []string{"pr1", "pr2", "pr3"},
+			storedPrs: map[string]prEntity.PullRequest{
+				"pr1": {
+					Id:        "pr1",
+					AuthorId:  "u1",
+					Status:    prEntity.PROpen,
+					Reviewers: []string{"u2"},
+				},
+				// the only free member is already a reviewer
+				"pr2": {
+					Id:        "pr2",
+					AuthorId:  "u1",
+					Status:    prEntity.PROpen,
+					Reviewers: []string{"u2", "u3"},
+				},
+				// merged after ids were read
+				"pr3": {
+					Id:        "pr3",
+					AuthorId:  "u1",
+					Status:    prEntity.PRMerged,
+					Reviewers: []string{"u2"},
+				},
+			},
+			expectedReport: prEntity.ReassignReport{
+				Reassigned: []prEntity.Reassignment{
+					{
+						PullRequestId: "pr1",
+						OldReviewerId: "u2",
+						NewReviewerId: "u3",
+					},
+				},
+				NotReassigned: []string{"pr2"},
+			},
+			noError: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			mockPullRequestRepo.
+				EXPECT().
+				GetOpenIdsByReviewer(gomock.Any(), reviewerId).
+				Return(tc.prIds, tc.idsRepoError)
+
+			mockPullRequestRepo.
+				EXPECT().
+				Reassign(gomock.Any(), gomock.Any(), reviewerId, gomock.Any()).
+				DoAndReturn(func(
+					ctx context.Context,
+					prId string,
+					oldReviewerId string,
+					callback interfaces.ReassignHandler,
+				) (prEntity.PullRequest, string, error) {
+					pr := tc.storedPrs[prId]
+
+					newReviewer, err := callback(pr.AuthorId, pr, "team1", teamMembers)
+
+					return pr, newReviewer, err
+				}).
+				Times(len(tc.prIds))
+
+			service := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
+
+			report, err := service.ReassignOpenReviews(context.Background(), reviewerId)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedReport, report)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package reviewstreamservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/interfaces"
+)
+
+type ReviewStreamService struct {
+	repo   interfaces.ReviewStreamRepo
+	broker interfaces.ReviewStreamBroker
+	cfg    *config.ReviewStreamConfig
+}
+
+func CreateReviewStreamService(
+	repo interfaces.ReviewStreamRepo,
+	broker interfaces.ReviewStreamBroker,
+	cfg *config.ReviewStreamConfig,
+) interfaces.ReviewStreamService {
+	return &ReviewStreamService{
+		repo:   repo,
+		broker: broker,
+		cfg:    cfg,
+	}
+}
+
+func (s *ReviewStreamService) Stream(
+	ctx context.Context,
+	reviewerId string,
+	lastEventId *int64,
+	sink interfaces.ReviewEventSink,
+) error {
+	// subscription goes first, so events stored during replay wake stream up
+	signal, unsubscribe := s.broker.Subscribe(reviewerId)
+	defer unsubscribe()
+
+	var after int64
+
+	if lastEventId != nil {
+		after = *lastEventId
+	} else {
+		last, err := s.repo.GetLastSeq(ctx)
+
+		if err != nil {
+			return fmt.Errorf("failed to get last review event from repo: %w", err)
+		}
+
+		after = last
+	}
+
+	keepAlive := time.NewTicker(s.cfg.KeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		events, err := s.repo.GetAfter(ctx, reviewerId, after, s.cfg.BatchSize)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("failed to get review events from repo: %w", err)
+		}
+
+		for _, event := range events {
+			if err := sink.Send(event); err != nil {
+				return fmt.Errorf("failed to send review event: %w", err)
+			}
+
+			after = event.Seq
+		}
+
+		// full batch means more events are stored already
+		if len(events) == s.cfg.BatchSize {
+			continue
+		}
+
+		open, err := s.wait(ctx, signal, keepAlive.C, sink)
+
+		if err != nil {
+			return err
+		}
+
+		if !open || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// blocks until new events may be available or ctx is done, idle stream is kept alive meanwhile.
+// False is returned, when broker is stopped and stream has to be closed
+func (s *ReviewStreamService) wait(
+	ctx context.Context,
+	signal <-chan struct{},
+	keepAlive <-chan time.Time,
+	sink interfaces.ReviewEventSink,
+) (bool, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return true, nil
+
+		case _, open := <-signal:
+			return open, nil
+
+		case <-keepAlive:
+			if err := sink.KeepAlive(); err != nil {
+				return true, fmt.Errorf("failed to keep review stream alive: %w", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package reviewstreamservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	reviewstreamservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/review-stream"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/entity"
+	reviewStreamMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingSink struct {
+	events  []entity.ReviewEvent
+	sendErr error
+}
+
+func (s *recordingSink) Send(event entity.ReviewEvent) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+
+	s.events = append(s.events, event)
+
+	return nil
+}
+
+func (s *recordingSink) KeepAlive() error {
+	return nil
+}
+
+// expected read of events after seq, stream is stopped after read, when cancel is set
+type repoRead struct {
+	after  int64
+	events []entity.ReviewEvent
+	err    error
+	cancel bool
+}
+
+func TestStream(t *testing.T) {
+	config := config.ReviewStreamConfig{
+		KeepAliveInterval: time.Minute,
+		BatchSize:         2,
+	}
+
+	event := func(seq int64, eventType entity.ReviewEventType) entity.ReviewEvent {
+		return entity.ReviewEvent{
+			Seq:           seq,
+			ReviewerId:    "u1",
+			Type:          eventType,
+			PullRequestId: "pr1",
+		}
+	}
+
+	lastEventId := int64(5)
+
+	type testCase struct {
+		what string
+
+		lastEventId    *int64
+		lastSeq        int64
+		lastSeqError   error
+		signaled       bool
+		brokerStopped  bool
+		reads          []repoRead
+		sendError      error
+		expectedEvents []entity.ReviewEvent
+		expectedError  string
+		noError        bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "failed to get last event",
+
+			lastSeqError:  errors.New("db is down"),
+			expectedError: "failed to get last review event from repo: db is down",
+		},
+
+		{
+			what: "failed to get events",
+
+			lastEventId:   &lastEventId,
+			reads:         []repoRead{{after: 5, err: errors.New("db is down")}},
+			expectedError: "failed to get review events from repo: db is down",
+		},
+
+		{
+			what: "failed to send event",
+
+			lastEventId:   &lastEventId,
+			reads:         []repoRead{{after: 5, events: []entity.ReviewEvent{event(6, entity.ReviewAssigned)}}},
+			sendError:     errors.New("broken pipe"),
+			expectedError: "failed to send review event: broken pipe",
+		},
+
+		{
+			what: "missed events are replayed after last event id",
+
+			lastEventId: &lastEventId,
+			reads: []repoRead{
+				{after: 5, events: []entity.ReviewEvent{event(6, entity.ReviewAssigned)}, cancel: true},
+			},
+			expectedEvents: []entity.ReviewEvent{event(6, entity.ReviewAssigned)},
+			noError:        true,
+		},
+
+		{
+			what: "long replay is read in batches",
+
+			lastEventId: &lastEventId,
+			reads: []repoRead{
+				{after: 5, events: []entity.ReviewEvent{event(6, entity.ReviewAssigned), event(8, entity.ReviewMerged)}},
+				{after: 8, events: []entity.ReviewEvent{event(9, entity.ReviewUnassigned)}, cancel: true},
+			},
+			expectedEvents: []entity.ReviewEvent{
+				event(6, entity.ReviewAssigned),
+				event(8, entity.ReviewMerged),
+				event(9, entity.ReviewUnassigned),
+			},
+			noError: true,
+		},
+
+		{
+			what: "new events are sent after notification",
+
+			lastSeq:  10,
+			signaled: true,
+			reads: []repoRead{
+				{after: 10},
+				{after: 10, events: []entity.ReviewEvent{event(11, entity.ReviewAssigned)}, cancel: true},
+			},
+			expectedEvents: []entity.ReviewEvent{event(11, entity.ReviewAssigned)},
+			noError:        true,
+		},
+
+		{
+			what: "stream is closed, when broker stops",
+
+			lastSeq:       10,
+			brokerStopped: true,
+			reads:         []repoRead{{after: 10}},
+			noError:       true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			mockRepo := reviewStreamMocks.NewMockReviewStreamRepo(ctrl)
+			mockBroker := reviewStreamMocks.NewMockReviewStreamBroker(ctrl)
+
+			signal := make(chan struct{}, 1)
+			if tc.signaled {
+				signal <- struct{}{}
+			}
+
+			if tc.brokerStopped {
+				close(signal)
+			}
+
+			unsubscribed := false
+
+			mockBroker.EXPECT().Subscribe("u1").Return((<-chan struct{})(signal), func() { unsubscribed = true })
+
+			if tc.lastEventId == nil {
+				mockRepo.EXPECT().GetLastSeq(gomock.Any()).Return(tc.lastSeq, tc.lastSeqError)
+			}
+
+			calls := make([]*gomock.Call, 0, len(tc.reads))
+
+			for _, read := range tc.reads {
+				calls = append(calls, mockRepo.EXPECT().GetAfter(gomock.Any(), "u1", read.after, 2).DoAndReturn(
+					func(_ context.Context, _ string, _ int64, _ int) ([]entity.ReviewEvent, error) {
+						if read.cancel {
+							cancel()
+						}
+
+						return read.events, read.err
+					},
+				))
+			}
+
+			gomock.InOrder(calls...)
+
+			sink := &recordingSink{sendErr: tc.sendError}
+
+			service := reviewstreamservice.CreateReviewStreamService(mockRepo, mockBroker, &config)
+
+			err := service.Stream(ctx, "u1", tc.lastEventId, sink)
+
+			assert.True(t, unsubscribed)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedEvents, sink.events)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package reviewerpicker
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+)
+
+type LeastLoadedPicker struct{}
+
+func CreateLeastLoadedPicker() interfaces.ReviewerPicker {
+	return &LeastLoadedPicker{}
+}
+
+func (p *LeastLoadedPicker) Pick(_ interfaces.PickScope, candidates []memberEntity.Member, count int) []string {
+	sorted := slices.Clone(candidates)
+
+	// shuffle before stable sort to break ties between equally loaded members randomly
+	rand.Shuffle(len(sorted), func(i, j int) {
+		sorted[i], sorted[j] = sorted[j], sorted[i]
+	})
+
+	slices.SortStableFunc(sorted, func(a, b memberEntity.Member) int {
+		return cmp.Compare(a.OpenReviews, b.OpenReviews)
+	})
+
+	resultLen := min(count, len(sorted))
+
+	return candidatesIds(sorted[:resultLen])
+}
@@ -0,0 +1,30 @@
+package reviewerpicker
+
+import (
+	"math/rand"
+
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+)
+
+type RandomPicker struct{}
+
+func CreateRandomPicker() interfaces.ReviewerPicker {
+	return &RandomPicker{}
+}
+
+func (p *RandomPicker) Pick(_ interfaces.PickScope, candidates []memberEntity.Member, count int) []string {
+	ids := candidatesIds(candidates)
+
+	resultLen := min(count, len(ids))
+
+	if len(ids) > resultLen {
+		// get resultLen random candidates with O(resultLen) complexity
+		for i := range resultLen {
+			index := rand.Intn(len(ids)-i) + i
+			ids[i], ids[index] = ids[index], ids[i]
+		}
+	}
+
+	return ids[:resultLen]
+}
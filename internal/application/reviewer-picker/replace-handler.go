@@ -0,0 +1,23 @@
+package reviewerpicker
+
+import (
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+)
+
+// CreateReplaceHandler applies reassign rules of pr and picks replacement with picker,
+// so manual reassign and reassign on deactivation choose reviewers the same way.
+// Members with skills matching labels of pr are preferred
+func CreateReplaceHandler(picker interfaces.ReviewerPicker) interfaces.ReplaceHandler {
+	return func(pr prEntity.PullRequest, teamName string, teamMembers []memberEntity.Member) (string, error) {
+		picked := PickBySkills(picker, interfaces.TeamScope(teamName), pr.ReplacementCandidates(teamMembers), pr.Labels, 1)
+
+		if len(picked) == 0 {
+			return "", prErrors.ErrCannotReassign
+		}
+
+		return picked[0], nil
+	}
+}
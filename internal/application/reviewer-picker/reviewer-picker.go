@@ -0,0 +1,85 @@
+package reviewerpicker
+
+import (
+	"fmt"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+)
+
+const (
+	StrategyRandom         = "random"
+	StrategyLeastLoaded    = "least_loaded"
+	StrategyRoundRobin     = "round_robin"
+	StrategyWeightedRandom = "weighted_random"
+)
+
+// TeamPicker chooses strategy by team name, so teams can try their own policy
+type TeamPicker struct {
+	defaultPicker interfaces.ReviewerPicker
+	teamPickers   map[string]interfaces.ReviewerPicker
+}
+
+func CreateReviewerPicker(cfg *config.ReviewerPickerConfig) (interfaces.ReviewerPicker, error) {
+	// pickers are shared between teams, stateful ones keep state per pick scope
+	pickers := map[string]interfaces.ReviewerPicker{
+		StrategyRandom:      CreateRandomPicker(),
+		StrategyLeastLoaded: CreateLeastLoadedPicker(),
+		StrategyRoundRobin:  CreateRoundRobinPicker(),
+	}
+
+	weightedPicker, err := CreateWeightedRandomPicker(cfg.Weights)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pickers[StrategyWeightedRandom] = weightedPicker
+
+	strategy := cfg.Strategy
+	if strategy == "" {
+		strategy = StrategyRandom
+	}
+
+	defaultPicker, ok := pickers[strategy]
+
+	if !ok {
+		return nil, fmt.Errorf("unknown reviewer picker strategy: %s", strategy)
+	}
+
+	teamPickers := make(map[string]interfaces.ReviewerPicker, len(cfg.TeamStrategies))
+
+	for teamName, teamStrategy := range cfg.TeamStrategies {
+		picker, ok := pickers[teamStrategy]
+
+		if !ok {
+			return nil, fmt.Errorf("unknown reviewer picker strategy for team %s: %s", teamName, teamStrategy)
+		}
+
+		teamPickers[teamName] = picker
+	}
+
+	return &TeamPicker{
+		defaultPicker: defaultPicker,
+		teamPickers:   teamPickers,
+	}, nil
+}
+
+func (p *TeamPicker) Pick(scope interfaces.PickScope, candidates []memberEntity.Member, count int) []string {
+	if picker, ok := p.teamPickers[scope.TeamName]; ok {
+		return picker.Pick(scope, candidates, count)
+	}
+
+	return p.defaultPicker.Pick(scope, candidates, count)
+}
+
+func candidatesIds(candidates []memberEntity.Member) []string {
+	ids := make([]string, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		ids = append(ids, candidate.Id)
+	}
+
+	return ids
+}
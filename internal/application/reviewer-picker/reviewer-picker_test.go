@@ -0,0 +1,388 @@
+package reviewerpicker_test
+
+import (
+	"fmt"
+	"testing"
+
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRandomPickers(t *testing.T) {
+	weightedPicker, err := reviewerpicker.CreateWeightedRandomPicker(map[string]int{"u2": 10})
+	assert.NoError(t, err)
+
+	pickers := map[string]interfaces.ReviewerPicker{
+		"random":          reviewerpicker.CreateRandomPicker(),
+		"weighted random": weightedPicker,
+	}
+
+	type testCase struct {
+		what string
+
+		candidates    []memberEntity.Member
+		count         int
+		expectedCount int
+	}
+
+	testCases := []testCase{
+		{
+			what:          "no candidates",
+			candidates:    []memberEntity.Member{},
+			count:         2,
+			expectedCount: 0,
+		},
+
+		{
+			what: "not enough for target count",
+			candidates: []memberEntity.Member{
+				{Id: "u1"},
+			},
+			count:         2,
+			expectedCount: 1,
+		},
+
+		{
+			what: "enough for target count",
+			candidates: []memberEntity.Member{
+				{Id: "u1"},
+				{Id: "u2"},
+				{Id: "u3"},
+				{Id: "u4"},
+			},
+			count:         2,
+			expectedCount: 2,
+		},
+	}
+
+	for name, picker := range pickers {
+		for i, tc := range testCases {
+			t.Run(fmt.Sprintf("%s test %d: %s", name, i, tc.what), func(t *testing.T) {
+				picked := picker.Pick(interfaces.TeamScope("team1"), tc.candidates, tc.count)
+
+				assert.Len(t, picked, tc.expectedCount)
+
+				candidatesMap := make(map[string]struct{})
+				for _, candidate := range tc.candidates {
+					candidatesMap[candidate.Id] = struct{}{}
+				}
+
+				pickedMap := make(map[string]struct{})
+				for _, id := range picked {
+					assert.Contains(t, candidatesMap, id)
+					assert.NotContains(t, pickedMap, id)
+					pickedMap[id] = struct{}{}
+				}
+			})
+		}
+	}
+}
+
+func TestLeastLoadedPicker(t *testing.T) {
+	type testCase struct {
+		what string
+
+		candidates []memberEntity.Member
+		count      int
+		expected   []string
+	}
+
+	testCases := []testCase{
+		{
+			what:       "no candidates",
+			candidates: []memberEntity.Member{},
+			count:      2,
+			expected:   []string{},
+		},
+
+		{
+			what: "pick least loaded",
+			candidates: []memberEntity.Member{
+				{Id: "u1", OpenReviews: 5},
+				{Id: "u2", OpenReviews: 0},
+				{Id: "u3", OpenReviews: 3},
+				{Id: "u4", OpenReviews: 1},
+			},
+			count:    2,
+			expected: []string{"u2", "u4"},
+		},
+
+		{
+			what: "not enough for target count",
+			candidates: []memberEntity.Member{
+				{Id: "u1", OpenReviews: 5},
+			},
+			count:    2,
+			expected: []string{"u1"},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			picker := reviewerpicker.CreateLeastLoadedPicker()
+
+			assert.Equal(t, tc.expected, picker.Pick(interfaces.TeamScope("team1"), tc.candidates, tc.count))
+		})
+	}
+}
+
+func TestLeastLoadedPickerTies(t *testing.T) {
+	candidates := []memberEntity.Member{
+		{Id: "u1", OpenReviews: 2},
+		{Id: "u2", OpenReviews: 1},
+		{Id: "u3", OpenReviews: 1},
+		{Id: "u4", OpenReviews: 0},
+	}
+
+	picker := reviewerpicker.CreateLeastLoadedPicker()
+
+	pickedSecond := make(map[string]struct{})
+
+	for range 100 {
+		picked := picker.Pick(interfaces.TeamScope("team1"), candidates, 2)
+
+		assert.Len(t, picked, 2)
+		assert.Equal(t, "u4", picked[0])
+		assert.Contains(t, []string{"u2", "u3"}, picked[1])
+
+		pickedSecond[picked[1]] = struct{}{}
+	}
+
+	// ties are broken randomly, so both equally loaded members are picked sometimes
+	assert.Len(t, pickedSecond, 2)
+}
+
+func TestRoundRobinPicker(t *testing.T) {
+	candidates := []memberEntity.Member{
+		{Id: "u3"},
+		{Id: "u1"},
+		{Id: "u2"},
+	}
+
+	picker := reviewerpicker.CreateRoundRobinPicker()
+
+	assert.Equal(t, []string{"u1", "u2"}, picker.Pick(interfaces.TeamScope("team1"), candidates, 2))
+	assert.Equal(t, []string{"u3", "u1"}, picker.Pick(interfaces.TeamScope("team1"), candidates, 2))
+
+	// queue is kept per team
+	assert.Equal(t, []string{"u1"}, picker.Pick(interfaces.TeamScope("team2"), candidates, 1))
+
+	// pool of team has its own queue, so it does not shift the queue of team
+	poolScope := interfaces.PickScope{TeamName: "team1", PoolName: "platform"}
+	assert.Equal(t, []string{"u1"}, picker.Pick(poolScope, candidates, 1))
+	assert.Equal(t, []string{"u2"}, picker.Pick(interfaces.TeamScope("team1"), candidates, 1))
+
+	// last picked member left the candidates list
+	assert.Equal(t, []string{"u3"}, picker.Pick(interfaces.TeamScope("team1"), []memberEntity.Member{{Id: "u3"}, {Id: "u4"}}, 1))
+}
+
+func TestWeightedRandomPickerInvalidWeight(t *testing.T) {
+	_, err := reviewerpicker.CreateWeightedRandomPicker(map[string]int{"u1": 0})
+
+	assert.EqualError(t, err, "weight of member u1 must be positive, got 0")
+}
+
+func TestCreateReviewerPicker(t *testing.T) {
+	type testCase struct {
+		what string
+
+		cfg           config.ReviewerPickerConfig
+		expectedError string
+		noError       bool
+	}
+
+	testCases := []testCase{
+		{
+			what:    "default strategy",
+			cfg:     config.ReviewerPickerConfig{},
+			noError: true,
+		},
+
+		{
+			what: "unknown strategy",
+			cfg: config.ReviewerPickerConfig{
+				Strategy: "by_mood",
+			},
+			expectedError: "unknown reviewer picker strategy: by_mood",
+		},
+
+		{
+			what: "unknown team strategy",
+			cfg: config.ReviewerPickerConfig{
+				Strategy: reviewerpicker.StrategyRandom,
+				TeamStrategies: map[string]string{
+					"backend": "by_mood",
+				},
+			},
+			expectedError: "unknown reviewer picker strategy for team backend: by_mood",
+		},
+
+		{
+			what: "invalid weights",
+			cfg: config.ReviewerPickerConfig{
+				Strategy: reviewerpicker.StrategyWeightedRandom,
+				Weights: map[string]int{
+					"u1": -1,
+				},
+			},
+			expectedError: "weight of member u1 must be positive, got -1",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			_, err := reviewerpicker.CreateReviewerPicker(&tc.cfg)
+
+			if tc.noError {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestTeamPicker(t *testing.T) {
+	picker, err := reviewerpicker.CreateReviewerPicker(&config.ReviewerPickerConfig{
+		Strategy: reviewerpicker.StrategyLeastLoaded,
+		TeamStrategies: map[string]string{
+			"frontend": reviewerpicker.StrategyRoundRobin,
+		},
+	})
+
+	assert.NoError(t, err)
+
+	candidates := []memberEntity.Member{
+		{Id: "u1", OpenReviews: 3},
+		{Id: "u2", OpenReviews: 1},
+		{Id: "u3", OpenReviews: 2},
+	}
+
+	// default strategy
+	assert.Equal(t, []string{"u2"}, picker.Pick(interfaces.TeamScope("backend"), candidates, 1))
+	assert.Equal(t, []string{"u2"}, picker.Pick(interfaces.TeamScope("backend"), candidates, 1))
+
+	// team override
+	assert.Equal(t, []string{"u1"}, picker.Pick(interfaces.TeamScope("frontend"), candidates, 1))
+	assert.Equal(t, []string{"u2"}, picker.Pick(interfaces.TeamScope("frontend"), candidates, 1))
+}
+
+func TestReplaceHandler(t *testing.T) {
+	limit := 1
+
+	replace := reviewerpicker.CreateReplaceHandler(reviewerpicker.CreateRandomPicker())
+
+	pr := prEntity.PullRequest{
+		Id:        "pr1",
+		AuthorId:  "u1",
+		Status:    prEntity.PROpen,
+		Reviewers: []string{"u2", "u3"},
+	}
+
+	teamMembers := []memberEntity.Member{
+		{Id: "u1", Activity: memberEntity.MemberActive},
+		{Id: "u2", Activity: memberEntity.MemberInactive},
+		{Id: "u3", Activity: memberEntity.MemberActive},
+		{Id: "u4", Activity: memberEntity.MemberInactive},
+		{Id: "u5", Activity: memberEntity.MemberActive, Unavailable: true},
+		{Id: "u6", Activity: memberEntity.MemberActive, OpenReviews: 1, MaxOpenReviews: &limit},
+	}
+
+	_, err := replace(pr, "team1", teamMembers)
+	assert.ErrorIs(t, err, prErrors.ErrCannotReassign)
+
+	newReviewer, err := replace(pr, "team1", append(teamMembers, memberEntity.Member{
+		Id:       "u7",
+		Activity: memberEntity.MemberActive,
+	}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "u7", newReviewer)
+}
+
+func TestPickBySkills(t *testing.T) {
+	candidates := []memberEntity.Member{
+		{Id: "u1", OpenReviews: 5, Skills: []string{"postgres", "security"}},
+		{Id: "u2", OpenReviews: 0, Skills: []string{"security"}},
+		{Id: "u3", OpenReviews: 2},
+		{Id: "u4", OpenReviews: 1, Skills: []string{"frontend"}},
+	}
+
+	type testCase struct {
+		what string
+
+		labels   []string
+		count    int
+		expected []string
+	}
+
+	testCases := []testCase{
+		{
+			what:     "no labels",
+			labels:   nil,
+			count:    2,
+			expected: []string{"u2", "u4"},
+		},
+
+		{
+			what:     "no matching skills",
+			labels:   []string{"docs"},
+			count:    2,
+			expected: []string{"u2", "u4"},
+		},
+
+		{
+			what:     "more matching skills first",
+			labels:   []string{"postgres", "security"},
+			count:    2,
+			expected: []string{"u1", "u2"},
+		},
+
+		{
+			what:     "rest of slots from other candidates",
+			labels:   []string{"postgres", "security"},
+			count:    3,
+			expected: []string{"u1", "u2", "u4"},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			picker := reviewerpicker.CreateLeastLoadedPicker()
+
+			picked := reviewerpicker.PickBySkills(picker, interfaces.TeamScope("team1"), candidates, tc.labels, tc.count)
+
+			assert.Equal(t, tc.expected, picked)
+		})
+	}
+}
+
+func TestPickBySkillsRoundRobin(t *testing.T) {
+	candidates := []memberEntity.Member{
+		{Id: "u1", Skills: []string{"postgres"}},
+		{Id: "u2"},
+		{Id: "u3"},
+		{Id: "u4"},
+	}
+
+	picker := reviewerpicker.CreateRoundRobinPicker()
+
+	// rotation advances once per pr, so the rest of slots goes around the team
+	expected := [][]string{
+		{"u1", "u2"},
+		{"u1", "u3"},
+		{"u1", "u4"},
+		{"u1", "u2"},
+	}
+
+	for i, expectedPicked := range expected {
+		picked := reviewerpicker.PickBySkills(picker, interfaces.TeamScope("team1"), candidates, []string{"postgres"}, 2)
+
+		assert.Equal(t, expectedPicked, picked, fmt.Sprintf("pr %d", i))
+	}
+}
@@ -0,0 +1,58 @@
+package reviewerpicker
+
+import (
+	"slices"
+	"sync"
+
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+)
+
+// RoundRobinPicker keeps queues in memory, so they start over on restart
+// and every replica of service has its own ones
+type RoundRobinPicker struct {
+	mu sync.Mutex
+	// pick scope -> id of the last picked member
+	lastPicked map[interfaces.PickScope]string
+}
+
+func CreateRoundRobinPicker() interfaces.ReviewerPicker {
+	return &RoundRobinPicker{
+		lastPicked: make(map[interfaces.PickScope]string),
+	}
+}
+
+func (p *RoundRobinPicker) Pick(scope interfaces.PickScope, candidates []memberEntity.Member, count int) []string {
+	ids := candidatesIds(candidates)
+
+	resultLen := min(count, len(ids))
+
+	if resultLen == 0 {
+		return []string{}
+	}
+
+	// order by id, so the queue survives changes of team members list
+	slices.Sort(ids)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	start := 0
+	if last, ok := p.lastPicked[scope]; ok {
+		start, _ = slices.BinarySearch(ids, last)
+
+		if start < len(ids) && ids[start] == last {
+			start++
+		}
+	}
+
+	res := make([]string, 0, resultLen)
+
+	for i := range resultLen {
+		res = append(res, ids[(start+i)%len(ids)])
+	}
+
+	p.lastPicked[scope] = res[len(res)-1]
+
+	return res
+}
@@ -0,0 +1,57 @@
+package reviewerpicker
+
+import (
+	"slices"
+
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+)
+
+// PickBySkills ranks candidates by number of skills matching labels of pr and fills slots
+// from the best rank first. Ranks, which fit into free slots, are taken whole, picker chooses
+// only inside the first rank, which does not fit, so it is called at most once per pr and
+// stateful pickers advance once. When nothing matches, all candidates have the same rank,
+// so choice is the same as the one of picker
+func PickBySkills(
+	picker interfaces.ReviewerPicker,
+	scope interfaces.PickScope,
+	candidates []memberEntity.Member,
+	labels []string,
+	count int,
+) []string {
+	ranks := make(map[int][]memberEntity.Member)
+
+	for _, candidate := range candidates {
+		matching := candidate.MatchingSkills(labels)
+		ranks[matching] = append(ranks[matching], candidate)
+	}
+
+	if len(ranks) <= 1 {
+		return picker.Pick(scope, candidates, count)
+	}
+
+	order := make([]int, 0, len(ranks))
+
+	for matching := range ranks {
+		order = append(order, matching)
+	}
+
+	slices.Sort(order)
+	slices.Reverse(order)
+
+	picked := make([]string, 0, count)
+
+	for _, matching := range order {
+		rank := ranks[matching]
+		free := count - len(picked)
+
+		if len(rank) > free {
+			picked = append(picked, picker.Pick(scope, rank, free)...)
+			break
+		}
+
+		picked = append(picked, candidatesIds(rank)...)
+	}
+
+	return picked
+}
@@ -0,0 +1,66 @@
+package reviewerpicker
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+)
+
+const defaultWeight = 1
+
+type WeightedRandomPicker struct {
+	weights map[string]int
+}
+
+func CreateWeightedRandomPicker(weights map[string]int) (interfaces.ReviewerPicker, error) {
+	for memberId, weight := range weights {
+		if weight <= 0 {
+			return nil, fmt.Errorf("weight of member %s must be positive, got %d", memberId, weight)
+		}
+	}
+
+	return &WeightedRandomPicker{
+		weights: weights,
+	}, nil
+}
+
+func (p *WeightedRandomPicker) Pick(_ interfaces.PickScope, candidates []memberEntity.Member, count int) []string {
+	type weightedCandidate struct {
+		id  string
+		key float64
+	}
+
+	weighted := make([]weightedCandidate, 0, len(candidates))
+
+	// sampling without replacement by Efraimidis-Spirakis:
+	// candidates with the smallest -ln(u)/w keys are picked
+	for _, candidate := range candidates {
+		weight, ok := p.weights[candidate.Id]
+		if !ok {
+			weight = defaultWeight
+		}
+
+		weighted = append(weighted, weightedCandidate{
+			id:  candidate.Id,
+			key: -math.Log(1-rand.Float64()) / float64(weight),
+		})
+	}
+
+	slices.SortFunc(weighted, func(a, b weightedCandidate) int {
+		return cmp.Compare(a.key, b.key)
+	})
+
+	resultLen := min(count, len(weighted))
+	res := make([]string, 0, resultLen)
+
+	for _, candidate := range weighted[:resultLen] {
+		res = append(res, candidate.id)
+	}
+
+	return res
+}
@@ -0,0 +1,169 @@
+package reviewerpublishjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	integrationEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/interfaces"
+	webhookEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+	"github.com/rs/zerolog"
+)
+
+// ReviewerPublishJob pushes queued reviewer changes to code hosts of prs.
+// Failed publications are retried with backoff, so outage of host does not lose them
+type ReviewerPublishJob struct {
+	repo interfaces.PublicationRepo
+	// source -> publisher, sources without one are published by noop
+	publishers map[string]interfaces.ReviewerPublisher
+	noop       interfaces.ReviewerPublisher
+	// source -> member id -> login in hosting
+	logins map[string]map[string]string
+	cfg    *config.ReviewerPublishConfig
+	logger zerolog.Logger
+}
+
+func CreateReviewerPublishJob(
+	repo interfaces.PublicationRepo,
+	publishers map[string]interfaces.ReviewerPublisher,
+	noop interfaces.ReviewerPublisher,
+	cfg *config.IntegrationsConfig,
+	log zerolog.Logger,
+) *ReviewerPublishJob {
+	return &ReviewerPublishJob{
+		repo:       repo,
+		publishers: publishers,
+		noop:       noop,
+		logins: map[string]map[string]string{
+			integrationEntity.SourceGithub: invert(cfg.Github.Users),
+			integrationEntity.SourceGitlab: invert(cfg.Gitlab.Users),
+		},
+		cfg:    &cfg.Publish,
+		logger: log.With().Str("job", "reviewer-publish").Logger(),
+	}
+}
+
+// login -> member id to member id -> login
+func invert(users map[string]string) map[string]string {
+	res := make(map[string]string, len(users))
+
+	for login, memberId := range users {
+		res[memberId] = login
+	}
+
+	return res
+}
+
+// Run publishes due changes every dispatch interval until ctx is done
+func (j *ReviewerPublishJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.cfg.DispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.PublishDue(ctx); err != nil {
+			j.logger.Error().Err(err).Msg("failed to publish reviewers")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *ReviewerPublishJob) PublishDue(ctx context.Context) error {
+	claimedAt := time.Now()
+
+	publications, err := j.repo.ClaimDue(ctx, claimedAt, j.cfg.Lease, j.cfg.BatchSize)
+
+	if err != nil {
+		return fmt.Errorf("failed to claim due publications from repo: %w", err)
+	}
+
+	policy := webhookEntity.RetryPolicy{
+		MaxAttempts: j.cfg.MaxAttempts,
+		BaseDelay:   j.cfg.BaseDelay,
+		MaxDelay:    j.cfg.MaxDelay,
+	}
+
+	for _, publication := range publications {
+		// attempt must end before lease, rest of the batch is claimed again after it
+		if time.Since(claimedAt)+j.cfg.Timeout > j.cfg.Lease {
+			break
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		publishCtx, cancel := context.WithTimeout(ctx, j.cfg.Timeout)
+		publishErr := j.publish(publishCtx, publication)
+		cancel()
+
+		if publishErr != nil {
+			publication = publication.Failed(publishErr.Error(), policy, time.Now())
+		} else {
+			publication = publication.Published(time.Now())
+		}
+
+		if publication.Status == entity.PublicationDead {
+			j.logger.Warn().
+				Str("pullRequestId", publication.Link.PullRequestId).
+				Int("attempts", publication.Attempts).
+				Str("lastError", publication.LastError).
+				Msg("reviewer publication is dead")
+		}
+
+		if err := j.repo.SaveAttempt(ctx, publication); err != nil {
+			return fmt.Errorf("failed to save publication attempt in repo: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (j *ReviewerPublishJob) publish(ctx context.Context, publication entity.Publication) error {
+	request := entity.ReviewerRequest{
+		Repository: publication.Link.Repository,
+		Number:     publication.Link.Number,
+		Added:      j.toLogins(publication.Link, publication.Added),
+		Removed:    j.toLogins(publication.Link, publication.Removed),
+	}
+
+	if request.IsEmpty() {
+		return nil
+	}
+
+	publisher, ok := j.publishers[publication.Link.Source]
+
+	if !ok {
+		publisher = j.noop
+	}
+
+	return publisher.Publish(ctx, request)
+}
+
+// members without login in hosting can not be requested there, so they are skipped
+func (j *ReviewerPublishJob) toLogins(link integrationEntity.HostLink, memberIds []string) []string {
+	logins := make([]string, 0, len(memberIds))
+
+	for _, memberId := range memberIds {
+		login, ok := j.logins[link.Source][memberId]
+
+		if !ok {
+			j.logger.Warn().
+				Str("pullRequestId", link.PullRequestId).
+				Str("memberId", memberId).
+				Msg("member has no login in hosting, it is not published")
+			continue
+		}
+
+		logins = append(logins, login)
+	}
+
+	return logins
+}
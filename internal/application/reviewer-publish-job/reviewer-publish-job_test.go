@@ -0,0 +1,248 @@
+package reviewerpublishjob_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	reviewerpublishjob "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-publish-job"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	integrationEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/interfaces"
+	reviewerPublishMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/mocks"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublishDue(t *testing.T) {
+	log := logger.NewTest()
+
+	config := config.IntegrationsConfig{
+		Github: config.GitHostingConfig{
+			Users: map[string]string{
+				"octocat": "u1",
+				"hubot":   "u2",
+			},
+		},
+		Gitlab: config.GitHostingConfig{
+			Users: map[string]string{
+				"root": "u1",
+			},
+		},
+		Publish: config.ReviewerPublishConfig{
+			BatchSize:   10,
+			Lease:       time.Minute,
+			Timeout:     5 * time.Second,
+			MaxAttempts: 3,
+			BaseDelay:   10 * time.Second,
+			MaxDelay:    time.Minute,
+		},
+	}
+
+	githubLink := integrationEntity.HostLink{
+		PullRequestId: "gh-1",
+		Source:        integrationEntity.SourceGithub,
+		Repository:    "acme/pr-service",
+		Number:        42,
+	}
+
+	reassignment := entity.Publication{
+		Id:      1,
+		Link:    githubLink,
+		Added:   []string{"u2"},
+		Removed: []string{"u1"},
+		Status:  entity.PublicationPending,
+	}
+
+	unmapped := reassignment
+	unmapped.Added = []string{"u7"}
+	unmapped.Removed = []string{}
+
+	lastAttempt := reassignment
+	lastAttempt.Attempts = 2
+
+	gitlabPublication := reassignment
+	gitlabPublication.Link = integrationEntity.HostLink{
+		PullRequestId: "gl-1",
+		Source:        integrationEntity.SourceGitlab,
+		Repository:    "1",
+		Number:        17,
+	}
+	gitlabPublication.Added = []string{}
+
+	type testCase struct {
+		what string
+
+		claimed          []entity.Publication
+		claimError       error
+		callPublish      bool
+		callNoop         bool
+		publishError     error
+		expectedRequest  entity.ReviewerRequest
+		expectedStatus   entity.PublicationStatus
+		expectedAttempts int
+		expectedLastErr  string
+		expectedDelay    time.Duration
+		expectedError    string
+		noError          bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "failed to claim due publications",
+
+			claimError:    errors.New("db is down"),
+			expectedError: "failed to claim due publications from repo: db is down",
+		},
+
+		{
+			what: "reassignment is published with logins",
+
+			claimed:     []entity.Publication{reassignment},
+			callPublish: true,
+			expectedRequest: entity.ReviewerRequest{
+				Repository: "acme/pr-service",
+				Number:     42,
+				Added:      []string{"hubot"},
+				Removed:    []string{"octocat"},
+			},
+			expectedStatus:   entity.PublicationPublished,
+			expectedAttempts: 1,
+			noError:          true,
+		},
+
+		{
+			what: "members without logins are not published",
+
+			claimed:          []entity.Publication{unmapped},
+			expectedStatus:   entity.PublicationPublished,
+			expectedAttempts: 1,
+			noError:          true,
+		},
+
+		{
+			what: "failed attempt is retried with backoff",
+
+			claimed:     []entity.Publication{reassignment},
+			callPublish: true,
+			expectedRequest: entity.ReviewerRequest{
+				Repository: "acme/pr-service",
+				Number:     42,
+				Added:      []string{"hubot"},
+				Removed:    []string{"octocat"},
+			},
+			publishError:     errors.New("unexpected status 502"),
+			expectedStatus:   entity.PublicationPending,
+			expectedAttempts: 1,
+			expectedLastErr:  "unexpected status 502",
+			expectedDelay:    10 * time.Second,
+			noError:          true,
+		},
+
+		{
+			what: "publication is dead after max attempts",
+
+			claimed:     []entity.Publication{lastAttempt},
+			callPublish: true,
+			expectedRequest: entity.ReviewerRequest{
+				Repository: "acme/pr-service",
+				Number:     42,
+				Added:      []string{"hubot"},
+				Removed:    []string{"octocat"},
+			},
+			publishError:     errors.New("connection refused"),
+			expectedStatus:   entity.PublicationDead,
+			expectedAttempts: 3,
+			expectedLastErr:  "connection refused",
+			noError:          true,
+		},
+
+		{
+			what: "hosting without publisher uses noop",
+
+			claimed:  []entity.Publication{gitlabPublication},
+			callNoop: true,
+			expectedRequest: entity.ReviewerRequest{
+				Repository: "1",
+				Number:     17,
+				Added:      []string{},
+				Removed:    []string{"root"},
+			},
+			expectedStatus:   entity.PublicationPublished,
+			expectedAttempts: 1,
+			noError:          true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPublicationRepo := reviewerPublishMocks.NewMockPublicationRepo(ctrl)
+			mockGithubPublisher := reviewerPublishMocks.NewMockReviewerPublisher(ctrl)
+			mockNoopPublisher := reviewerPublishMocks.NewMockReviewerPublisher(ctrl)
+
+			mockPublicationRepo.EXPECT().ClaimDue(
+				gomock.Any(),
+				gomock.Any(),
+				config.Publish.Lease,
+				config.Publish.BatchSize,
+			).Return(tc.claimed, tc.claimError)
+
+			if tc.callPublish {
+				mockGithubPublisher.EXPECT().Publish(gomock.Any(), tc.expectedRequest).Return(tc.publishError)
+			}
+
+			if tc.callNoop {
+				mockNoopPublisher.EXPECT().Publish(gomock.Any(), tc.expectedRequest).Return(nil)
+			}
+
+			var saved entity.Publication
+
+			if len(tc.claimed) > 0 {
+				mockPublicationRepo.EXPECT().SaveAttempt(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, p entity.Publication) error {
+						saved = p
+						return nil
+					},
+				)
+			}
+
+			job := reviewerpublishjob.CreateReviewerPublishJob(
+				mockPublicationRepo,
+				map[string]interfaces.ReviewerPublisher{
+					integrationEntity.SourceGithub: mockGithubPublisher,
+				},
+				mockNoopPublisher,
+				&config,
+				log,
+			)
+
+			startedAt := time.Now()
+			err := job.PublishDue(context.Background())
+
+			if tc.noError {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+
+			if len(tc.claimed) == 0 {
+				return
+			}
+
+			assert.Equal(t, tc.expectedStatus, saved.Status)
+			assert.Equal(t, tc.expectedAttempts, saved.Attempts)
+			assert.Equal(t, tc.expectedLastErr, saved.LastError)
+
+			if tc.expectedStatus == entity.PublicationPending {
+				assert.WithinDuration(t, startedAt.Add(tc.expectedDelay), saved.NextAttemptAt, time.Second)
+			}
+		})
+	}
+}
@@ -0,0 +1,406 @@
+package scimservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	memberErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/errors"
+	memberInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/interfaces"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/entity"
+	scimErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/interfaces"
+	teamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
+	teamInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/interfaces"
+	"github.com/google/uuid"
+)
+
+type ScimService struct {
+	repo          interfaces.ScimRepo
+	memberService memberInterfaces.MemberService
+	teamService   teamInterfaces.TeamService
+}
+
+func CreateScimService(
+	repo interfaces.ScimRepo,
+	memberService memberInterfaces.MemberService,
+	teamService teamInterfaces.TeamService,
+) interfaces.ScimService {
+	return &ScimService{
+		repo:          repo,
+		memberService: memberService,
+		teamService:   teamService,
+	}
+}
+
+func (s *ScimService) CreateUser(ctx context.Context, id, username string, active bool) (memberEntity.Member, error) {
+	if username == "" {
+		return memberEntity.Member{}, scimErrors.ErrInvalidUserName
+	}
+
+	if id == "" {
+		id = uuid.NewString()
+	}
+
+	activity := memberEntity.MemberInactive
+	if active {
+		activity = memberEntity.MemberActive
+	}
+
+	member, err := s.repo.CreateUser(ctx, memberEntity.NewMember(id, username, activity))
+
+	if err != nil {
+		if errors.Is(err, scimErrors.ErrUserExists) {
+			return memberEntity.Member{}, err
+		}
+
+		return memberEntity.Member{}, fmt.Errorf("failed to create user in repo: %w", err)
+	}
+
+	return member, nil
+}
+
+func (s *ScimService) GetUser(ctx context.Context, id string) (memberEntity.Member, error) {
+	member, err := s.repo.GetUser(ctx, id)
+
+	if err != nil {
+		if errors.Is(err, memberErrors.ErrMemberNotFound) {
+			return memberEntity.Member{}, err
+		}
+
+		return memberEntity.Member{}, fmt.Errorf("failed to get user from repo: %w", err)
+	}
+
+	return member, nil
+}
+
+func (s *ScimService) ListUsers(ctx context.Context, filter string, page entity.Page) (entity.UserList, error) {
+	parsed, ok := entity.ParseFilter(filter)
+
+	if !ok || !(parsed.IsEmpty() || parsed.On("userName")) {
+		return entity.UserList{}, scimErrors.ErrInvalidFilter
+	}
+
+	page = page.Normalize()
+
+	users, total, err := s.repo.ListUsers(ctx, parsed.Value, page.Offset(), page.Count)
+
+	if err != nil {
+		return entity.UserList{}, fmt.Errorf("failed to list users in repo: %w", err)
+	}
+
+	return entity.UserList{
+		Total: total,
+		Page:  page,
+		Users: users,
+	}, nil
+}
+
+func (s *ScimService) UpdateUser(ctx context.Context, id string, patch entity.UserPatch) (memberEntity.Member, error) {
+	if patch.Username != nil {
+		if *patch.Username == "" {
+			return memberEntity.Member{}, scimErrors.ErrInvalidUserName
+		}
+
+		if err := s.repo.SetUsername(ctx, id, *patch.Username); err != nil {
+			if errors.Is(err, memberErrors.ErrMemberNotFound) {
+				return memberEntity.Member{}, err
+			}
+
+			return memberEntity.Member{}, fmt.Errorf("failed to set username in repo: %w", err)
+		}
+	}
+
+	if patch.Active != nil {
+		// config default decides, whether open reviews of deactivated user are reassigned
+		if _, _, err := s.memberService.SetIsActive(ctx, id, *patch.Active, nil); err != nil {
+			if errors.Is(err, memberErrors.ErrMemberNotFound) {
+				return memberEntity.Member{}, err
+			}
+
+			return memberEntity.Member{}, fmt.Errorf("failed to set activity of user: %w", err)
+		}
+	}
+
+	return s.GetUser(ctx, id)
+}
+
+func (s *ScimService) DeleteUser(ctx context.Context, id string) error {
+	_, _, err := s.memberService.SetIsActive(ctx, id, false, nil)
+
+	if err != nil {
+		if errors.Is(err, memberErrors.ErrMemberNotFound) {
+			return err
+		}
+
+		return fmt.Errorf("failed to deactivate deleted user: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ScimService) CreateGroup(ctx context.Context, name string, memberIds []string) (teamEntity.Team, error) {
+	if !teamEntity.IsValidName(name) {
+		return teamEntity.Team{}, teamErrors.ErrInvalidTeamName
+	}
+
+	members, err := s.getMembers(ctx, memberIds)
+
+	if err != nil {
+		return teamEntity.Team{}, err
+	}
+
+	// upsert of existing team would replace its members, but SCIM create must not touch other group
+	if err := s.teamService.Create(ctx, name, members); err != nil {
+		if errors.Is(err, teamErrors.ErrTeamExists) {
+			return teamEntity.Team{}, err
+		}
+
+		return teamEntity.Team{}, fmt.Errorf("failed to create group: %w", err)
+	}
+
+	return s.getTeam(ctx, name)
+}
+
+func (s *ScimService) GetGroup(ctx context.Context, id string) (teamEntity.Team, error) {
+	name, err := s.getGroupName(ctx, id)
+
+	if err != nil {
+		return teamEntity.Team{}, err
+	}
+
+	return s.getTeam(ctx, name)
+}
+
+func (s *ScimService) ListGroups(ctx context.Context, filter string, page entity.Page) (entity.GroupList, error) {
+	parsed, ok := entity.ParseFilter(filter)
+
+	if !ok || !(parsed.IsEmpty() || parsed.On("displayName")) {
+		return entity.GroupList{}, scimErrors.ErrInvalidFilter
+	}
+
+	page = page.Normalize()
+
+	names, total, err := s.repo.ListGroupNames(ctx, parsed.Value, page.Offset(), page.Count)
+
+	if err != nil {
+		return entity.GroupList{}, fmt.Errorf("failed to list groups in repo: %w", err)
+	}
+
+	groups := make([]teamEntity.Team, 0, len(names))
+
+	for _, name := range names {
+		team, err := s.getTeam(ctx, name)
+
+		if err != nil {
+			return entity.GroupList{}, err
+		}
+
+		groups = append(groups, team)
+	}
+
+	return entity.GroupList{
+		Total:  total,
+		Page:   page,
+		Groups: groups,
+	}, nil
+}
+
+func (s *ScimService) UpdateGroup(
+	ctx context.Context,
+	id string,
+	ops []entity.GroupOperation,
+) (teamEntity.Team, error) {
+	name, err := s.getGroupName(ctx, id)
+
+	if err != nil {
+		return teamEntity.Team{}, err
+	}
+
+	team, err := s.getTeam(ctx, name)
+
+	if err != nil {
+		return teamEntity.Team{}, err
+	}
+
+	// PATCH is atomic (RFC 7644 3.5.2), so operations only build the final group, which is stored at once
+	newName := name
+	memberIds := make([]string, 0, len(team.Members))
+
+	for _, member := range team.Members {
+		memberIds = append(memberIds, member.Id)
+	}
+
+	for _, op := range ops {
+		switch op.Kind {
+		case entity.GroupRename:
+			newName = op.DisplayName
+
+		case entity.GroupAddMembers:
+			memberIds = appendMissing(memberIds, op.MemberIds)
+
+		case entity.GroupRemoveMembers:
+			// removal of users, which are not in group, is not an error in SCIM
+			memberIds = removeIds(memberIds, op.MemberIds)
+
+		case entity.GroupReplaceMembers:
+			memberIds = appendMissing([]string{}, op.MemberIds)
+
+		default:
+			return teamEntity.Team{}, scimErrors.ErrInvalidPatch
+		}
+	}
+
+	members, err := s.getGroupMembers(ctx, team, memberIds)
+
+	if err != nil {
+		return teamEntity.Team{}, err
+	}
+
+	team, err = s.teamService.Replace(ctx, name, newName, members)
+
+	if err != nil {
+		if errors.Is(err, teamErrors.ErrInvalidTeamName) ||
+			errors.Is(err, teamErrors.ErrTeamExists) ||
+			errors.Is(err, teamErrors.ErrTeamNotFound) {
+			return teamEntity.Team{}, err
+		}
+
+		return teamEntity.Team{}, fmt.Errorf("failed to update group: %w", err)
+	}
+
+	return team, nil
+}
+
+func (s *ScimService) DeleteGroup(ctx context.Context, id string) error {
+	name, err := s.getGroupName(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.teamService.Delete(ctx, name, teamEntity.DeleteRefuse, ""); err != nil {
+		if errors.Is(err, teamErrors.ErrTeamNotFound) || errors.Is(err, teamErrors.ErrTeamHasOpenPRs) {
+			return err
+		}
+
+		return fmt.Errorf("failed to delete group: %w", err)
+	}
+
+	return nil
+}
+
+func appendMissing(ids []string, added []string) []string {
+	present := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		present[id] = struct{}{}
+	}
+
+	for _, id := range added {
+		if _, ok := present[id]; !ok {
+			ids = append(ids, id)
+			present[id] = struct{}{}
+		}
+	}
+
+	return ids
+}
+
+func removeIds(ids []string, removed []string) []string {
+	remove := make(map[string]struct{}, len(removed))
+	for _, id := range removed {
+		remove[id] = struct{}{}
+	}
+
+	kept := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		if _, ok := remove[id]; !ok {
+			kept = append(kept, id)
+		}
+	}
+
+	return kept
+}
+
+// current members of group are already known, only new ones are read as provisioned users
+func (s *ScimService) getGroupMembers(
+	ctx context.Context,
+	team teamEntity.Team,
+	ids []string,
+) ([]memberEntity.Member, error) {
+	current := make(map[string]memberEntity.Member, len(team.Members))
+	for _, member := range team.Members {
+		current[member.Id] = member
+	}
+
+	members := make([]memberEntity.Member, 0, len(ids))
+
+	for _, id := range ids {
+		if member, ok := current[id]; ok {
+			members = append(members, member)
+			continue
+		}
+
+		added, err := s.getMembers(ctx, []string{id})
+
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, added...)
+	}
+
+	return members, nil
+}
+
+// team upsert creates unknown members, so users are required to be provisioned before groups
+func (s *ScimService) getMembers(ctx context.Context, ids []string) ([]memberEntity.Member, error) {
+	members := make([]memberEntity.Member, 0, len(ids))
+
+	for _, id := range ids {
+		member, err := s.repo.GetUser(ctx, id)
+
+		if err != nil {
+			if errors.Is(err, memberErrors.ErrMemberNotFound) {
+				return nil, scimErrors.ErrUnknownMembers
+			}
+
+			return nil, fmt.Errorf("failed to get member of group from repo: %w", err)
+		}
+
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+func (s *ScimService) getGroupName(ctx context.Context, id string) (string, error) {
+	name, err := s.repo.GetGroupName(ctx, id)
+
+	if err != nil {
+		if errors.Is(err, teamErrors.ErrTeamNotFound) {
+			return "", err
+		}
+
+		return "", fmt.Errorf("failed to get group from repo: %w", err)
+	}
+
+	return name, nil
+}
+
+func (s *ScimService) getTeam(ctx context.Context, name string) (teamEntity.Team, error) {
+	team, err := s.teamService.GetByName(ctx, name)
+
+	if err != nil {
+		if errors.Is(err, teamErrors.ErrTeamNotFound) {
+			return teamEntity.Team{}, err
+		}
+
+		return teamEntity.Team{}, fmt.Errorf("failed to get group: %w", err)
+	}
+
+	return team, nil
+}
@@ -0,0 +1,303 @@
+package scimservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	memberservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/member"
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
+	scimservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/scim"
+	teamservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/team"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	memberMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/mocks"
+	scimEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/entity"
+	scimErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/interfaces"
+	scimMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/mocks"
+	teamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
+	teamMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func createService(
+	scimRepo *scimMocks.MockScimRepo,
+	teamRepo *teamMocks.MockTeamRepo,
+	memberRepo *memberMocks.MockMemberRepo,
+) interfaces.ScimService {
+	cfg := config.PullRequestConfig{}
+
+	memberService := memberservice.CreateMemberService(memberRepo, reviewerpicker.CreateRandomPicker(), &cfg)
+	teamService := teamservice.CreateTeamService(teamRepo, reviewerpicker.CreateRandomPicker(), nil, &cfg)
+
+	return scimservice.CreateScimService(scimRepo, memberService, teamService)
+}
+
+func TestCreateUser(t *testing.T) {
+	type testCase struct {
+		what string
+
+		id               string
+		username         string
+		active           bool
+		callRepo         bool
+		expectedActivity memberEntity.MemberActivity
+		expectedError    string
+		noError          bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "empty username",
+
+			id:            "u1",
+			username:      "",
+			active:        true,
+			expectedError: scimErrors.ErrInvalidUserName.Error(),
+		},
+
+		{
+			what: "id of identity provider kept",
+
+			id:               "u1",
+			username:         "bob",
+			active:           true,
+			callRepo:         true,
+			expectedActivity: memberEntity.MemberActive,
+			noError:          true,
+		},
+
+		{
+			what: "id generated without externalId",
+
+			username:         "bob",
+			active:           true,
+			callRepo:         true,
+			expectedActivity: memberEntity.MemberActive,
+			noError:          true,
+		},
+
+		{
+			what: "inactive user created",
+
+			id:               "u1",
+			username:         "bob",
+			active:           false,
+			callRepo:         true,
+			expectedActivity: memberEntity.MemberInactive,
+			noError:          true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			scimRepo := scimMocks.NewMockScimRepo(ctrl)
+
+			if tc.callRepo {
+				scimRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, member memberEntity.Member) (memberEntity.Member, error) {
+						if tc.id == "" {
+							_, err := uuid.Parse(member.Id)
+							assert.NoError(t, err)
+						} else {
+							assert.Equal(t, tc.id, member.Id)
+						}
+
+						assert.Equal(t, tc.username, member.Username)
+						assert.Equal(t, tc.expectedActivity, member.Activity)
+
+						return member, nil
+					},
+				)
+			}
+
+			service := createService(scimRepo, teamMocks.NewMockTeamRepo(ctrl), memberMocks.NewMockMemberRepo(ctrl))
+
+			_, err := service.CreateUser(context.Background(), tc.id, tc.username, tc.active)
+
+			if tc.noError {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestListGroups(t *testing.T) {
+	type testCase struct {
+		what string
+
+		filter         string
+		page           scimEntity.Page
+		callRepo       bool
+		expectedName   string
+		expectedOffset int
+		expectedLimit  int
+		storedNames    []string
+		expectedPage   scimEntity.Page
+		expectedError  string
+		noError        bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "filter on users attribute",
+
+			filter:        `userName eq "bob"`,
+			expectedError: scimErrors.ErrInvalidFilter.Error(),
+		},
+
+		{
+			what: "filter with unsupported operator",
+
+			filter:        `displayName sw "back"`,
+			expectedError: scimErrors.ErrInvalidFilter.Error(),
+		},
+
+		{
+			what: "group found by displayName",
+
+			filter:         `displayname EQ "backend"`,
+			callRepo:       true,
+			expectedName:   "backend",
+			expectedOffset: 0,
+			expectedLimit:  scimEntity.MaxPageSize,
+			storedNames:    []string{"backend"},
+			expectedPage:   scimEntity.Page{StartIndex: 1, Count: scimEntity.MaxPageSize},
+			noError:        true,
+		},
+
+		{
+			what: "too large page limited",
+
+			page:           scimEntity.Page{StartIndex: 201, Count: 1000},
+			callRepo:       true,
+			expectedOffset: 200,
+			expectedLimit:  scimEntity.MaxPageSize,
+			storedNames:    []string{},
+			expectedPage:   scimEntity.Page{StartIndex: 201, Count: scimEntity.MaxPageSize},
+			noError:        true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			scimRepo := scimMocks.NewMockScimRepo(ctrl)
+			teamRepo := teamMocks.NewMockTeamRepo(ctrl)
+
+			if tc.callRepo {
+				scimRepo.EXPECT().ListGroupNames(
+					gomock.Any(),
+					tc.expectedName,
+					tc.expectedOffset,
+					tc.expectedLimit,
+				).Return(tc.storedNames, len(tc.storedNames), nil)
+			}
+
+			for _, name := range tc.storedNames {
+				teamRepo.EXPECT().GetByName(gomock.Any(), name).Return(teamEntity.Team{Id: "g1", Name: name}, nil)
+			}
+
+			service := createService(scimRepo, teamRepo, memberMocks.NewMockMemberRepo(ctrl))
+
+			list, err := service.ListGroups(context.Background(), tc.filter, tc.page)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPage, list.Page)
+				assert.Equal(t, len(tc.storedNames), len(list.Groups))
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestDeleteGroup(t *testing.T) {
+	type testCase struct {
+		what string
+
+		groupError    error
+		callDelete    bool
+		deleteError   error
+		expectedError string
+		noError       bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "group not found",
+
+			groupError:    teamErrors.ErrTeamNotFound,
+			expectedError: teamErrors.ErrTeamNotFound.Error(),
+		},
+
+		{
+			what: "group with open pull requests",
+
+			callDelete:    true,
+			deleteError:   teamErrors.ErrTeamHasOpenPRs,
+			expectedError: teamErrors.ErrTeamHasOpenPRs.Error(),
+		},
+
+		{
+			what: "failed to delete group",
+
+			callDelete:    true,
+			deleteError:   errors.New("db is down"),
+			expectedError: "failed to delete group: failed to delete team in repo: db is down",
+		},
+
+		{
+			what: "group deleted",
+
+			callDelete: true,
+			noError:    true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			scimRepo := scimMocks.NewMockScimRepo(ctrl)
+			teamRepo := teamMocks.NewMockTeamRepo(ctrl)
+
+			scimRepo.EXPECT().GetGroupName(gomock.Any(), "g1").Return("backend", tc.groupError)
+
+			if tc.callDelete {
+				teamRepo.EXPECT().Delete(
+					gomock.Any(),
+					"backend",
+					teamEntity.DeleteRefuse,
+					"",
+					gomock.Any(),
+					gomock.Any(),
+				).Return(teamEntity.DeletionReport{}, tc.deleteError)
+			}
+
+			service := createService(scimRepo, teamRepo, memberMocks.NewMockMemberRepo(ctrl))
+
+			err := service.DeleteGroup(context.Background(), "g1")
+
+			if tc.noError {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
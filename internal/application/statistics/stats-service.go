@@ -2,28 +2,57 @@ package statsservice
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/statistics/entity"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/statistics/interfaces"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
 )
 
 type StatsService struct {
 	repo interfaces.StatsRepo
+	cfg  *config.StatsConfig
 }
 
-func CreateStatsService(repo interfaces.StatsRepo) interfaces.StatsService {
+func CreateStatsService(repo interfaces.StatsRepo, cfg *config.StatsConfig) interfaces.StatsService {
 	return &StatsService{
 		repo: repo,
+		cfg:  cfg,
 	}
 }
 
-func (s *StatsService) GetAssignmentsPerMember(ctx context.Context, limit, offset int) ([]entity.AssignmentsPerMember, error) {
-	stats, err := s.repo.GetAssignmentsPerMember(ctx, limit, offset)
+func (s *StatsService) GetAssignmentsPerMember(
+	ctx context.Context,
+	teamName string,
+	after *pagination.Cursor,
+	limit int,
+) ([]entity.AssignmentsPerMember, *pagination.Cursor, error) {
+	if limit == 0 {
+		limit = s.cfg.OutLimit
+	}
+
+	if limit < 1 || limit > s.cfg.OutLimit {
+		return []entity.AssignmentsPerMember{}, nil, fmt.Errorf(
+			"%w: limit must be between 1 and %d",
+			pagination.ErrInvalidLimit,
+			s.cfg.OutLimit,
+		)
+	}
+
+	stats, err := s.repo.GetAssignmentsPerMember(ctx, teamName, after, limit+1)
+
+	if errors.Is(err, pagination.ErrInvalidCursor) || errors.Is(err, teamErrors.ErrTeamNotFound) {
+		return []entity.AssignmentsPerMember{}, nil, err
+	}
 
 	if err != nil {
-		return []entity.AssignmentsPerMember{}, fmt.Errorf("failed to get assignments stats from repo: %w", err)
+		return []entity.AssignmentsPerMember{}, nil, fmt.Errorf("failed to get assignments stats from repo: %w", err)
 	}
 
-	return stats, nil
+	stats, next := pagination.NextPage(stats, limit, entity.AssignmentsPerMember.Cursor)
+
+	return stats, next, nil
 }
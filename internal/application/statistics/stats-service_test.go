@@ -7,51 +7,114 @@ import (
 	"testing"
 
 	statsservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/statistics"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/statistics/entity"
 	statsMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/statistics/mocks"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestGetAssignmentsPerMember(t *testing.T) {
+	config := config.StatsConfig{
+		OutLimit: 10,
+	}
+
+	after := &pagination.Cursor{Value: "7", Id: "u0"}
+
+	repoStats := []entity.AssignmentsPerMember{
+		{
+			MemberId:         "u1",
+			AssignmentsCount: 5,
+		},
+		{
+			MemberId:         "u2",
+			AssignmentsCount: 2,
+		},
+	}
+
 	type testCase struct {
 		what string
 
-		limit              int
-		offset             int
-		expectedStatistics []entity.AssignmentsPerMember
-		repoError          error
-		expectedError      string
-		noError            bool
+		limit          int
+		teamName       string
+		callRepo       bool
+		expectedLimit  int
+		repoStats      []entity.AssignmentsPerMember
+		repoError      error
+		expectedStats  []entity.AssignmentsPerMember
+		expectedCursor *pagination.Cursor
+		expectedError  string
+		noError        bool
 	}
 
 	testCases := []testCase{
+		{
+			what: "limit exceeds max page size",
+
+			limit:         11,
+			expectedError: "invalid page size: limit must be between 1 and 10",
+		},
+
+		{
+			what: "negative limit",
+
+			limit:         -1,
+			expectedError: "invalid page size: limit must be between 1 and 10",
+		},
+
+		{
+			what: "cursor rejected by repo",
+
+			limit:         5,
+			callRepo:      true,
+			expectedLimit: 6,
+			repoError:     pagination.ErrInvalidCursor,
+			expectedError: "invalid cursor",
+		},
+
 		{
 			what: "failed to get assignments stats from repo",
 
 			limit:         5,
-			offset:        10,
+			callRepo:      true,
+			expectedLimit: 6,
 			repoError:     errors.New("db is down"),
 			expectedError: "failed to get assignments stats from repo: db is down",
 		},
 
 		{
-			what: "successfully get statistics",
-
-			limit:     2,
-			offset:    10,
-			repoError: nil,
-			noError:   true,
-			expectedStatistics: []entity.AssignmentsPerMember{
-				{
-					MemberId:         "u1",
-					AssignmentsCount: 5,
-				},
-				{
-					MemberId:         "u2",
-					AssignmentsCount: 2,
-				},
-			},
+			what: "team not found",
+
+			limit:         5,
+			teamName:      "backend",
+			callRepo:      true,
+			expectedLimit: 6,
+			repoError:     teamErrors.ErrTeamNotFound,
+			expectedError: teamErrors.ErrTeamNotFound.Error(),
+		},
+
+		{
+			what: "successfully get page with next cursor",
+
+			limit:          1,
+			callRepo:       true,
+			expectedLimit:  2,
+			repoStats:      repoStats,
+			expectedStats:  repoStats[:1],
+			expectedCursor: &pagination.Cursor{Value: "5", Id: "u1"},
+			noError:        true,
+		},
+
+		{
+			what: "successfully get last page with default limit",
+
+			callRepo:      true,
+			expectedLimit: 11,
+			repoStats:     repoStats,
+			expectedStats: repoStats,
+			noError:       true,
 		},
 	}
 
@@ -62,19 +125,23 @@ func TestGetAssignmentsPerMember(t *testing.T) {
 
 			mockStatsRepo := statsMocks.NewMockStatsRepo(ctrl)
 
-			mockStatsRepo.EXPECT().GetAssignmentsPerMember(
-				gomock.Any(),
-				tc.limit,
-				tc.offset,
-			).Return(tc.expectedStatistics, tc.repoError)
+			if tc.callRepo {
+				mockStatsRepo.EXPECT().GetAssignmentsPerMember(
+					gomock.Any(),
+					tc.teamName,
+					after,
+					tc.expectedLimit,
+				).Return(tc.repoStats, tc.repoError)
+			}
 
-			statsService := statsservice.CreateStatsService(mockStatsRepo)
+			statsService := statsservice.CreateStatsService(mockStatsRepo, &config)
 
-			stats, err := statsService.GetAssignmentsPerMember(context.Background(), tc.limit, tc.offset)
+			stats, cursor, err := statsService.GetAssignmentsPerMember(context.Background(), tc.teamName, after, tc.limit)
 
 			if tc.noError {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedStatistics, stats)
+				assert.Equal(t, tc.expectedStats, stats)
+				assert.Equal(t, tc.expectedCursor, cursor)
 			} else {
 				assert.Equal(t, tc.expectedError, err.Error())
 			}
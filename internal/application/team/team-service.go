@@ -5,23 +5,47 @@ import (
 	"errors"
 	"fmt"
 
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	codeownersEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/entity"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	poolEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/entity"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
+	prInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
 	teamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
 	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/interfaces"
 )
 
 type TeamService struct {
-	repo interfaces.TeamRepo
+	repo    interfaces.TeamRepo
+	replace prInterfaces.ReplaceHandler
+	// team name -> rules from config
+	codeowners map[string]codeownersEntity.Ruleset
+	cfg        *config.PullRequestConfig
 }
 
-func CreateTeamService(repo interfaces.TeamRepo) interfaces.TeamService {
+func CreateTeamService(
+	repo interfaces.TeamRepo,
+	picker prInterfaces.ReviewerPicker,
+	codeowners map[string]codeownersEntity.Ruleset,
+	cfg *config.PullRequestConfig,
+) interfaces.TeamService {
 	return &TeamService{
-		repo: repo,
+		repo:       repo,
+		replace:    reviewerpicker.CreateReplaceHandler(picker),
+		codeowners: codeowners,
+		cfg:        cfg,
 	}
 }
 
-func (s *TeamService) Upsert(ctx context.Context, name string, membersList []memberEntity.Member) error {
+func (s *TeamService) Upsert(
+	ctx context.Context,
+	name string,
+	membersList []memberEntity.Member,
+	dryRun bool,
+) (teamEntity.UpsertDiff, error) {
 	team := teamEntity.NewTeam(name, membersList)
 
 	newMembers := make(map[string]struct{})
@@ -29,7 +53,7 @@ func (s *TeamService) Upsert(ctx context.Context, name string, membersList []mem
 		newMembers[member.Id] = struct{}{}
 	}
 
-	err := s.repo.Upsert(ctx, team, func(currentTeam teamEntity.Team) bool {
+	diff, err := s.repo.Upsert(ctx, team, func(currentTeam teamEntity.Team) bool {
 		if len(newMembers) != len(currentTeam.Members) {
 			return false
 		}
@@ -41,19 +65,60 @@ func (s *TeamService) Upsert(ctx context.Context, name string, membersList []mem
 		}
 
 		return true
-	})
+	}, dryRun)
 
 	if err != nil {
-		if errors.Is(err, teamErrors.ErrTeamExists) || errors.Is(err, teamErrors.ErrMemberOfOtherTeam) {
-			return err
+		if errors.Is(err, teamErrors.ErrTeamExists) {
+			return teamEntity.UpsertDiff{}, err
 		}
 
-		return fmt.Errorf("failed to upsert team to repo: %w", err)
+		return teamEntity.UpsertDiff{}, fmt.Errorf("failed to upsert team to repo: %w", err)
+	}
+
+	return diff, nil
+}
+
+func (s *TeamService) Create(ctx context.Context, name string, members []memberEntity.Member) error {
+	if !teamEntity.IsValidName(name) {
+		return teamErrors.ErrInvalidTeamName
+	}
+
+	err := s.repo.Create(ctx, teamEntity.NewTeam(name, members))
+
+	if errors.Is(err, teamErrors.ErrTeamExists) {
+		return err
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to create team in repo: %w", err)
 	}
 
 	return nil
 }
 
+func (s *TeamService) Replace(
+	ctx context.Context,
+	name string,
+	newName string,
+	members []memberEntity.Member,
+) (teamEntity.Team, error) {
+	if !teamEntity.IsValidName(newName) {
+		return teamEntity.Team{}, teamErrors.ErrInvalidTeamName
+	}
+
+	team, err := s.repo.Replace(ctx, name, teamEntity.Team{Name: newName, Members: members})
+
+	if err != nil {
+		if errors.Is(err, teamErrors.ErrTeamNotFound) || errors.Is(err, teamErrors.ErrTeamExists) {
+			return teamEntity.Team{}, err
+		}
+
+		return teamEntity.Team{}, fmt.Errorf("failed to replace team in repo: %w", err)
+	}
+
+	return team, nil
+}
+
 func (s *TeamService) GetByName(ctx context.Context, name string) (teamEntity.Team, error) {
 	team, err := s.repo.GetByName(ctx, name)
 
@@ -68,14 +133,230 @@ func (s *TeamService) GetByName(ctx context.Context, name string) (teamEntity.Te
 	return team, nil
 }
 
-func (s *TeamService) DeactivateAll(ctx context.Context, name string) error {
-	if err := s.repo.SetActivityForAll(ctx, name, memberEntity.MemberInactive); err != nil {
+func (s *TeamService) DeactivateAll(
+	ctx context.Context,
+	name string,
+	keepActive []string,
+	reassignReviews *bool,
+) (teamEntity.DeactivationReport, error) {
+	reassign := s.cfg.ReassignOnDeactivate
+	if reassignReviews != nil {
+		reassign = *reassignReviews
+	}
+
+	var replace prInterfaces.ReplaceHandler
+	if reassign {
+		replace = s.replace
+	}
+
+	if s.cfg.DeactivateTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.cfg.DeactivateTimeout)
+		defer cancel()
+	}
+
+	report, err := s.repo.DeactivateMembers(ctx, name, keepActive, replace)
+
+	if err != nil {
 		if errors.Is(err, teamErrors.ErrTeamNotFound) {
-			return err
+			return teamEntity.DeactivationReport{}, err
+		}
+
+		// driver may report canceled statement instead of context error
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return teamEntity.DeactivationReport{}, teamErrors.ErrDeactivationTimeout
 		}
 
-		return fmt.Errorf("failed to deactivate in repo: %w", err)
+		return teamEntity.DeactivationReport{}, fmt.Errorf("failed to deactivate in repo: %w", err)
+	}
+
+	return report, nil
+}
+
+func (s *TeamService) SetCodeowners(ctx context.Context, name string, rules string) (codeownersEntity.Ruleset, error) {
+	ruleset, err := codeownersEntity.Parse(rules)
+
+	if err != nil {
+		return codeownersEntity.Ruleset{}, err
+	}
+
+	if err := s.repo.SetCodeowners(ctx, name, ruleset); err != nil {
+		if errors.Is(err, teamErrors.ErrTeamNotFound) {
+			return codeownersEntity.Ruleset{}, err
+		}
+
+		return codeownersEntity.Ruleset{}, fmt.Errorf("failed to set codeowners in repo: %w", err)
+	}
+
+	return ruleset, nil
+}
+
+func (s *TeamService) GetCodeowners(
+	ctx context.Context,
+	name string,
+) (codeownersEntity.Ruleset, codeownersEntity.Source, error) {
+	uploaded, err := s.repo.GetCodeowners(ctx, name)
+
+	if err != nil {
+		if errors.Is(err, teamErrors.ErrTeamNotFound) {
+			return codeownersEntity.Ruleset{}, "", err
+		}
+
+		return codeownersEntity.Ruleset{}, "", fmt.Errorf("failed to get codeowners from repo: %w", err)
+	}
+
+	if uploaded != nil {
+		return *uploaded, codeownersEntity.SourceApi, nil
+	}
+
+	if ruleset, ok := s.codeowners[name]; ok {
+		return ruleset, codeownersEntity.SourceConfig, nil
+	}
+
+	return codeownersEntity.Ruleset{Rules: []codeownersEntity.Rule{}}, codeownersEntity.SourceNone, nil
+}
+
+func (s *TeamService) Rename(ctx context.Context, name, newName string) error {
+	if !teamEntity.IsValidName(newName) {
+		return teamErrors.ErrInvalidTeamName
+	}
+
+	err := s.repo.Rename(ctx, name, newName)
+
+	if errors.Is(err, teamErrors.ErrTeamNotFound) || errors.Is(err, teamErrors.ErrTeamExists) {
+		return err
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to rename team in repo: %w", err)
+	}
+
+	return nil
+}
+
+func (s *TeamService) SetArchived(ctx context.Context, name string, archived bool) error {
+	err := s.repo.SetArchived(ctx, name, archived)
+
+	if errors.Is(err, teamErrors.ErrTeamNotFound) {
+		return err
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to set archived in repo: %w", err)
 	}
 
 	return nil
 }
+
+func (s *TeamService) Delete(
+	ctx context.Context,
+	name string,
+	mode teamEntity.DeleteMode,
+	targetTeam string,
+) (teamEntity.DeletionReport, error) {
+	if !mode.IsValid() {
+		return teamEntity.DeletionReport{}, teamErrors.ErrInvalidDeleteMode
+	}
+
+	if mode == teamEntity.DeleteReassign && (targetTeam == "" || targetTeam == name) {
+		return teamEntity.DeletionReport{}, teamErrors.ErrInvalidTargetTeam
+	}
+
+	report, err := s.repo.Delete(ctx, name, mode, targetTeam, closeUnfinished, s.replace)
+
+	if err != nil {
+		if errors.Is(err, teamErrors.ErrTeamNotFound) ||
+			errors.Is(err, teamErrors.ErrTeamHasOpenPRs) ||
+			errors.Is(err, teamErrors.ErrTeamArchived) {
+			return teamEntity.DeletionReport{}, err
+		}
+
+		return teamEntity.DeletionReport{}, fmt.Errorf("failed to delete team in repo: %w", err)
+	}
+
+	return report, nil
+}
+
+// closes unfinished pull request of deleted team as /pullRequest/close does
+func closeUnfinished(
+	pr prEntity.PullRequest,
+	_ string,
+	_ []memberEntity.Member,
+	_ []memberEntity.Member,
+	_ []poolEntity.PoolQuota,
+) (prEntity.PullRequest, bool, error) {
+	if !pr.Status.CanTransitionTo(prEntity.PRClosed) {
+		return pr, false, prErrors.ErrInvalidTransition
+	}
+
+	pr.Status = prEntity.PRClosed
+
+	return pr, true, nil
+}
+
+func (s *TeamService) AddMembers(
+	ctx context.Context,
+	name string,
+	members []memberEntity.Member,
+) (teamEntity.Team, error) {
+	if len(members) == 0 {
+		return teamEntity.Team{}, teamErrors.ErrNoMembers
+	}
+
+	team, err := s.repo.AddMembers(ctx, name, members)
+
+	if err != nil {
+		if errors.Is(err, teamErrors.ErrTeamNotFound) {
+			return teamEntity.Team{}, err
+		}
+
+		return teamEntity.Team{}, fmt.Errorf("failed to add members in repo: %w", err)
+	}
+
+	return team, nil
+}
+
+func (s *TeamService) RemoveMembers(ctx context.Context, name string, memberIds []string) (teamEntity.Team, error) {
+	if len(memberIds) == 0 {
+		return teamEntity.Team{}, teamErrors.ErrNoMembers
+	}
+
+	team, err := s.repo.RemoveMembers(ctx, name, memberIds)
+
+	if err != nil {
+		if errors.Is(err, teamErrors.ErrTeamNotFound) || errors.Is(err, teamErrors.ErrMemberNotInTeam) {
+			return teamEntity.Team{}, err
+		}
+
+		return teamEntity.Team{}, fmt.Errorf("failed to remove members in repo: %w", err)
+	}
+
+	return team, nil
+}
+
+func (s *TeamService) MoveMembers(
+	ctx context.Context,
+	name string,
+	targetTeam string,
+	memberIds []string,
+) (teamEntity.Team, teamEntity.Team, error) {
+	if len(memberIds) == 0 {
+		return teamEntity.Team{}, teamEntity.Team{}, teamErrors.ErrNoMembers
+	}
+
+	if targetTeam == "" || targetTeam == name {
+		return teamEntity.Team{}, teamEntity.Team{}, teamErrors.ErrInvalidMoveTarget
+	}
+
+	source, target, err := s.repo.MoveMembers(ctx, name, targetTeam, memberIds)
+
+	if err != nil {
+		if errors.Is(err, teamErrors.ErrTeamNotFound) || errors.Is(err, teamErrors.ErrMemberNotInTeam) {
+			return teamEntity.Team{}, teamEntity.Team{}, err
+		}
+
+		return teamEntity.Team{}, teamEntity.Team{}, fmt.Errorf("failed to move members in repo: %w", err)
+	}
+
+	return source, target, nil
+}
@@ -4,10 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
 	teamservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/team"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	codeownersEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/entity"
+	codeownersErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/errors"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
+	prInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
 	teamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
 	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/interfaces"
@@ -25,6 +34,8 @@ func TestUpsert(t *testing.T) {
 		expectedTeam      teamEntity.Team
 		currentTeam       teamEntity.Team
 		expectedTeamEqual bool
+		dryRun            bool
+		repoDiff          teamEntity.UpsertDiff
 		repoError         error
 		expectedError     string
 		noError           bool
@@ -182,7 +193,7 @@ func TestUpsert(t *testing.T) {
 		},
 
 		{
-			what: "different count of members",
+			what: "failed to upsert team to repo",
 
 			teamName: "team1",
 			members: []memberEntity.Member{
@@ -215,12 +226,12 @@ func TestUpsert(t *testing.T) {
 			currentTeam: teamEntity.Team{},
 
 			expectedTeamEqual: false,
-			repoError:         teamErrors.ErrMemberOfOtherTeam,
-			expectedError:     teamErrors.ErrMemberOfOtherTeam.Error(),
+			repoError:         errors.New("db is down"),
+			expectedError:     "failed to upsert team to repo: db is down",
 		},
 
 		{
-			what: "failed to upsert team to repo",
+			what: "dry run returns diff",
 
 			teamName: "team1",
 			members: []memberEntity.Member{
@@ -228,14 +239,23 @@ func TestUpsert(t *testing.T) {
 					Id: "u1",
 				},
 				{
-					Id: "u2",
-				},
-				{
-					Id: "u3",
+					Id: "u4",
 				},
 			},
 
 			expectedTeam: teamEntity.Team{
+				Name: "team1",
+				Members: []memberEntity.Member{
+					{
+						Id: "u1",
+					},
+					{
+						Id: "u4",
+					},
+				},
+			},
+
+			currentTeam: teamEntity.Team{
 				Name: "team1",
 				Members: []memberEntity.Member{
 					{
@@ -244,17 +264,61 @@ func TestUpsert(t *testing.T) {
 					{
 						Id: "u2",
 					},
+				},
+			},
+
+			expectedTeamEqual: false,
+			dryRun:            true,
+			repoDiff: teamEntity.UpsertDiff{
+				Added:   []string{"u4"},
+				Removed: []string{"u2"},
+				AlsoMemberOf: []teamEntity.MemberOfOtherTeams{
+					{MemberId: "u4", Teams: []string{"team2"}},
+				},
+				DroppedReviews: []teamEntity.DroppedReview{
+					{PullRequestId: "pr1", ReviewerId: "u2"},
+				},
+			},
+			noError: true,
+		},
+
+		{
+			what: "dry run of same roster returns empty diff",
+
+			teamName: "team1",
+			members: []memberEntity.Member{
+				{
+					Id: "u1",
+				},
+			},
+
+			expectedTeam: teamEntity.Team{
+				Name: "team1",
+				Members: []memberEntity.Member{
 					{
-						Id: "u3",
+						Id: "u1",
 					},
 				},
 			},
 
-			currentTeam: teamEntity.Team{},
+			currentTeam: teamEntity.Team{
+				Name: "team1",
+				Members: []memberEntity.Member{
+					{
+						Id: "u1",
+					},
+				},
+			},
 
-			expectedTeamEqual: false,
-			repoError:         errors.New("db is down"),
-			expectedError:     "failed to upsert team to repo: db is down",
+			expectedTeamEqual: true,
+			dryRun:            true,
+			repoDiff: teamEntity.UpsertDiff{
+				Added:          []string{},
+				Removed:        []string{},
+				AlsoMemberOf:   []teamEntity.MemberOfOtherTeams{},
+				DroppedReviews: []teamEntity.DroppedReview{},
+			},
+			noError: true,
 		},
 	}
 
@@ -267,21 +331,27 @@ func TestUpsert(t *testing.T) {
 
 			mockTeamRepo.
 				EXPECT().
-				Upsert(gomock.Any(), teamEntity.Matcher(tc.expectedTeam), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, team teamEntity.Team, callback interfaces.TeamMatcher) error {
+				Upsert(gomock.Any(), teamEntity.Matcher(tc.expectedTeam), gomock.Any(), tc.dryRun).
+				DoAndReturn(func(
+					ctx context.Context,
+					team teamEntity.Team,
+					callback interfaces.TeamMatcher,
+					dryRun bool,
+				) (teamEntity.UpsertDiff, error) {
 					teamEqual := callback(tc.currentTeam)
 
 					assert.Equal(t, tc.expectedTeamEqual, teamEqual)
 
-					return tc.repoError
+					return tc.repoDiff, tc.repoError
 				})
 
-			service := teamservice.CreateTeamService(mockTeamRepo)
+			service := teamservice.CreateTeamService(mockTeamRepo, reviewerpicker.CreateRandomPicker(), nil, &config.PullRequestConfig{})
 
-			err := service.Upsert(context.Background(), tc.teamName, tc.members)
+			diff, err := service.Upsert(context.Background(), tc.teamName, tc.members, tc.dryRun)
 
 			if tc.noError {
 				assert.NoError(t, err)
+				assert.Equal(t, tc.repoDiff, diff)
 			} else {
 				assert.Equal(t, tc.expectedError, err.Error())
 			}
@@ -337,7 +407,7 @@ func TestGetByName(t *testing.T) {
 
 			mockTeamRepo.EXPECT().GetByName(gomock.Any(), teamName).Return(tc.expectedTeam, tc.repoError)
 
-			service := teamservice.CreateTeamService(mockTeamRepo)
+			service := teamservice.CreateTeamService(mockTeamRepo, reviewerpicker.CreateRandomPicker(), nil, &config.PullRequestConfig{})
 
 			team, err := service.GetByName(context.Background(), teamName)
 
@@ -352,13 +422,34 @@ func TestGetByName(t *testing.T) {
 }
 
 func TestDeactivateAll(t *testing.T) {
+	disabled := false
+
+	report := teamEntity.DeactivationReport{
+		Deactivated: 2,
+		Reassigned: []prEntity.Reassignment{
+			{
+				PullRequestId: "pr1",
+				OldReviewerId: "u1",
+				NewReviewerId: "u2",
+			},
+		},
+		ShortOfReviewers: []string{"pr2"},
+	}
+
 	type testCase struct {
 		what string
 
-		teamName      string
-		repoError     error
-		expectedError string
-		noError       bool
+		teamName             string
+		keepActive           []string
+		reassignOnDeactivate bool
+		reassignReviews      *bool
+		deactivateTimeout    time.Duration
+		waitForDeadline      bool
+		expectReplace        bool
+		report               teamEntity.DeactivationReport
+		repoError            error
+		expectedError        string
+		noError              bool
 	}
 
 	testCases := []testCase{
@@ -379,12 +470,52 @@ func TestDeactivateAll(t *testing.T) {
 		},
 
 		{
-			what: "failed to deactivate all members in team",
+			what: "successfully deactivate all members in team",
 
 			teamName:  "team1",
+			report:    teamEntity.DeactivationReport{Deactivated: 3},
 			repoError: nil,
 			noError:   true,
 		},
+
+		{
+			what: "keep some members active",
+
+			teamName:   "team1",
+			keepActive: []string{"u3", "u4"},
+			report:     teamEntity.DeactivationReport{Deactivated: 1},
+			noError:    true,
+		},
+
+		{
+			what: "reassign reviews by config",
+
+			teamName:             "team1",
+			reassignOnDeactivate: true,
+			expectReplace:        true,
+			report:               report,
+			noError:              true,
+		},
+
+		{
+			what: "request overrides config",
+
+			teamName:             "team1",
+			reassignOnDeactivate: true,
+			reassignReviews:      &disabled,
+			expectReplace:        false,
+			noError:              true,
+		},
+
+		{
+			what: "latency budget exceeded",
+
+			teamName:          "team1",
+			deactivateTimeout: time.Millisecond,
+			waitForDeadline:   true,
+			repoError:         errors.New("pq: canceling statement due to user request"),
+			expectedError:     teamErrors.ErrDeactivationTimeout.Error(),
+		},
 	}
 
 	for i, tc := range testCases {
@@ -394,15 +525,572 @@ func TestDeactivateAll(t *testing.T) {
 
 			mockTeamRepo := teamMocks.NewMockTeamRepo(ctrl)
 
-			mockTeamRepo.EXPECT().SetActivityForAll(
+			mockTeamRepo.EXPECT().DeactivateMembers(
 				gomock.Any(),
 				tc.teamName,
-				memberEntity.MemberInactive,
-			).Return(tc.repoError)
+				tc.keepActive,
+				gomock.Any(),
+			).DoAndReturn(func(
+				ctx context.Context,
+				name string,
+				keepActive []string,
+				replace prInterfaces.ReplaceHandler,
+			) (teamEntity.DeactivationReport, error) {
+				assert.Equal(t, tc.expectReplace, replace != nil)
+
+				if tc.waitForDeadline {
+					<-ctx.Done()
+				}
+
+				return tc.report, tc.repoError
+			})
+
+			cfg := config.PullRequestConfig{
+				ReassignOnDeactivate: tc.reassignOnDeactivate,
+				DeactivateTimeout:    tc.deactivateTimeout,
+			}
+
+			service := teamservice.CreateTeamService(mockTeamRepo, reviewerpicker.CreateRandomPicker(), nil, &cfg)
+
+			report, err := service.DeactivateAll(context.Background(), tc.teamName, tc.keepActive, tc.reassignReviews)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.report, report)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestSetCodeowners(t *testing.T) {
+	teamName := "team1"
+
+	type testCase struct {
+		what string
+
+		rules         string
+		callRepo      bool
+		expectedRules string
+		repoError     error
+		expectedError error
+		errorMessage  string
+		noError       bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "negated pattern",
+
+			rules:         "*.go u1\n!vendor/ u2",
+			expectedError: codeownersErrors.ErrInvalidRuleset,
+		},
+
+		{
+			what: "empty owner",
+
+			rules:         "*.go @",
+			expectedError: codeownersErrors.ErrInvalidRuleset,
+		},
+
+		{
+			what: "team not found",
+
+			rules:         "*.go u1",
+			callRepo:      true,
+			expectedRules: "*.go u1",
+			repoError:     teamErrors.ErrTeamNotFound,
+			expectedError: teamErrors.ErrTeamNotFound,
+		},
+
+		{
+			what: "failed to set codeowners in repo",
+
+			rules:         "*.go u1",
+			callRepo:      true,
+			expectedRules: "*.go u1",
+			repoError:     errors.New("db is down"),
+			errorMessage:  "failed to set codeowners in repo: db is down",
+		},
+
+		{
+			what: "successfully set codeowners",
+
+			rules:         "# owners\n\n*.go @u1 u2\n/docs/ u3\n",
+			callRepo:      true,
+			expectedRules: "*.go u1 u2\n/docs/ u3",
+			noError:       true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeamRepo := teamMocks.NewMockTeamRepo(ctrl)
+
+			if tc.callRepo {
+				mockTeamRepo.
+					EXPECT().
+					SetCodeowners(gomock.Any(), teamName, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, name string, ruleset codeownersEntity.Ruleset) error {
+						assert.Equal(t, tc.expectedRules, ruleset.String())
+
+						return tc.repoError
+					})
+			}
+
+			service := teamservice.CreateTeamService(mockTeamRepo, reviewerpicker.CreateRandomPicker(), nil, &config.PullRequestConfig{})
+
+			ruleset, err := service.SetCodeowners(context.Background(), teamName, tc.rules)
+
+			switch {
+			case tc.noError:
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRules, ruleset.String())
+
+			case tc.expectedError != nil:
+				assert.ErrorIs(t, err, tc.expectedError)
+
+			default:
+				assert.Equal(t, tc.errorMessage, err.Error())
+			}
+		})
+	}
+}
+
+func TestGetCodeowners(t *testing.T) {
+	teamName := "team1"
+
+	uploaded, err := codeownersEntity.Parse("*.go u1")
+	assert.NoError(t, err)
+
+	fromConfig, err := codeownersEntity.Parse("/docs/ u2")
+	assert.NoError(t, err)
+
+	type testCase struct {
+		what string
+
+		uploaded         *codeownersEntity.Ruleset
+		configCodeowners map[string]codeownersEntity.Ruleset
+		repoError        error
+		expectedRules    string
+		expectedSource   codeownersEntity.Source
+		expectedError    string
+		noError          bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "team not found",
+
+			repoError:     teamErrors.ErrTeamNotFound,
+			expectedError: teamErrors.ErrTeamNotFound.Error(),
+		},
+
+		{
+			what: "failed to get codeowners from repo",
+
+			repoError:     errors.New("db is down"),
+			expectedError: "failed to get codeowners from repo: db is down",
+		},
+
+		{
+			what: "uploaded rules take precedence over config",
+
+			uploaded: &uploaded,
+			configCodeowners: map[string]codeownersEntity.Ruleset{
+				teamName: fromConfig,
+			},
+			expectedRules:  "*.go u1",
+			expectedSource: codeownersEntity.SourceApi,
+			noError:        true,
+		},
+
+		{
+			what: "rules from config",
+
+			configCodeowners: map[string]codeownersEntity.Ruleset{
+				teamName: fromConfig,
+			},
+			expectedRules:  "/docs/ u2",
+			expectedSource: codeownersEntity.SourceConfig,
+			noError:        true,
+		},
+
+		{
+			what: "team without rules",
+
+			configCodeowners: map[string]codeownersEntity.Ruleset{
+				"team2": fromConfig,
+			},
+			expectedRules:  "",
+			expectedSource: codeownersEntity.SourceNone,
+			noError:        true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeamRepo := teamMocks.NewMockTeamRepo(ctrl)
+
+			mockTeamRepo.EXPECT().GetCodeowners(gomock.Any(), teamName).Return(tc.uploaded, tc.repoError)
+
+			service := teamservice.CreateTeamService(
+				mockTeamRepo,
+				reviewerpicker.CreateRandomPicker(),
+				tc.configCodeowners,
+				&config.PullRequestConfig{},
+			)
+
+			ruleset, source, err := service.GetCodeowners(context.Background(), teamName)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRules, ruleset.String())
+				assert.Equal(t, tc.expectedSource, source)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestRename(t *testing.T) {
+	type testCase struct {
+		what string
+
+		newName       string
+		callRepo      bool
+		repoError     error
+		expectedError string
+		noError       bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "empty new name",
+
+			newName:       "",
+			expectedError: teamErrors.ErrInvalidTeamName.Error(),
+		},
+
+		{
+			what: "too long new name",
+
+			newName:       strings.Repeat("t", 65),
+			expectedError: teamErrors.ErrInvalidTeamName.Error(),
+		},
+
+		{
+			what: "team not found",
+
+			newName:       "platform",
+			callRepo:      true,
+			repoError:     teamErrors.ErrTeamNotFound,
+			expectedError: teamErrors.ErrTeamNotFound.Error(),
+		},
+
+		{
+			what: "new name is taken",
+
+			newName:       "platform",
+			callRepo:      true,
+			repoError:     teamErrors.ErrTeamExists,
+			expectedError: teamErrors.ErrTeamExists.Error(),
+		},
+
+		{
+			what: "failed to rename team",
+
+			newName:       "platform",
+			callRepo:      true,
+			repoError:     errors.New("db is down"),
+			expectedError: "failed to rename team in repo: db is down",
+		},
+
+		{
+			what: "successfully renamed",
+
+			newName:  "platform",
+			callRepo: true,
+			noError:  true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeamRepo := teamMocks.NewMockTeamRepo(ctrl)
+
+			if tc.callRepo {
+				mockTeamRepo.EXPECT().Rename(gomock.Any(), "backend", tc.newName).Return(tc.repoError)
+			}
+
+			service := teamservice.CreateTeamService(mockTeamRepo, reviewerpicker.CreateRandomPicker(), nil, &config.PullRequestConfig{})
+
+			err := service.Rename(context.Background(), "backend", tc.newName)
+
+			if tc.noError {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type testCase struct {
+		what string
+
+		mode           teamEntity.DeleteMode
+		targetTeam     string
+		callRepo       bool
+		repoReport     teamEntity.DeletionReport
+		repoError      error
+		expectedReport teamEntity.DeletionReport
+		expectedError  string
+		noError        bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "unknown mode",
+
+			mode:          teamEntity.DeleteMode("ARCHIVE"),
+			expectedError: teamErrors.ErrInvalidDeleteMode.Error(),
+		},
+
+		{
+			what: "reassign without target team",
+
+			mode:          teamEntity.DeleteReassign,
+			expectedError: teamErrors.ErrInvalidTargetTeam.Error(),
+		},
+
+		{
+			what: "reassign to deleted team",
+
+			mode:          teamEntity.DeleteReassign,
+			targetTeam:    "backend",
+			expectedError: teamErrors.ErrInvalidTargetTeam.Error(),
+		},
+
+		{
+			what: "team has open pull requests",
+
+			mode:          teamEntity.DeleteRefuse,
+			callRepo:      true,
+			repoError:     teamErrors.ErrTeamHasOpenPRs,
+			expectedError: teamErrors.ErrTeamHasOpenPRs.Error(),
+		},
+
+		{
+			what: "target team is archived",
+
+			mode:          teamEntity.DeleteReassign,
+			targetTeam:    "platform",
+			callRepo:      true,
+			repoError:     teamErrors.ErrTeamArchived,
+			expectedError: teamErrors.ErrTeamArchived.Error(),
+		},
+
+		{
+			what: "failed to delete team",
+
+			mode:          teamEntity.DeleteClose,
+			callRepo:      true,
+			repoError:     errors.New("db is down"),
+			expectedError: "failed to delete team in repo: db is down",
+		},
+
+		{
+			what: "successfully deleted with closing",
+
+			mode:     teamEntity.DeleteClose,
+			callRepo: true,
+			repoReport: teamEntity.DeletionReport{
+				Closed: []string{"pr1", "pr2"},
+				Moved:  []string{},
+			},
+			expectedReport: teamEntity.DeletionReport{
+				Closed: []string{"pr1", "pr2"},
+				Moved:  []string{},
+			},
+			noError: true,
+		},
+
+		{
+			what: "successfully deleted with reassigning",
+
+			mode:       teamEntity.DeleteReassign,
+			targetTeam: "platform",
+			callRepo:   true,
+			repoReport: teamEntity.DeletionReport{
+				Closed: []string{},
+				Moved:  []string{"pr1", "pr2"},
+				Reassigned: []prEntity.Reassignment{
+					{PullRequestId: "pr1", OldReviewerId: "u1", NewReviewerId: "u3"},
+				},
+				ShortOfReviewers: []string{"pr2"},
+			},
+			expectedReport: teamEntity.DeletionReport{
+				Closed: []string{},
+				Moved:  []string{"pr1", "pr2"},
+				Reassigned: []prEntity.Reassignment{
+					{PullRequestId: "pr1", OldReviewerId: "u1", NewReviewerId: "u3"},
+				},
+				ShortOfReviewers: []string{"pr2"},
+			},
+			noError: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeamRepo := teamMocks.NewMockTeamRepo(ctrl)
+
+			if tc.callRepo {
+				mockTeamRepo.
+					EXPECT().
+					Delete(gomock.Any(), "backend", tc.mode, tc.targetTeam, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						_ string,
+						_ teamEntity.DeleteMode,
+						_ string,
+						closeHandler prInterfaces.UpdateStatusHandler,
+						replace prInterfaces.ReplaceHandler,
+					) (teamEntity.DeletionReport, error) {
+						assert.NotNil(t, replace)
+
+						// unfinished pull requests are closed as by status change
+						for _, status := range []prEntity.PRStatus{prEntity.PROpen, prEntity.PRDraft} {
+							pr := prEntity.PullRequest{Id: "pr1", Status: status, Reviewers: []string{"u1"}}
+
+							closed, updated, err := closeHandler(pr, "backend", nil, nil, nil)
+
+							assert.NoError(t, err)
+							assert.True(t, updated)
+							assert.Equal(t, prEntity.PRClosed, closed.Status)
+							assert.Equal(t, pr.Reviewers, closed.Reviewers)
+						}
+
+						_, _, err := closeHandler(prEntity.PullRequest{Status: prEntity.PRMerged}, "backend", nil, nil, nil)
+						assert.ErrorIs(t, err, prErrors.ErrInvalidTransition)
+
+						return tc.repoReport, tc.repoError
+					})
+			}
+
+			service := teamservice.CreateTeamService(mockTeamRepo, reviewerpicker.CreateRandomPicker(), nil, &config.PullRequestConfig{})
+
+			report, err := service.Delete(context.Background(), "backend", tc.mode, tc.targetTeam)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedReport, report)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestMoveMembers(t *testing.T) {
+	type testCase struct {
+		what string
+
+		targetTeam    string
+		memberIds     []string
+		callRepo      bool
+		repoError     error
+		expectedError string
+		noError       bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "empty members list",
+
+			targetTeam:    "platform",
+			memberIds:     []string{},
+			expectedError: teamErrors.ErrNoMembers.Error(),
+		},
+
+		{
+			what: "target team is not set",
+
+			targetTeam:    "",
+			memberIds:     []string{"u1"},
+			expectedError: teamErrors.ErrInvalidMoveTarget.Error(),
+		},
+
+		{
+			what: "target team is source team",
+
+			targetTeam:    "backend",
+			memberIds:     []string{"u1"},
+			expectedError: teamErrors.ErrInvalidMoveTarget.Error(),
+		},
+
+		{
+			what: "member is not in source team",
+
+			targetTeam:    "platform",
+			memberIds:     []string{"u1", "u9"},
+			callRepo:      true,
+			repoError:     teamErrors.ErrMemberNotInTeam,
+			expectedError: teamErrors.ErrMemberNotInTeam.Error(),
+		},
+
+		{
+			what: "failed to move members",
+
+			targetTeam:    "platform",
+			memberIds:     []string{"u1"},
+			callRepo:      true,
+			repoError:     errors.New("db is down"),
+			expectedError: "failed to move members in repo: db is down",
+		},
+
+		{
+			what: "successfully moved",
+
+			targetTeam: "platform",
+			memberIds:  []string{"u1"},
+			callRepo:   true,
+			noError:    true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeamRepo := teamMocks.NewMockTeamRepo(ctrl)
+
+			if tc.callRepo {
+				mockTeamRepo.EXPECT().
+					MoveMembers(gomock.Any(), "backend", tc.targetTeam, tc.memberIds).
+					Return(teamEntity.Team{}, teamEntity.Team{}, tc.repoError)
+			}
 
-			service := teamservice.CreateTeamService(mockTeamRepo)
+			service := teamservice.CreateTeamService(mockTeamRepo, reviewerpicker.CreateRandomPicker(), nil, &config.PullRequestConfig{})
 
-			err := service.DeactivateAll(context.Background(), tc.teamName)
+			_, _, err := service.MoveMembers(context.Background(), "backend", tc.targetTeam, tc.memberIds)
 
 			if tc.noError {
 				assert.NoError(t, err)
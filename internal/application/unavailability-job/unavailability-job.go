@@ -0,0 +1,98 @@
+package unavailabilityjob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	memberInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/interfaces"
+	prInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+	"github.com/rs/zerolog"
+)
+
+// UnavailabilityJob reassigns open reviews of members, whose unavailability range has started
+type UnavailabilityJob struct {
+	memberRepo         memberInterfaces.MemberRepo
+	pullRequestService prInterfaces.PullRequestService
+	cfg                *config.UnavailabilityConfig
+	logger             zerolog.Logger
+}
+
+func CreateUnavailabilityJob(
+	memberRepo memberInterfaces.MemberRepo,
+	pullRequestService prInterfaces.PullRequestService,
+	cfg *config.UnavailabilityConfig,
+	log zerolog.Logger,
+) *UnavailabilityJob {
+	return &UnavailabilityJob{
+		memberRepo:         memberRepo,
+		pullRequestService: pullRequestService,
+		cfg:                cfg,
+		logger:             log.With().Str("job", "unavailability").Logger(),
+	}
+}
+
+// Run processes started ranges every check interval until ctx is done
+func (j *UnavailabilityJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.ProcessStarted(ctx); err != nil {
+			j.logger.Error().Err(err).Msg("failed to process started unavailability")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *UnavailabilityJob) ProcessStarted(ctx context.Context) error {
+	started, err := j.memberRepo.GetStartedUnavailability(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to get started unavailability from repo: %w", err)
+	}
+
+	// failed range is retried on next tick, it must not block ranges after it
+	var errs []error
+
+	for _, unavailability := range started {
+		report, err := j.pullRequestService.ReassignOpenReviews(ctx, unavailability.MemberId)
+
+		if err != nil {
+			err = fmt.Errorf("failed to reassign open reviews of member %s: %w", unavailability.MemberId, err)
+			j.logger.Error().Err(err).Str("unavailabilityId", unavailability.Id).Msg("failed to process unavailability")
+			errs = append(errs, err)
+			continue
+		}
+
+		// range is marked even if some reviews are not reassigned, team has no free members for them
+		if len(report.NotReassigned) > 0 {
+			j.logger.Warn().
+				Str("memberId", unavailability.MemberId).
+				Strs("pullRequests", report.NotReassigned).
+				Msg("no members to reassign reviews of unavailable member")
+		}
+
+		if err := j.memberRepo.MarkReviewsReassigned(ctx, unavailability.Id); err != nil {
+			err = fmt.Errorf("failed to mark reviews reassigned in repo: %w", err)
+			j.logger.Error().Err(err).Str("unavailabilityId", unavailability.Id).Msg("failed to process unavailability")
+			errs = append(errs, err)
+			continue
+		}
+
+		j.logger.Info().
+			Str("memberId", unavailability.MemberId).
+			Str("unavailabilityId", unavailability.Id).
+			Int("reassigned", len(report.Reassigned)).
+			Msg("reassigned open reviews of unavailable member")
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,146 @@
+package unavailabilityjob_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	pullrequestservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/pull-request"
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
+	unavailabilityjob "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/unavailability-job"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	memberMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/mocks"
+	prMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/mocks"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessStarted(t *testing.T) {
+	log := logger.NewTest()
+
+	prConfig := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	jobConfig := config.UnavailabilityConfig{}
+
+	started := []memberEntity.Unavailability{
+		{
+			Id:       "un1",
+			MemberId: "u1",
+			Kind:     memberEntity.UnavailabilityVacation,
+		},
+		{
+			Id:       "un2",
+			MemberId: "u2",
+			Kind:     memberEntity.UnavailabilitySickLeave,
+		},
+	}
+
+	type testCase struct {
+		what string
+
+		started        []memberEntity.Unavailability
+		startedError   error
+		failedMember   string
+		markError      error
+		expectedMarked []string
+		expectedError  string
+		noError        bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "failed to get started unavailability",
+
+			startedError:   errors.New("db is down"),
+			expectedMarked: []string{},
+			expectedError:  "failed to get started unavailability from repo: db is down",
+		},
+
+		{
+			what: "failed to reassign reviews, range is not marked, later ranges are processed",
+
+			started:        started,
+			failedMember:   "u1",
+			expectedMarked: []string{"un2"},
+			expectedError:  "failed to reassign open reviews of member u1: failed to get open pull requests from repo: db is down",
+		},
+
+		{
+			what: "failed to mark ranges, errors are joined",
+
+			started:        started,
+			markError:      errors.New("db is down"),
+			expectedMarked: []string{"un1", "un2"},
+			expectedError: "failed to mark reviews reassigned in repo: db is down\n" +
+				"failed to mark reviews reassigned in repo: db is down",
+		},
+
+		{
+			what: "all started ranges are marked",
+
+			started:        started,
+			expectedMarked: []string{"un1", "un2"},
+			noError:        true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMemberRepo := memberMocks.NewMockMemberRepo(ctrl)
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			mockMemberRepo.EXPECT().GetStartedUnavailability(gomock.Any()).Return(tc.started, tc.startedError)
+
+			mockPullRequestRepo.
+				EXPECT().
+				GetOpenIdsByReviewer(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, reviewerId string) ([]string, error) {
+					if reviewerId == tc.failedMember {
+						return nil, errors.New("db is down")
+					}
+
+					return []string{}, nil
+				}).
+				AnyTimes()
+
+			marked := []string{}
+
+			mockMemberRepo.
+				EXPECT().
+				MarkReviewsReassigned(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, unavailabilityId string) error {
+					marked = append(marked, unavailabilityId)
+					return tc.markError
+				}).
+				AnyTimes()
+
+			pullRequestService := pullrequestservice.CreatePullRequestService(
+				mockPullRequestRepo,
+				reviewerpicker.CreateRandomPicker(),
+				nil,
+				&prConfig,
+			)
+
+			job := unavailabilityjob.CreateUnavailabilityJob(mockMemberRepo, pullRequestService, &jobConfig, log)
+
+			err := job.ProcessStarted(context.Background())
+
+			if tc.noError {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+
+			assert.Equal(t, tc.expectedMarked, marked)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package webhookdispatcher
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/interfaces"
+	"github.com/rs/zerolog"
+)
+
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderEventId   = "X-Webhook-Event-Id"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// WebhookDispatcher delivers events from outbox to subscribed webhooks.
+// Delivery is at least once: receivers should deduplicate events by id
+type WebhookDispatcher struct {
+	repo   interfaces.WebhookRepo
+	sender interfaces.WebhookSender
+	cfg    *config.WebhookConfig
+	logger zerolog.Logger
+}
+
+type eventBody struct {
+	Id        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+func CreateWebhookDispatcher(
+	repo interfaces.WebhookRepo,
+	sender interfaces.WebhookSender,
+	cfg *config.WebhookConfig,
+	log zerolog.Logger,
+) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		repo:   repo,
+		sender: sender,
+		cfg:    cfg,
+		logger: log.With().Str("job", "webhook-dispatcher").Logger(),
+	}
+}
+
+// Run dispatches due deliveries every dispatch interval until ctx is done
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.DispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.DispatchDue(ctx); err != nil {
+			d.logger.Error().Err(err).Msg("failed to dispatch webhook deliveries")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *WebhookDispatcher) DispatchDue(ctx context.Context) error {
+	claimedAt := time.Now()
+
+	deliveries, err := d.repo.ClaimDue(ctx, claimedAt, d.cfg.Lease, d.cfg.BatchSize)
+
+	if err != nil {
+		return fmt.Errorf("failed to claim due deliveries from repo: %w", err)
+	}
+
+	policy := entity.RetryPolicy{
+		MaxAttempts: d.cfg.MaxAttempts,
+		BaseDelay:   d.cfg.BaseDelay,
+		MaxDelay:    d.cfg.MaxDelay,
+	}
+
+	for _, delivery := range deliveries {
+		// attempt must end before lease, otherwise another dispatcher could send it concurrently.
+		// Rest of the batch is claimed again after lease
+		if time.Since(claimedAt)+d.cfg.Timeout > d.cfg.Lease {
+			break
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		request, err := buildRequest(delivery, time.Now())
+
+		if err != nil {
+			return fmt.Errorf("failed to build request of event %s: %w", delivery.Event.Id, err)
+		}
+
+		sendCtx, cancel := context.WithTimeout(ctx, d.cfg.Timeout)
+		sendErr := d.sender.Send(sendCtx, request)
+		cancel()
+
+		if sendErr != nil {
+			delivery = delivery.Failed(sendErr.Error(), policy, time.Now())
+		} else {
+			delivery = delivery.Delivered(time.Now())
+		}
+
+		if delivery.Status == entity.DeliveryDead {
+			d.logger.Warn().
+				Str("eventId", delivery.Event.Id).
+				Str("webhookId", delivery.WebhookId).
+				Int("attempts", delivery.Attempts).
+				Str("lastError", delivery.LastError).
+				Msg("webhook delivery moved to dead letters")
+		}
+
+		if err := d.repo.SaveAttempt(ctx, delivery); err != nil {
+			return fmt.Errorf("failed to save delivery attempt in repo: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func buildRequest(delivery entity.Delivery, now time.Time) (entity.WebhookRequest, error) {
+	body, err := json.Marshal(eventBody{
+		Id:        delivery.Event.Id,
+		Type:      string(delivery.Event.Type),
+		CreatedAt: delivery.Event.CreatedAt,
+		Payload:   delivery.Event.Payload,
+	})
+
+	if err != nil {
+		return entity.WebhookRequest{}, err
+	}
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	return entity.WebhookRequest{
+		Url: delivery.Url,
+		Headers: map[string]string{
+			"Content-Type":  "application/json",
+			HeaderEvent:     string(delivery.Event.Type),
+			HeaderEventId:   delivery.Event.Id,
+			HeaderTimestamp: timestamp,
+			HeaderSignature: "sha256=" + Sign(delivery.Secret, timestamp, body),
+		},
+		Body: body,
+	}, nil
+}
+
+// Sign returns hex HMAC-SHA256 of timestamp and body joined with dot.
+// Timestamp is signed too, so receivers can reject replayed requests
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,206 @@
+package webhookdispatcher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	webhookdispatcher "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/webhook-dispatcher"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	eventEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/event/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+	webhookMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/mocks"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDispatchDue(t *testing.T) {
+	log := logger.NewTest()
+
+	config := config.WebhookConfig{
+		BatchSize:   10,
+		Lease:       time.Minute,
+		Timeout:     5 * time.Second,
+		MaxAttempts: 3,
+		BaseDelay:   10 * time.Second,
+		MaxDelay:    time.Minute,
+	}
+
+	event := eventEntity.Event{
+		Id:        "e1",
+		Type:      eventEntity.EventPRMerged,
+		Payload:   json.RawMessage(`{"pull_request_id":"pr1"}`),
+		CreatedAt: time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	delivery := entity.Delivery{
+		Event:     event,
+		WebhookId: "w1",
+		Url:       "https://example.com/hook",
+		Secret:    "s3cret",
+		Status:    entity.DeliveryPending,
+	}
+
+	lastAttempt := delivery
+	lastAttempt.Attempts = 2
+
+	type testCase struct {
+		what string
+
+		claimed          []entity.Delivery
+		claimError       error
+		sendError        error
+		saveError        error
+		expectedStatus   entity.DeliveryStatus
+		expectedAttempts int
+		expectedLastErr  string
+		expectedDelay    time.Duration
+		expectedError    string
+		noError          bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "failed to claim due deliveries",
+
+			claimError:    errors.New("db is down"),
+			expectedError: "failed to claim due deliveries from repo: db is down",
+		},
+
+		{
+			what: "nothing to deliver",
+
+			claimed: []entity.Delivery{},
+			noError: true,
+		},
+
+		{
+			what: "successfully delivered",
+
+			claimed:          []entity.Delivery{delivery},
+			expectedStatus:   entity.DeliveryDelivered,
+			expectedAttempts: 1,
+			noError:          true,
+		},
+
+		{
+			what: "failed attempt is retried with backoff",
+
+			claimed:          []entity.Delivery{delivery},
+			sendError:        errors.New("unexpected status 503"),
+			expectedStatus:   entity.DeliveryPending,
+			expectedAttempts: 1,
+			expectedLastErr:  "unexpected status 503",
+			expectedDelay:    10 * time.Second,
+			noError:          true,
+		},
+
+		{
+			what: "delivery is dead after max attempts",
+
+			claimed:          []entity.Delivery{lastAttempt},
+			sendError:        errors.New("connection refused"),
+			expectedStatus:   entity.DeliveryDead,
+			expectedAttempts: 3,
+			expectedLastErr:  "connection refused",
+			noError:          true,
+		},
+
+		{
+			what: "failed to save attempt",
+
+			claimed:          []entity.Delivery{delivery},
+			saveError:        errors.New("db is down"),
+			expectedStatus:   entity.DeliveryDelivered,
+			expectedAttempts: 1,
+			expectedError:    "failed to save delivery attempt in repo: db is down",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookRepo := webhookMocks.NewMockWebhookRepo(ctrl)
+			mockWebhookSender := webhookMocks.NewMockWebhookSender(ctrl)
+
+			mockWebhookRepo.EXPECT().ClaimDue(
+				gomock.Any(),
+				gomock.Any(),
+				config.Lease,
+				config.BatchSize,
+			).Return(tc.claimed, tc.claimError)
+
+			var request entity.WebhookRequest
+			var saved entity.Delivery
+
+			if len(tc.claimed) > 0 {
+				mockWebhookSender.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, r entity.WebhookRequest) error {
+						request = r
+						return tc.sendError
+					},
+				)
+
+				mockWebhookRepo.EXPECT().SaveAttempt(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, d entity.Delivery) error {
+						saved = d
+						return tc.saveError
+					},
+				)
+			}
+
+			dispatcher := webhookdispatcher.CreateWebhookDispatcher(mockWebhookRepo, mockWebhookSender, &config, log)
+
+			startedAt := time.Now()
+			err := dispatcher.DispatchDue(context.Background())
+
+			if tc.noError {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+
+			if len(tc.claimed) == 0 {
+				return
+			}
+
+			assert.Equal(t, tc.expectedStatus, saved.Status)
+			assert.Equal(t, tc.expectedAttempts, saved.Attempts)
+			assert.Equal(t, tc.expectedLastErr, saved.LastError)
+
+			if tc.expectedStatus == entity.DeliveryPending {
+				assert.WithinDuration(t, startedAt.Add(tc.expectedDelay), saved.NextAttemptAt, time.Second)
+			}
+
+			timestamp := request.Headers[webhookdispatcher.HeaderTimestamp]
+
+			assert.Equal(t, "https://example.com/hook", request.Url)
+			assert.Equal(t, "pull_request.merged", request.Headers[webhookdispatcher.HeaderEvent])
+			assert.Equal(t, "e1", request.Headers[webhookdispatcher.HeaderEventId])
+			assert.Equal(
+				t,
+				"sha256="+webhookdispatcher.Sign("s3cret", timestamp, request.Body),
+				request.Headers[webhookdispatcher.HeaderSignature],
+			)
+			assert.JSONEq(
+				t,
+				`{"id":"e1","type":"pull_request.merged","created_at":"2025-11-01T12:00:00Z",`+
+					`"payload":{"pull_request_id":"pr1"}}`,
+				string(request.Body),
+			)
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	// printf '1700000000.{}' | openssl dgst -sha256 -hmac s3cret
+	expected := "97926816e98fbb41ccb1673225ff29a2f35369099990e1b1561651e7bd097ebf"
+
+	assert.Equal(t, expected, webhookdispatcher.Sign("s3cret", "1700000000", []byte("{}")))
+}
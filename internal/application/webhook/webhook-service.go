@@ -0,0 +1,138 @@
+package webhookservice
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	eventEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/event/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+	webhookErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/interfaces"
+)
+
+const secretBytes = 32
+
+type WebhookService struct {
+	repo interfaces.WebhookRepo
+	cfg  *config.WebhookConfig
+}
+
+func CreateWebhookService(repo interfaces.WebhookRepo, cfg *config.WebhookConfig) interfaces.WebhookService {
+	return &WebhookService{
+		repo: repo,
+		cfg:  cfg,
+	}
+}
+
+func (s *WebhookService) Register(
+	ctx context.Context,
+	url string,
+	secret string,
+	eventTypes []eventEntity.EventType,
+) (entity.Webhook, error) {
+	if secret == "" {
+		generated, err := generateSecret()
+
+		if err != nil {
+			return entity.Webhook{}, fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+
+		secret = generated
+	}
+
+	webhook := entity.NewWebhook(url, secret, eventTypes)
+
+	if !webhook.IsValid() {
+		return entity.Webhook{}, webhookErrors.ErrInvalidWebhook
+	}
+
+	if err := s.repo.Register(ctx, webhook); err != nil {
+		return entity.Webhook{}, fmt.Errorf("failed to register webhook in repo: %w", err)
+	}
+
+	return webhook, nil
+}
+
+func (s *WebhookService) List(ctx context.Context) ([]entity.Webhook, error) {
+	webhooks, err := s.repo.List(ctx)
+
+	if err != nil {
+		return []entity.Webhook{}, fmt.Errorf("failed to list webhooks in repo: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+func (s *WebhookService) Delete(ctx context.Context, webhookId string) error {
+	err := s.repo.Delete(ctx, webhookId)
+
+	if errors.Is(err, webhookErrors.ErrWebhookNotFound) {
+		return err
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook in repo: %w", err)
+	}
+
+	return nil
+}
+
+// dead letters are returned, when status is empty
+func (s *WebhookService) GetDeliveries(
+	ctx context.Context,
+	webhookId string,
+	status entity.DeliveryStatus,
+) ([]entity.Delivery, error) {
+	if status == "" {
+		status = entity.DeliveryDead
+	}
+
+	if !status.IsValid() {
+		return []entity.Delivery{}, webhookErrors.ErrInvalidStatus
+	}
+
+	deliveries, err := s.repo.GetDeliveries(ctx, webhookId, status, s.cfg.OutLimit)
+
+	if errors.Is(err, webhookErrors.ErrWebhookNotFound) {
+		return []entity.Delivery{}, err
+	}
+
+	if err != nil {
+		return []entity.Delivery{}, fmt.Errorf("failed to get deliveries in repo: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func (s *WebhookService) Replay(ctx context.Context, webhookId string, filter entity.ReplayFilter) (int, error) {
+	// replay of the whole outbox has to be requested explicitly with since
+	if filter.IsEmpty() {
+		return 0, webhookErrors.ErrInvalidReplay
+	}
+
+	queued, err := s.repo.Replay(ctx, webhookId, filter)
+
+	if errors.Is(err, webhookErrors.ErrWebhookNotFound) {
+		return 0, err
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to replay events in repo: %w", err)
+	}
+
+	return queued, nil
+}
+
+func generateSecret() (string, error) {
+	buf := make([]byte, secretBytes)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,306 @@
+package webhookservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	webhookservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/webhook"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	eventEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/event/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+	webhookErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/errors"
+	webhookMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegister(t *testing.T) {
+	config := config.WebhookConfig{
+		OutLimit: 10,
+	}
+
+	type testCase struct {
+		what string
+
+		url             string
+		secret          string
+		eventTypes      []eventEntity.EventType
+		callRepo        bool
+		repoError       error
+		expectedSecret  string
+		expectedTypes   []eventEntity.EventType
+		expectedError   string
+		generatedSecret bool
+		noError         bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "url without scheme",
+
+			url:           "example.com/hook",
+			secret:        "s3cret",
+			expectedError: "invalid webhook",
+		},
+
+		{
+			what: "unsupported scheme",
+
+			url:           "ftp://example.com/hook",
+			secret:        "s3cret",
+			expectedError: "invalid webhook",
+		},
+
+		{
+			what: "unknown event type",
+
+			url:           "https://example.com/hook",
+			secret:        "s3cret",
+			eventTypes:    []eventEntity.EventType{"pull_request.deleted"},
+			expectedError: "invalid webhook",
+		},
+
+		{
+			what: "failed to register webhook in repo",
+
+			url:           "https://example.com/hook",
+			secret:        "s3cret",
+			callRepo:      true,
+			repoError:     errors.New("db is down"),
+			expectedError: "failed to register webhook in repo: db is down",
+		},
+
+		{
+			what: "successfully register webhook with given secret",
+
+			url:            "https://example.com/hook",
+			secret:         "s3cret",
+			eventTypes:     []eventEntity.EventType{eventEntity.EventPRMerged},
+			callRepo:       true,
+			expectedSecret: "s3cret",
+			expectedTypes:  []eventEntity.EventType{eventEntity.EventPRMerged},
+			noError:        true,
+		},
+
+		{
+			what: "successfully register webhook with generated secret for all events",
+
+			url:             "http://localhost:9000/hook",
+			callRepo:        true,
+			expectedTypes:   []eventEntity.EventType{},
+			generatedSecret: true,
+			noError:         true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookRepo := webhookMocks.NewMockWebhookRepo(ctrl)
+
+			if tc.callRepo {
+				mockWebhookRepo.EXPECT().Register(gomock.Any(), gomock.Any()).Return(tc.repoError)
+			}
+
+			webhookService := webhookservice.CreateWebhookService(mockWebhookRepo, &config)
+
+			webhook, err := webhookService.Register(context.Background(), tc.url, tc.secret, tc.eventTypes)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, webhook.Id)
+				assert.Equal(t, tc.url, webhook.Url)
+				assert.Equal(t, tc.expectedTypes, webhook.EventTypes)
+
+				if tc.generatedSecret {
+					assert.Len(t, webhook.Secret, 64)
+				} else {
+					assert.Equal(t, tc.expectedSecret, webhook.Secret)
+				}
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestGetDeliveries(t *testing.T) {
+	config := config.WebhookConfig{
+		OutLimit: 10,
+	}
+
+	deliveries := []entity.Delivery{
+		{
+			Event:     eventEntity.Event{Id: "e1", Type: eventEntity.EventPRCreated},
+			WebhookId: "w1",
+			Status:    entity.DeliveryDead,
+			Attempts:  8,
+			LastError: "unexpected status 500",
+		},
+	}
+
+	type testCase struct {
+		what string
+
+		status             entity.DeliveryStatus
+		callRepo           bool
+		expectedStatus     entity.DeliveryStatus
+		repoError          error
+		expectedDeliveries []entity.Delivery
+		expectedError      string
+		noError            bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid status",
+
+			status:        "FAILED",
+			expectedError: "invalid delivery status",
+		},
+
+		{
+			what: "webhook not found",
+
+			status:         entity.DeliveryPending,
+			callRepo:       true,
+			expectedStatus: entity.DeliveryPending,
+			repoError:      webhookErrors.ErrWebhookNotFound,
+			expectedError:  "webhook not found",
+		},
+
+		{
+			what: "failed to get deliveries in repo",
+
+			status:         entity.DeliveryDelivered,
+			callRepo:       true,
+			expectedStatus: entity.DeliveryDelivered,
+			repoError:      errors.New("db is down"),
+			expectedError:  "failed to get deliveries in repo: db is down",
+		},
+
+		{
+			what: "successfully get dead deliveries by default",
+
+			callRepo:           true,
+			expectedStatus:     entity.DeliveryDead,
+			expectedDeliveries: deliveries,
+			noError:            true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookRepo := webhookMocks.NewMockWebhookRepo(ctrl)
+
+			if tc.callRepo {
+				mockWebhookRepo.EXPECT().GetDeliveries(
+					gomock.Any(),
+					"w1",
+					tc.expectedStatus,
+					10,
+				).Return(tc.expectedDeliveries, tc.repoError)
+			}
+
+			webhookService := webhookservice.CreateWebhookService(mockWebhookRepo, &config)
+
+			res, err := webhookService.GetDeliveries(context.Background(), "w1", tc.status)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDeliveries, res)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestReplay(t *testing.T) {
+	config := config.WebhookConfig{
+		OutLimit: 10,
+	}
+
+	since := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		what string
+
+		filter         entity.ReplayFilter
+		callRepo       bool
+		repoQueued     int
+		repoError      error
+		expectedQueued int
+		expectedError  string
+		noError        bool
+	}
+
+	testCases := []testCase{
+		{
+			what: "empty filter",
+
+			filter:        entity.ReplayFilter{EventIds: []string{}},
+			expectedError: "replay filter is empty",
+		},
+
+		{
+			what: "webhook not found",
+
+			filter:        entity.ReplayFilter{DeadOnly: true},
+			callRepo:      true,
+			repoError:     webhookErrors.ErrWebhookNotFound,
+			expectedError: "webhook not found",
+		},
+
+		{
+			what: "failed to replay events in repo",
+
+			filter:        entity.ReplayFilter{EventIds: []string{"e1"}},
+			callRepo:      true,
+			repoError:     errors.New("db is down"),
+			expectedError: "failed to replay events in repo: db is down",
+		},
+
+		{
+			what: "successfully replay events since time",
+
+			filter:         entity.ReplayFilter{Since: &since},
+			callRepo:       true,
+			repoQueued:     3,
+			expectedQueued: 3,
+			noError:        true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookRepo := webhookMocks.NewMockWebhookRepo(ctrl)
+
+			if tc.callRepo {
+				mockWebhookRepo.EXPECT().Replay(gomock.Any(), "w1", tc.filter).Return(tc.repoQueued, tc.repoError)
+			}
+
+			webhookService := webhookservice.CreateWebhookService(mockWebhookRepo, &config)
+
+			queued, err := webhookService.Replay(context.Background(), "w1", tc.filter)
+
+			if tc.noError {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedQueued, queued)
+			} else {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -10,9 +12,14 @@ import (
 type Config struct {
 	Env string `yaml:"env" env-required:"true"`
 
-	RestConfig        `yaml:"rest" env-required:"true"`
-	PostgresConfig    `yaml:"postgres" env-required:"true"`
-	PullRequestConfig `yaml:"pull_request" env-required:"true"`
+	RestConfig           `yaml:"rest" env-required:"true"`
+	PostgresConfig       `yaml:"postgres" env-required:"true"`
+	PullRequestConfig    `yaml:"pull_request" env-required:"true"`
+	UnavailabilityConfig `yaml:"unavailability"`
+	StatsConfig          `yaml:"stats"`
+	WebhookConfig        `yaml:"webhooks"`
+	ReviewStreamConfig   `yaml:"review_stream"`
+	IntegrationsConfig   `yaml:"integrations"`
 }
 
 type RestConfig struct {
@@ -30,9 +37,126 @@ type PostgresConfig struct {
 	Database string `yaml:"database" env-required:"true"`
 }
 
+const (
+	// assign only members with capacity, even if there are less than target count
+	CapacityFallbackUnderAssign = "under_assign"
+	// fill missing reviewers with members at capacity
+	CapacityFallbackIgnoreCap = "ignore_cap"
+	// same as under_assign, but refuse to create pr, when no member has capacity
+	CapacityFallbackFail = "fail"
+)
+
 type PullRequestConfig struct {
-	OutLimit             int `yaml:"out_limit" env-required:"true"`
-	TargetReviewersCount int `yaml:"target_reviewers_count" env-required:"true"`
+	OutLimit             int                  `yaml:"out_limit" env-required:"true"`
+	TargetReviewersCount int                  `yaml:"target_reviewers_count" env-required:"true"`
+	ReviewerPicker       ReviewerPickerConfig `yaml:"reviewer_picker"`
+	// what to do on create, when members with capacity are not enough for target count
+	CapacityFallback string `yaml:"capacity_fallback" env-default:"under_assign"`
+	// reassign open reviews of deactivated members, can be overridden per request
+	ReassignOnDeactivate bool `yaml:"reassign_on_deactivate" env-default:"false"`
+	// latency budget of team deactivation, changes are rolled back when it is exceeded
+	DeactivateTimeout time.Duration     `yaml:"deactivate_timeout" env-default:"5s"`
+	MergePolicy       MergePolicyConfig `yaml:"merge_policy"`
+	// team name -> CODEOWNERS-style rules, rules uploaded through api take precedence
+	Codeowners map[string]string `yaml:"codeowners"`
+}
+
+type MergePolicyConfig struct {
+	// approvals of current reviewers needed to merge, 0 disables the rule
+	MinApprovals int `yaml:"min_approvals" env-default:"0"`
+	// forbid merge while any current reviewer requests changes
+	NoChangesRequested bool `yaml:"no_changes_requested" env-default:"false"`
+	// at least one current reviewer must be a member of the team, empty disables the rule
+	RequiredReviewerTeam string `yaml:"required_reviewer_team"`
+	// merge must be requested by somebody other than author
+	AuthorCannotMerge bool `yaml:"author_cannot_merge" env-default:"false"`
+	// team name -> conditions, which differ from global ones
+	Teams map[string]MergePolicyOverride `yaml:"teams"`
+}
+
+// omitted fields are taken from global policy
+type MergePolicyOverride struct {
+	MinApprovals         *int    `yaml:"min_approvals"`
+	NoChangesRequested   *bool   `yaml:"no_changes_requested"`
+	RequiredReviewerTeam *string `yaml:"required_reviewer_team"`
+	AuthorCannotMerge    *bool   `yaml:"author_cannot_merge"`
+}
+
+type ReviewerPickerConfig struct {
+	// one of: random, least_loaded, round_robin, weighted_random.
+	// round_robin queues are kept in memory of process, they start over on restart and differ between replicas
+	Strategy string `yaml:"strategy" env-default:"random"`
+	// team name -> strategy, overrides default strategy for the team
+	TeamStrategies map[string]string `yaml:"team_strategies"`
+	// member id -> weight for weighted_random strategy, members without weight get 1
+	Weights map[string]int `yaml:"weights"`
+}
+
+type UnavailabilityConfig struct {
+	// how often background job looks for started unavailability ranges to reassign open reviews
+	CheckInterval time.Duration `yaml:"check_interval" env-default:"1m"`
+}
+
+type StatsConfig struct {
+	// max page size of statistics
+	OutLimit int `yaml:"out_limit" env-default:"100"`
+}
+
+type WebhookConfig struct {
+	// how often dispatcher looks for due deliveries
+	DispatchInterval time.Duration `yaml:"dispatch_interval" env-default:"5s"`
+	// max deliveries claimed by one dispatch
+	BatchSize int `yaml:"batch_size" env-default:"100"`
+	// claimed deliveries are hidden from other dispatchers for lease
+	Lease time.Duration `yaml:"lease" env-default:"1m"`
+	// timeout of one delivery request
+	Timeout time.Duration `yaml:"timeout" env-default:"5s"`
+	// delivery becomes dead after max attempts
+	MaxAttempts int           `yaml:"max_attempts" env-default:"8"`
+	BaseDelay   time.Duration `yaml:"base_delay" env-default:"10s"`
+	MaxDelay    time.Duration `yaml:"max_delay" env-default:"1h"`
+	// max number of deliveries in response of admin api
+	OutLimit int `yaml:"out_limit" env-default:"100"`
+}
+
+type ReviewStreamConfig struct {
+	// how often comment is sent to idle stream, so proxies do not close it
+	KeepAliveInterval time.Duration `yaml:"keep_alive_interval" env-default:"15s"`
+	// max events read from db at once, longer replays are read in several batches
+	BatchSize int `yaml:"batch_size" env-default:"100"`
+}
+
+type IntegrationsConfig struct {
+	Github GitHostingConfig `yaml:"github" env-prefix:"GITHUB_"`
+	Gitlab GitHostingConfig `yaml:"gitlab" env-prefix:"GITLAB_"`
+	// pushing of assigned reviewers to code hosts
+	Publish ReviewerPublishConfig `yaml:"publish"`
+}
+
+type GitHostingConfig struct {
+	// secret of hosting webhook (secret token for gitlab), ingestion endpoint is disabled when it is empty
+	Secret string `yaml:"secret" env:"WEBHOOK_SECRET"`
+	// login in hosting -> member id
+	Users map[string]string `yaml:"users"`
+	// REST api of hosting, e.g. https://api.github.com or https://gitlab.example.com/api/v4
+	ApiUrl string `yaml:"api_url"`
+	// reviewers are not pushed to hosting, when token is empty
+	ApiToken string `yaml:"api_token" env:"API_TOKEN"`
+}
+
+type ReviewerPublishConfig struct {
+	// how often publisher looks for due publications
+	DispatchInterval time.Duration `yaml:"dispatch_interval" env-default:"5s"`
+	// max publications claimed by one dispatch
+	BatchSize int `yaml:"batch_size" env-default:"50"`
+	// claimed publications are hidden from other publishers for lease
+	Lease time.Duration `yaml:"lease" env-default:"1m"`
+	// timeout of one publication, it may take several api calls
+	Timeout time.Duration `yaml:"timeout" env-default:"10s"`
+	// publication becomes dead after max attempts
+	MaxAttempts int           `yaml:"max_attempts" env-default:"10"`
+	BaseDelay   time.Duration `yaml:"base_delay" env-default:"10s"`
+	MaxDelay    time.Duration `yaml:"max_delay" env-default:"1h"`
 }
 
 func MustLoadConfig() *Config {
@@ -52,5 +176,95 @@ func MustLoadConfig() *Config {
 		log.Fatalf("failed to load config: %s", err.Error())
 	}
 
+	if err := cfg.validate(); err != nil {
+		log.Fatalf("invalid config: %s", err.Error())
+	}
+
 	return &cfg
 }
+
+func (cfg *Config) validate() error {
+	switch cfg.PullRequestConfig.CapacityFallback {
+	case CapacityFallbackUnderAssign, CapacityFallbackIgnoreCap, CapacityFallbackFail:
+	default:
+		return fmt.Errorf("unknown capacity fallback: %s", cfg.PullRequestConfig.CapacityFallback)
+	}
+
+	mergePolicy := cfg.PullRequestConfig.MergePolicy
+
+	if mergePolicy.MinApprovals < 0 {
+		return fmt.Errorf("min approvals must not be negative, got %d", mergePolicy.MinApprovals)
+	}
+
+	for teamName, override := range mergePolicy.Teams {
+		if override.MinApprovals != nil && *override.MinApprovals < 0 {
+			return fmt.Errorf("min approvals for team %s must not be negative, got %d", teamName, *override.MinApprovals)
+		}
+	}
+
+	if cfg.PullRequestConfig.OutLimit <= 0 {
+		return fmt.Errorf("pull request out limit must be positive, got %d", cfg.PullRequestConfig.OutLimit)
+	}
+
+	if cfg.StatsConfig.OutLimit <= 0 {
+		return fmt.Errorf("stats out limit must be positive, got %d", cfg.StatsConfig.OutLimit)
+	}
+
+	if err := cfg.WebhookConfig.validate(); err != nil {
+		return err
+	}
+
+	if err := cfg.IntegrationsConfig.Publish.validate(); err != nil {
+		return err
+	}
+
+	if cfg.ReviewStreamConfig.KeepAliveInterval <= 0 || cfg.ReviewStreamConfig.BatchSize <= 0 {
+		return fmt.Errorf("review stream keep alive interval and batch size must be positive")
+	}
+
+	if cfg.UnavailabilityConfig.CheckInterval <= 0 {
+		return fmt.Errorf("unavailability check interval must be positive, got %s", cfg.UnavailabilityConfig.CheckInterval)
+	}
+
+	return nil
+}
+
+func (cfg *WebhookConfig) validate() error {
+	if cfg.DispatchInterval <= 0 || cfg.Lease <= 0 || cfg.Timeout <= 0 {
+		return fmt.Errorf("webhook dispatch interval, lease and timeout must be positive")
+	}
+
+	if cfg.Lease <= cfg.Timeout {
+		return fmt.Errorf("webhook lease %s must exceed delivery timeout %s", cfg.Lease, cfg.Timeout)
+	}
+
+	if cfg.BatchSize <= 0 || cfg.MaxAttempts <= 0 || cfg.OutLimit <= 0 {
+		return fmt.Errorf("webhook batch size, max attempts and out limit must be positive")
+	}
+
+	if cfg.BaseDelay <= 0 || cfg.MaxDelay < cfg.BaseDelay {
+		return fmt.Errorf("webhook retry delays must be positive and max delay must not be less than base one")
+	}
+
+	return nil
+}
+
+func (cfg *ReviewerPublishConfig) validate() error {
+	if cfg.DispatchInterval <= 0 || cfg.Lease <= 0 || cfg.Timeout <= 0 {
+		return fmt.Errorf("reviewer publish interval, lease and timeout must be positive")
+	}
+
+	if cfg.Lease <= cfg.Timeout {
+		return fmt.Errorf("reviewer publish lease %s must exceed timeout %s", cfg.Lease, cfg.Timeout)
+	}
+
+	if cfg.BatchSize <= 0 || cfg.MaxAttempts <= 0 {
+		return fmt.Errorf("reviewer publish batch size and max attempts must be positive")
+	}
+
+	if cfg.BaseDelay <= 0 || cfg.MaxDelay < cfg.BaseDelay {
+		return fmt.Errorf("reviewer publish retry delays must be positive and max delay must not be less than base one")
+	}
+
+	return nil
+}
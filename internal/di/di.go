@@ -1,22 +1,47 @@
 package di
 
 import (
+	"context"
+	"sync"
+
+	ingestservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/integration"
 	memberservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/member"
+	poolservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/pool"
 	pullrequestservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/pull-request"
+	reviewstreamservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/review-stream"
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
+	reviewerpublishjob "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-publish-job"
+	scimservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/scim"
 	statsservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/statistics"
 	teamservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/team"
+	unavailabilityjob "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/unavailability-job"
+	webhookservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/webhook"
+	webhookdispatcher "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/webhook-dispatcher"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	codeownersEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/entity"
+	integrationEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
+	reviewerPublishInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/interfaces"
+	pgbroker "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/brokers/postgres"
+	codehostclient "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/clients/code-host"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/clients/postgres"
+	webhookclient "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/clients/webhook"
+	integrationrepopg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/integration"
 	memberrepopg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/member"
+	poolrepopg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/pool"
 	pullrequestrepopg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/pull-request"
+	reviewstreampg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/review-stream"
+	reviewerpublishpg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/reviewer-publish"
+	scimrepopg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/scim"
 	statsrepopg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/statistics"
 	teamrepopg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/team"
+	webhookrepopg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/webhook"
 	rest "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin"
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog"
 )
 
-func MustConfigureApp(r *gin.Engine, cfg *config.Config, log zerolog.Logger) func() {
+// MustConfigureApp returns func, which closes open event streams, and func, which releases resources
+func MustConfigureApp(r *gin.Engine, cfg *config.Config, log zerolog.Logger) (func(), func()) {
 	conn, err := postgres.CreateConnection(&cfg.PostgresConfig)
 
 	if err != nil {
@@ -27,17 +52,162 @@ func MustConfigureApp(r *gin.Engine, cfg *config.Config, log zerolog.Logger) fun
 	teamRepo := teamrepopg.CreateTeamRepoPg(conn, log)
 	pullRequestRepo := pullrequestrepopg.CreatePullRequestRepoPg(conn, log)
 	statsRepo := statsrepopg.CreateStatsRepoPg(conn, log)
+	webhookRepo := webhookrepopg.CreateWebhookRepoPg(conn, log)
+	reviewStreamRepo := reviewstreampg.CreateReviewStreamRepoPg(conn, log)
+	deliveryRepo := integrationrepopg.CreateDeliveryRepoPg(conn, log)
+	hostLinkRepo := integrationrepopg.CreateHostLinkRepoPg(conn, log)
+	publicationRepo := reviewerpublishpg.CreatePublicationRepoPg(conn, log)
+	poolRepo := poolrepopg.CreatePoolRepoPg(conn, log)
+	scimRepo := scimrepopg.CreateScimRepoPg(conn, log)
+
+	reviewStreamBroker, err := pgbroker.CreateReviewStreamBrokerPg(&cfg.PostgresConfig, log)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to listen review stream notifications")
+	}
+
+	reviewerPicker, err := reviewerpicker.CreateReviewerPicker(&cfg.PullRequestConfig.ReviewerPicker)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to configure reviewer picker")
+	}
+
+	codeowners, err := codeownersEntity.ParseRulesets(cfg.PullRequestConfig.Codeowners)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to parse codeowners from config")
+	}
+
+	memberService := memberservice.CreateMemberService(memberRepo, reviewerPicker, &cfg.PullRequestConfig)
+	teamService := teamservice.CreateTeamService(teamRepo, reviewerPicker, codeowners, &cfg.PullRequestConfig)
+	pullrequestservice := pullrequestservice.CreatePullRequestService(
+		pullRequestRepo,
+		reviewerPicker,
+		codeowners,
+		&cfg.PullRequestConfig,
+	)
+	statsService := statsservice.CreateStatsService(statsRepo, &cfg.StatsConfig)
+	webhookService := webhookservice.CreateWebhookService(webhookRepo, &cfg.WebhookConfig)
+	poolService := poolservice.CreatePoolService(poolRepo)
+	scimService := scimservice.CreateScimService(scimRepo, memberService, teamService)
+	reviewStreamService := reviewstreamservice.CreateReviewStreamService(
+		reviewStreamRepo,
+		reviewStreamBroker,
+		&cfg.ReviewStreamConfig,
+	)
+	githubIngestService := ingestservice.CreateIngestService(
+		pullrequestservice,
+		deliveryRepo,
+		hostLinkRepo,
+		integrationEntity.SourceGithub,
+		cfg.IntegrationsConfig.Github.Users,
+	)
+	gitlabIngestService := ingestservice.CreateIngestService(
+		pullrequestservice,
+		deliveryRepo,
+		hostLinkRepo,
+		integrationEntity.SourceGitlab,
+		cfg.IntegrationsConfig.Gitlab.Users,
+	)
+
+	rest.InitRoutes(
+		r,
+		&cfg.RestConfig,
+		log,
+		memberService,
+		teamService,
+		pullrequestservice,
+		statsService,
+		webhookService,
+		reviewStreamService,
+		githubIngestService,
+		gitlabIngestService,
+		&cfg.IntegrationsConfig,
+		poolService,
+		scimService,
+	)
+
+	jobCtx, stopJobs := context.WithCancel(context.Background())
 
-	memberService := memberservice.CreateMemberService(memberRepo)
-	teamService := teamservice.CreateTeamService(teamRepo)
-	pullrequestservice := pullrequestservice.CreatePullRequestService(pullRequestRepo, &cfg.PullRequestConfig)
-	statsService := statsservice.CreateStatsService(statsRepo)
+	unavailabilityJob := unavailabilityjob.CreateUnavailabilityJob(
+		memberRepo,
+		pullrequestservice,
+		&cfg.UnavailabilityConfig,
+		log,
+	)
 
-	rest.InitRoutes(r, &cfg.RestConfig, log, memberService, teamService, pullrequestservice, statsService)
+	webhookDispatcher := webhookdispatcher.CreateWebhookDispatcher(
+		webhookRepo,
+		webhookclient.CreateWebhookClient(cfg.WebhookConfig.Timeout),
+		&cfg.WebhookConfig,
+		log,
+	)
+
+	reviewerPublishJob := reviewerpublishjob.CreateReviewerPublishJob(
+		publicationRepo,
+		reviewerPublishers(&cfg.IntegrationsConfig),
+		codehostclient.CreateNoopPublisher(),
+		&cfg.IntegrationsConfig,
+		log,
+	)
+
+	var jobs sync.WaitGroup
+
+	jobs.Add(4)
+
+	go func() {
+		defer jobs.Done()
+		unavailabilityJob.Run(jobCtx)
+	}()
+
+	go func() {
+		defer jobs.Done()
+		webhookDispatcher.Run(jobCtx)
+	}()
+
+	go func() {
+		defer jobs.Done()
+		reviewerPublishJob.Run(jobCtx)
+	}()
+
+	// broker is stopped separately on server shutdown, which closes subscribed streams
+	brokerCtx, stopBroker := context.WithCancel(context.Background())
+
+	go func() {
+		defer jobs.Done()
+		reviewStreamBroker.Run(brokerCtx)
+	}()
+
+	return stopBroker, func() {
+		stopJobs()
+		stopBroker()
+		jobs.Wait()
 
-	return func() {
 		if err := conn.Close(); err != nil {
 			log.Error().Err(err).Msg("failed to close postgres connection")
 		}
 	}
 }
+
+// reviewers are pushed only to hostings with api token
+func reviewerPublishers(cfg *config.IntegrationsConfig) map[string]reviewerPublishInterfaces.ReviewerPublisher {
+	publishers := make(map[string]reviewerPublishInterfaces.ReviewerPublisher)
+
+	if cfg.Github.ApiToken != "" {
+		publishers[integrationEntity.SourceGithub] = codehostclient.CreateGithubPublisher(
+			cfg.Github.ApiUrl,
+			cfg.Github.ApiToken,
+			cfg.Publish.Timeout,
+		)
+	}
+
+	if cfg.Gitlab.ApiToken != "" {
+		publishers[integrationEntity.SourceGitlab] = codehostclient.CreateGitlabPublisher(
+			cfg.Gitlab.ApiUrl,
+			cfg.Gitlab.ApiToken,
+			cfg.Publish.Timeout,
+		)
+	}
+
+	return publishers
+}
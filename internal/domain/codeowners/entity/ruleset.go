@@ -0,0 +1,210 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	codeownersErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/errors"
+)
+
+// line of CODEOWNERS: paths matching pattern are owned by members with given ids
+type Rule struct {
+	Pattern string
+	// empty owners make matching paths unowned
+	Owners []string
+	re     *regexp.Regexp
+}
+
+// CODEOWNERS-style rules of team, the last rule matching a path defines its owners
+type Ruleset struct {
+	Rules []Rule
+}
+
+// Parse reads CODEOWNERS text: every line is pattern followed by owner ids separated by spaces,
+// @ before owner is optional. Blank lines and lines starting with # are skipped
+func Parse(text string) (Ruleset, error) {
+	rules := []Rule{}
+
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+
+		rule, err := NewRule(fields[0], fields[1:])
+
+		if err != nil {
+			return Ruleset{}, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return Ruleset{Rules: rules}, nil
+}
+
+func NewRule(pattern string, owners []string) (Rule, error) {
+	if strings.HasPrefix(pattern, "!") {
+		return Rule{}, fmt.Errorf("%w: negated pattern %s is not supported", codeownersErrors.ErrInvalidRuleset, pattern)
+	}
+
+	if strings.ContainsAny(pattern, "[]") {
+		return Rule{}, fmt.Errorf("%w: character ranges in pattern %s are not supported", codeownersErrors.ErrInvalidRuleset, pattern)
+	}
+
+	ids := make([]string, 0, len(owners))
+
+	for _, owner := range owners {
+		id := strings.TrimPrefix(owner, "@")
+
+		if id == "" {
+			return Rule{}, fmt.Errorf("%w: empty owner of pattern %s", codeownersErrors.ErrInvalidRuleset, pattern)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return Rule{
+		Pattern: pattern,
+		Owners:  ids,
+		re:      compilePattern(pattern),
+	}, nil
+}
+
+// gitignore semantics: pattern with slash not at the end is anchored at root, other patterns
+// match at any depth. Pattern matching a directory matches everything inside it
+func compilePattern(pattern string) *regexp.Regexp {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	var expr strings.Builder
+
+	expr.WriteString("^")
+
+	if !anchored {
+		expr.WriteString("(?:.*/)?")
+	}
+
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			expr.WriteString("(?:.*/)?")
+			i += 2
+
+		case strings.HasPrefix(pattern[i:], "**"):
+			expr.WriteString(".*")
+			i++
+
+		case pattern[i] == '*':
+			expr.WriteString("[^/]*")
+
+		case pattern[i] == '?':
+			expr.WriteString("[^/]")
+
+		default:
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+
+	switch {
+	case dirOnly:
+		expr.WriteString("/.*$")
+
+	// as in CODEOWNERS, docs/* owns files of docs, but not of its subdirectories
+	case strings.HasSuffix(pattern, "*") && !strings.HasSuffix(pattern, "**"):
+		expr.WriteString("$")
+
+	default:
+		expr.WriteString("(?:/.*)?$")
+	}
+
+	return regexp.MustCompile(expr.String())
+}
+
+func (r Rule) Matches(path string) bool {
+	if r.re == nil {
+		r.re = compilePattern(r.Pattern)
+	}
+
+	return r.re.MatchString(normalizePath(path))
+}
+
+func normalizePath(path string) string {
+	path = strings.TrimPrefix(path, "./")
+
+	return strings.TrimPrefix(path, "/")
+}
+
+func (r Ruleset) IsEmpty() bool {
+	return len(r.Rules) == 0
+}
+
+// Owners returns owners of paths in order of first appearance
+func (r Ruleset) Owners(paths []string) []string {
+	owners := []string{}
+
+	for _, path := range paths {
+		for i := len(r.Rules) - 1; i >= 0; i-- {
+			if !r.Rules[i].Matches(path) {
+				continue
+			}
+
+			for _, owner := range r.Rules[i].Owners {
+				if !slices.Contains(owners, owner) {
+					owners = append(owners, owner)
+				}
+			}
+
+			break
+		}
+	}
+
+	return owners
+}
+
+// String returns ruleset in CODEOWNERS format, which is parsed back to the same ruleset
+func (r Ruleset) String() string {
+	lines := make([]string, 0, len(r.Rules))
+
+	for _, rule := range r.Rules {
+		lines = append(lines, strings.Join(append([]string{rule.Pattern}, rule.Owners...), " "))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// ParseRulesets parses rulesets of teams by team name
+func ParseRulesets(texts map[string]string) (map[string]Ruleset, error) {
+	rulesets := make(map[string]Ruleset, len(texts))
+
+	for teamName, text := range texts {
+		ruleset, err := Parse(text)
+
+		if err != nil {
+			return nil, fmt.Errorf("codeowners of team %s: %w", teamName, err)
+		}
+
+		rulesets[teamName] = ruleset
+	}
+
+	return rulesets, nil
+}
+
+// where effective ruleset of team comes from
+type Source string
+
+const (
+	// uploaded through api, takes precedence over config
+	SourceApi    Source = "api"
+	SourceConfig Source = "config"
+	// team has no rules, reviewers are picked from the whole team
+	SourceNone Source = "none"
+)
@@ -0,0 +1,5 @@
+package errors
+
+import "errors"
+
+var ErrInvalidRuleset = errors.New("invalid codeowners ruleset")
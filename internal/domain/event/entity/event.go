@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	EventPRCreated       EventType = "pull_request.created"
+	EventPRMerged        EventType = "pull_request.merged"
+	EventPRStatusChanged EventType = "pull_request.status_changed"
+	EventPRReassigned    EventType = "pull_request.reassigned"
+	// reviewer is removed without replacement, e.g. with team membership
+	EventPRReviewerRemoved EventType = "pull_request.reviewer_removed"
+	EventMemberDeactivated EventType = "member.deactivated"
+)
+
+var eventTypes = []EventType{
+	EventPRCreated,
+	EventPRMerged,
+	EventPRStatusChanged,
+	EventPRReassigned,
+	EventPRReviewerRemoved,
+	EventMemberDeactivated,
+}
+
+func (t EventType) IsValid() bool {
+	return slices.Contains(eventTypes, t)
+}
+
+// fact, which has already happened, it is stored in the transaction of the change itself
+type Event struct {
+	Id   string
+	Type EventType
+	// json payload, its schema depends on type
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
+
+func NewEvent(eventType EventType, payload any) Event {
+	// payloads are plain structs, so marshaling does not fail
+	raw, _ := json.Marshal(payload)
+
+	return Event{
+		Id:        uuid.NewString(),
+		Type:      eventType,
+		Payload:   raw,
+		CreatedAt: time.Now(),
+	}
+}
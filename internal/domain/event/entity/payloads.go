@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"time"
+
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+)
+
+type PullRequestPayload struct {
+	Id                string     `json:"pull_request_id"`
+	Name              string     `json:"pull_request_name"`
+	AuthorId          string     `json:"author_id"`
+	Status            string     `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	MergedAt          *time.Time `json:"merged_at,omitempty"`
+}
+
+type ReassignmentPayload struct {
+	PullRequestId string `json:"pull_request_id"`
+	OldReviewerId string `json:"old_reviewer_id"`
+	NewReviewerId string `json:"new_reviewer_id"`
+}
+
+type ReviewerPayload struct {
+	PullRequestId string `json:"pull_request_id"`
+	ReviewerId    string `json:"reviewer_id"`
+}
+
+type MemberPayload struct {
+	UserId string `json:"user_id"`
+}
+
+func toPullRequestPayload(pr prEntity.PullRequest) PullRequestPayload {
+	reviewers := pr.Reviewers
+	if reviewers == nil {
+		reviewers = []string{}
+	}
+
+	payload := PullRequestPayload{
+		Id:                pr.Id,
+		Name:              pr.Name,
+		AuthorId:          pr.AuthorId,
+		Status:            string(pr.Status),
+		AssignedReviewers: reviewers,
+	}
+
+	if pr.Status == prEntity.PRMerged {
+		mergedAt := pr.MergedAt
+		payload.MergedAt = &mergedAt
+	}
+
+	return payload
+}
+
+func NewPRCreatedEvent(pr prEntity.PullRequest) Event {
+	return NewEvent(EventPRCreated, toPullRequestPayload(pr))
+}
+
+// merge gets its own event type, other transitions are reported as status change
+func NewPRStatusEvent(pr prEntity.PullRequest) Event {
+	if pr.Status == prEntity.PRMerged {
+		return NewEvent(EventPRMerged, toPullRequestPayload(pr))
+	}
+
+	return NewEvent(EventPRStatusChanged, toPullRequestPayload(pr))
+}
+
+func NewPRReassignedEvent(reassignment prEntity.Reassignment) Event {
+	return NewEvent(EventPRReassigned, ReassignmentPayload{
+		PullRequestId: reassignment.PullRequestId,
+		OldReviewerId: reassignment.OldReviewerId,
+		NewReviewerId: reassignment.NewReviewerId,
+	})
+}
+
+func NewPRReviewerRemovedEvent(prId string, reviewerId string) Event {
+	return NewEvent(EventPRReviewerRemoved, ReviewerPayload{
+		PullRequestId: prId,
+		ReviewerId:    reviewerId,
+	})
+}
+
+func NewMemberDeactivatedEvent(userId string) Event {
+	return NewEvent(EventMemberDeactivated, MemberPayload{
+		UserId: userId,
+	})
+}
@@ -0,0 +1,11 @@
+package entity
+
+// pr of code host, from which pull request was ingested
+type HostLink struct {
+	PullRequestId string
+	Source        string
+	// owner/name for github, project id for gitlab
+	Repository string
+	// pr number for github, mr iid for gitlab
+	Number int64
+}
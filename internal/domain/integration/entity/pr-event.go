@@ -0,0 +1,46 @@
+package entity
+
+import prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+
+// hostings, which events are ingested
+const (
+	SourceGithub = "github"
+	SourceGitlab = "gitlab"
+)
+
+type PRAction string
+
+const (
+	PROpened   PRAction = "opened"
+	PRReady    PRAction = "ready"
+	PRReopened PRAction = "reopened"
+	PRClosed   PRAction = "closed"
+	PRMerged   PRAction = "merged"
+)
+
+// change of pull request in external git hosting, users are identified by logins of the hosting
+type PREvent struct {
+	Action        PRAction
+	PullRequestId string
+	// owner/name for github, project id for gitlab
+	Repository string
+	// pr number for github, mr iid for gitlab
+	Number      int64
+	Name        string
+	AuthorLogin string
+	Draft       bool
+	// login of user, who merged pr, empty when hosting does not report it
+	MergedByLogin string
+	// id of delivery, which is the same for redeliveries of event, empty when hosting does not send it
+	DeliveryId string
+}
+
+// outcome of event, ignored events do not change anything
+type IngestResult struct {
+	Action      PRAction
+	PullRequest prEntity.PullRequest
+	Ignored     bool
+	Reason      string
+	// merge policy conditions, which merge done on hosting does not meet, it is recorded anyway
+	UnmetConditions []prEntity.UnmetCondition
+}
@@ -0,0 +1,8 @@
+package errors
+
+import "errors"
+
+var (
+	ErrUnknownUser      = errors.New("login is not mapped to member")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+)
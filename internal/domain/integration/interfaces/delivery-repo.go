@@ -0,0 +1,11 @@
+package interfaces
+
+import "context"
+
+// deliveries are stored per hosting, so redelivered event is applied once
+type DeliveryRepo interface {
+	// marks delivery as ingested, false is returned when it is already marked
+	Claim(ctx context.Context, source, deliveryId string) (bool, error)
+	// unmarks delivery, which failed to be ingested, so it is applied on redelivery
+	Release(ctx context.Context, source, deliveryId string) error
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
+)
+
+type HostLinkRepo interface {
+	// link is stored after pull request is created, current reviewers of it are enqueued
+	// for publication in the same transaction. Save of already linked pr is a no-op
+	Save(ctx context.Context, link entity.HostLink) error
+}
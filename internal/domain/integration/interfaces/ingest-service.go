@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
+)
+
+type IngestService interface {
+	// applies event of external hosting to pull request with the same id
+	Ingest(ctx context.Context, event entity.PREvent) (entity.IngestResult, error)
+}
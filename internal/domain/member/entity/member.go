@@ -1,5 +1,7 @@
 package entity
 
+import "slices"
+
 type MemberActivity string
 
 const (
@@ -11,8 +13,32 @@ type Member struct {
 	Id       string
 	Username string
 	Activity MemberActivity
+	// primary team of member, pull requests created without team belong to it
 	TeamId   *string
 	TeamName string
+	// names of all teams of member, including primary one
+	Teams []string
+	// number of OPEN pull requests where member is a reviewer
+	OpenReviews int
+	// nil means no limit
+	MaxOpenReviews *int
+	// member is inside an active unavailability range
+	Unavailable bool
+	// normalized skill tags, matched against labels of pull requests
+	Skills []string
+}
+
+// member can be assigned as reviewer
+func (m Member) IsAvailable() bool {
+	return m.Activity == MemberActive && !m.Unavailable
+}
+
+func (m Member) InTeam(teamName string) bool {
+	return m.TeamName == teamName || slices.Contains(m.Teams, teamName)
+}
+
+func (m Member) HasReviewCapacity() bool {
+	return m.MaxOpenReviews == nil || m.OpenReviews < *m.MaxOpenReviews
 }
 
 func NewMember(id, username string, activity MemberActivity) Member {
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"slices"
+	"strings"
+)
+
+// max length of skill tag and pull request label
+const MaxTagLength = 64
+
+// tags are compared case-insensitively, so they are kept trimmed, lowercased,
+// sorted and without duplicates. false if some tag is empty or too long
+func NormalizeTags(tags []string) ([]string, bool) {
+	res := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+
+		if tag == "" || len(tag) > MaxTagLength {
+			return nil, false
+		}
+
+		res = append(res, tag)
+	}
+
+	slices.Sort(res)
+
+	return slices.Compact(res), true
+}
+
+// number of member skills found in normalized labels of pull request
+func (m Member) MatchingSkills(labels []string) int {
+	matching := 0
+
+	for _, skill := range m.Skills {
+		if slices.Contains(labels, skill) {
+			matching++
+		}
+	}
+
+	return matching
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UnavailabilityKind string
+
+const (
+	UnavailabilityVacation  UnavailabilityKind = "VACATION"
+	UnavailabilitySickLeave UnavailabilityKind = "SICK_LEAVE"
+	UnavailabilityOnCall    UnavailabilityKind = "ON_CALL"
+)
+
+type Unavailability struct {
+	Id       string
+	MemberId string
+	Kind     UnavailabilityKind
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+func NewUnavailability(memberId string, kind UnavailabilityKind, startsAt, endsAt time.Time) Unavailability {
+	id := uuid.NewString()
+
+	return Unavailability{
+		Id:       id,
+		MemberId: memberId,
+		Kind:     kind,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+	}
+}
+
+func (u Unavailability) IsValid() bool {
+	switch u.Kind {
+	case UnavailabilityVacation, UnavailabilitySickLeave, UnavailabilityOnCall:
+	default:
+		return false
+	}
+
+	return u.StartsAt.Before(u.EndsAt)
+}
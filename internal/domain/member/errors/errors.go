@@ -3,5 +3,9 @@ package errors
 import "errors"
 
 var (
-	ErrMemberNotFound = errors.New("member not found")
+	ErrMemberNotFound         = errors.New("member not found")
+	ErrInvalidMaxOpenReviews  = errors.New("max open reviews must not be negative")
+	ErrInvalidUnavailability  = errors.New("invalid unavailability range")
+	ErrUnavailabilityNotFound = errors.New("unavailability not found")
+	ErrInvalidSkills          = errors.New("skills must be non-empty and at most 64 characters long")
 )
@@ -4,8 +4,32 @@ import (
 	"context"
 
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
 )
 
 type MemberRepo interface {
-	SetActivity(ctx context.Context, userId string, activity memberEntity.MemberActivity) (memberEntity.Member, error)
+	// replace is nil, when open reviews of member should not be reassigned
+	SetActivity(
+		ctx context.Context,
+		userId string,
+		activity memberEntity.MemberActivity,
+		replace prInterfaces.ReplaceHandler,
+	) (memberEntity.Member, prEntity.ReassignReport, error)
+	SetMaxOpenReviews(ctx context.Context, userId string, maxOpenReviews *int) (memberEntity.Member, error)
+	AddUnavailability(ctx context.Context, unavailability memberEntity.Unavailability) (memberEntity.Unavailability, error)
+	GetUnavailability(ctx context.Context, userId string) ([]memberEntity.Unavailability, error)
+	UpdateUnavailability(
+		ctx context.Context,
+		unavailability memberEntity.Unavailability,
+	) (memberEntity.Unavailability, error)
+	DeleteUnavailability(ctx context.Context, unavailabilityId string) error
+	// ranges which have already started, but open reviews of member are not reassigned yet
+	GetStartedUnavailability(ctx context.Context) ([]memberEntity.Unavailability, error)
+	MarkReviewsReassigned(ctx context.Context, unavailabilityId string) error
+	// skills are normalized, every method returns all skills of member after the change
+	GetSkills(ctx context.Context, userId string) ([]string, error)
+	SetSkills(ctx context.Context, userId string, skills []string) ([]string, error)
+	AddSkills(ctx context.Context, userId string, skills []string) ([]string, error)
+	DeleteSkills(ctx context.Context, userId string, skills []string) ([]string, error)
 }
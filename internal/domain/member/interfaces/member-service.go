@@ -2,10 +2,39 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
 )
 
 type MemberService interface {
-	SetIsActive(ctx context.Context, userId string, isActive bool) (entity.Member, error)
+	// reassignReviews overrides config default, when it is not nil
+	SetIsActive(
+		ctx context.Context,
+		userId string,
+		isActive bool,
+		reassignReviews *bool,
+	) (entity.Member, prEntity.ReassignReport, error)
+	SetMaxOpenReviews(ctx context.Context, userId string, maxOpenReviews *int) (entity.Member, error)
+	AddUnavailability(
+		ctx context.Context,
+		userId string,
+		kind entity.UnavailabilityKind,
+		startsAt, endsAt time.Time,
+	) (entity.Unavailability, error)
+	GetUnavailability(ctx context.Context, userId string) ([]entity.Unavailability, error)
+	UpdateUnavailability(
+		ctx context.Context,
+		unavailabilityId string,
+		kind entity.UnavailabilityKind,
+		startsAt, endsAt time.Time,
+	) (entity.Unavailability, error)
+	DeleteUnavailability(ctx context.Context, unavailabilityId string) error
+	// every skills method returns all skills of member after the change
+	GetSkills(ctx context.Context, userId string) ([]string, error)
+	// replaces all skills of member
+	SetSkills(ctx context.Context, userId string, skills []string) ([]string, error)
+	AddSkills(ctx context.Context, userId string, skills []string) ([]string, error)
+	DeleteSkills(ctx context.Context, userId string, skills []string) ([]string, error)
 }
@@ -9,6 +9,8 @@ import (
 	reflect "reflect"
 
 	entity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	entity0 "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	interfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,17 +37,181 @@ func (m *MockMemberRepo) EXPECT() *MockMemberRepoMockRecorder {
 	return m.recorder
 }
 
+// AddSkills mocks base method.
+func (m *MockMemberRepo) AddSkills(ctx context.Context, userId string, skills []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSkills", ctx, userId, skills)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSkills indicates an expected call of AddSkills.
+func (mr *MockMemberRepoMockRecorder) AddSkills(ctx, userId, skills interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSkills", reflect.TypeOf((*MockMemberRepo)(nil).AddSkills), ctx, userId, skills)
+}
+
+// AddUnavailability mocks base method.
+func (m *MockMemberRepo) AddUnavailability(ctx context.Context, unavailability entity.Unavailability) (entity.Unavailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUnavailability", ctx, unavailability)
+	ret0, _ := ret[0].(entity.Unavailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddUnavailability indicates an expected call of AddUnavailability.
+func (mr *MockMemberRepoMockRecorder) AddUnavailability(ctx, unavailability interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUnavailability", reflect.TypeOf((*MockMemberRepo)(nil).AddUnavailability), ctx, unavailability)
+}
+
+// DeleteSkills mocks base method.
+func (m *MockMemberRepo) DeleteSkills(ctx context.Context, userId string, skills []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSkills", ctx, userId, skills)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSkills indicates an expected call of DeleteSkills.
+func (mr *MockMemberRepoMockRecorder) DeleteSkills(ctx, userId, skills interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSkills", reflect.TypeOf((*MockMemberRepo)(nil).DeleteSkills), ctx, userId, skills)
+}
+
+// DeleteUnavailability mocks base method.
+func (m *MockMemberRepo) DeleteUnavailability(ctx context.Context, unavailabilityId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnavailability", ctx, unavailabilityId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUnavailability indicates an expected call of DeleteUnavailability.
+func (mr *MockMemberRepoMockRecorder) DeleteUnavailability(ctx, unavailabilityId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnavailability", reflect.TypeOf((*MockMemberRepo)(nil).DeleteUnavailability), ctx, unavailabilityId)
+}
+
+// GetSkills mocks base method.
+func (m *MockMemberRepo) GetSkills(ctx context.Context, userId string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSkills", ctx, userId)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSkills indicates an expected call of GetSkills.
+func (mr *MockMemberRepoMockRecorder) GetSkills(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkills", reflect.TypeOf((*MockMemberRepo)(nil).GetSkills), ctx, userId)
+}
+
+// GetStartedUnavailability mocks base method.
+func (m *MockMemberRepo) GetStartedUnavailability(ctx context.Context) ([]entity.Unavailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStartedUnavailability", ctx)
+	ret0, _ := ret[0].([]entity.Unavailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStartedUnavailability indicates an expected call of GetStartedUnavailability.
+func (mr *MockMemberRepoMockRecorder) GetStartedUnavailability(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStartedUnavailability", reflect.TypeOf((*MockMemberRepo)(nil).GetStartedUnavailability), ctx)
+}
+
+// GetUnavailability mocks base method.
+func (m *MockMemberRepo) GetUnavailability(ctx context.Context, userId string) ([]entity.Unavailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnavailability", ctx, userId)
+	ret0, _ := ret[0].([]entity.Unavailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnavailability indicates an expected call of GetUnavailability.
+func (mr *MockMemberRepoMockRecorder) GetUnavailability(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnavailability", reflect.TypeOf((*MockMemberRepo)(nil).GetUnavailability), ctx, userId)
+}
+
+// MarkReviewsReassigned mocks base method.
+func (m *MockMemberRepo) MarkReviewsReassigned(ctx context.Context, unavailabilityId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReviewsReassigned", ctx, unavailabilityId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkReviewsReassigned indicates an expected call of MarkReviewsReassigned.
+func (mr *MockMemberRepoMockRecorder) MarkReviewsReassigned(ctx, unavailabilityId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReviewsReassigned", reflect.TypeOf((*MockMemberRepo)(nil).MarkReviewsReassigned), ctx, unavailabilityId)
+}
+
 // SetActivity mocks base method.
-func (m *MockMemberRepo) SetActivity(ctx context.Context, userId string, activity entity.MemberActivity) (entity.Member, error) {
+func (m *MockMemberRepo) SetActivity(ctx context.Context, userId string, activity entity.MemberActivity, replace interfaces.ReplaceHandler) (entity.Member, entity0.ReassignReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetActivity", ctx, userId, activity, replace)
+	ret0, _ := ret[0].(entity.Member)
+	ret1, _ := ret[1].(entity0.ReassignReport)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SetActivity indicates an expected call of SetActivity.
+func (mr *MockMemberRepoMockRecorder) SetActivity(ctx, userId, activity, replace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActivity", reflect.TypeOf((*MockMemberRepo)(nil).SetActivity), ctx, userId, activity, replace)
+}
+
+// SetMaxOpenReviews mocks base method.
+func (m *MockMemberRepo) SetMaxOpenReviews(ctx context.Context, userId string, maxOpenReviews *int) (entity.Member, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetActivity", ctx, userId, activity)
+	ret := m.ctrl.Call(m, "SetMaxOpenReviews", ctx, userId, maxOpenReviews)
 	ret0, _ := ret[0].(entity.Member)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SetActivity indicates an expected call of SetActivity.
-func (mr *MockMemberRepoMockRecorder) SetActivity(ctx, userId, activity interface{}) *gomock.Call {
+// SetMaxOpenReviews indicates an expected call of SetMaxOpenReviews.
+func (mr *MockMemberRepoMockRecorder) SetMaxOpenReviews(ctx, userId, maxOpenReviews interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxOpenReviews", reflect.TypeOf((*MockMemberRepo)(nil).SetMaxOpenReviews), ctx, userId, maxOpenReviews)
+}
+
+// SetSkills mocks base method.
+func (m *MockMemberRepo) SetSkills(ctx context.Context, userId string, skills []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSkills", ctx, userId, skills)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSkills indicates an expected call of SetSkills.
+func (mr *MockMemberRepoMockRecorder) SetSkills(ctx, userId, skills interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSkills", reflect.TypeOf((*MockMemberRepo)(nil).SetSkills), ctx, userId, skills)
+}
+
+// UpdateUnavailability mocks base method.
+func (m *MockMemberRepo) UpdateUnavailability(ctx context.Context, unavailability entity.Unavailability) (entity.Unavailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUnavailability", ctx, unavailability)
+	ret0, _ := ret[0].(entity.Unavailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUnavailability indicates an expected call of UpdateUnavailability.
+func (mr *MockMemberRepoMockRecorder) UpdateUnavailability(ctx, unavailability interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActivity", reflect.TypeOf((*MockMemberRepo)(nil).SetActivity), ctx, userId, activity)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUnavailability", reflect.TypeOf((*MockMemberRepo)(nil).UpdateUnavailability), ctx, unavailability)
 }
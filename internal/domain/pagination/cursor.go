@@ -0,0 +1,52 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrInvalidLimit  = errors.New("invalid page size")
+)
+
+type Order string
+
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+func (o Order) IsValid() bool {
+	return o == OrderAsc || o == OrderDesc
+}
+
+// position right after the last item of page: sort key of item and id to break ties
+type Cursor struct {
+	Value string `json:"v"`
+	Id    string `json:"id"`
+}
+
+// opaque token for clients, they should not depend on its content
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeCursor(token string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.Id == "" {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
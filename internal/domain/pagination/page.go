@@ -0,0 +1,14 @@
+package pagination
+
+// pages are fetched with one extra item which tells whether the next page exists.
+// NextPage cuts the extra item and returns cursor of the next page, nil on the last page
+func NextPage[T any](items []T, limit int, cursor func(item T) Cursor) ([]T, *Cursor) {
+	if len(items) <= limit {
+		return items, nil
+	}
+
+	items = items[:limit]
+	next := cursor(items[limit-1])
+
+	return items, &next
+}
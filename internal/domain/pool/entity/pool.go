@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"slices"
+
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	"github.com/google/uuid"
+)
+
+const maxNameLength = 64
+
+// named set of reviewers, members can belong to different teams
+type Pool struct {
+	Id        string
+	Name      string
+	MemberIds []string
+	// teams drawing reviewers from pool
+	Teams []TeamQuota
+}
+
+// number of reviewers team draws from pool for every pull request
+type TeamQuota struct {
+	TeamName       string
+	ReviewersCount int
+}
+
+// pool attached to team of pull request with members needed to pick reviewers
+type PoolQuota struct {
+	PoolName       string
+	ReviewersCount int
+	Members        []memberEntity.Member
+}
+
+// member ids are kept sorted and without duplicates
+func NewPool(name string, memberIds []string) Pool {
+	ids := slices.Clone(memberIds)
+	slices.Sort(ids)
+
+	return Pool{
+		Id:        uuid.NewString(),
+		Name:      name,
+		MemberIds: slices.Compact(ids),
+		Teams:     []TeamQuota{},
+	}
+}
+
+func (p Pool) IsValid() bool {
+	return p.Name != "" && len(p.Name) <= maxNameLength
+}
+
+// members of team followed by members of pools, who are not in team
+func MergeCandidates(teamMembers []memberEntity.Member, pools []PoolQuota) []memberEntity.Member {
+	res := slices.Clone(teamMembers)
+
+	for _, pool := range pools {
+		for _, member := range pool.Members {
+			if !slices.ContainsFunc(res, func(m memberEntity.Member) bool { return m.Id == member.Id }) {
+				res = append(res, member)
+			}
+		}
+	}
+
+	return res
+}
+
+func (q PoolQuota) Contains(memberId string) bool {
+	return slices.ContainsFunc(q.Members, func(member memberEntity.Member) bool {
+		return member.Id == memberId
+	})
+}
@@ -0,0 +1,11 @@
+package errors
+
+import "errors"
+
+var (
+	ErrPoolNotFound          = errors.New("pool not found")
+	ErrInvalidPoolName       = errors.New("pool name must be non-empty and at most 64 characters long")
+	ErrMemberNotFound        = errors.New("some members of pool not found")
+	ErrInvalidReviewersCount = errors.New("reviewers count must be positive")
+	ErrTeamNotAttached       = errors.New("team is not attached to pool")
+)
@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	poolEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/entity"
+)
+
+type PoolRepo interface {
+	// creates pool or replaces members of existing one, returns stored pool
+	Upsert(ctx context.Context, pool poolEntity.Pool) (poolEntity.Pool, error)
+	GetByName(ctx context.Context, name string) (poolEntity.Pool, error)
+	List(ctx context.Context) ([]poolEntity.Pool, error)
+	// attachments of pool to teams are removed with it
+	Delete(ctx context.Context, name string) error
+	// sets number of reviewers team draws from pool, returns updated pool
+	AttachTeam(ctx context.Context, poolName, teamName string, reviewersCount int) (poolEntity.Pool, error)
+	DetachTeam(ctx context.Context, poolName, teamName string) (poolEntity.Pool, error)
+}
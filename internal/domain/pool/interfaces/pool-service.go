@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	poolEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/entity"
+)
+
+type PoolService interface {
+	// creates pool or replaces its members, members can belong to any team
+	Upsert(ctx context.Context, name string, memberIds []string) (poolEntity.Pool, error)
+	GetByName(ctx context.Context, name string) (poolEntity.Pool, error)
+	List(ctx context.Context) ([]poolEntity.Pool, error)
+	Delete(ctx context.Context, name string) error
+	// team draws reviewersCount reviewers from pool in addition to its own ones
+	AttachTeam(ctx context.Context, poolName, teamName string, reviewersCount int) (poolEntity.Pool, error)
+	DetachTeam(ctx context.Context, poolName, teamName string) (poolEntity.Pool, error)
+}
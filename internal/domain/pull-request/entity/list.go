@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
+)
+
+type PRSortField string
+
+const (
+	PRSortCreatedAt PRSortField = "created_at"
+	PRSortName      PRSortField = "name"
+)
+
+func (f PRSortField) IsValid() bool {
+	return f == PRSortCreatedAt || f == PRSortName
+}
+
+// empty fields are not applied
+type PRFilter struct {
+	AuthorId   string
+	TeamName   string
+	Status     PRStatus
+	ReviewerId string
+	// created_at range, from is inclusive, to is exclusive
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+type PRListQuery struct {
+	Filter PRFilter
+	SortBy PRSortField
+	Order  pagination.Order
+	// nil for the first page
+	After *pagination.Cursor
+	Limit int
+}
+
+// status of review queue query, which disables status filter
+const ReviewQueueAnyStatus PRStatus = "ALL"
+
+// queue of PRs, where member is reviewer, ordered by created_at
+type ReviewQueueQuery struct {
+	ReviewerId string
+	// OPEN if empty, ReviewQueueAnyStatus for every status
+	Status PRStatus
+	Order  pagination.Order
+	// nil for the first page
+	After *pagination.Cursor
+	Limit int
+}
+
+// cursor pointing right after pr in list sorted by the field
+func (pr PullRequest) Cursor(sortBy PRSortField) pagination.Cursor {
+	value := pr.CreatedAt.UTC().Format(time.RFC3339Nano)
+	if sortBy == PRSortName {
+		value = pr.Name
+	}
+
+	return pagination.Cursor{
+		Value: value,
+		Id:    pr.Id,
+	}
+}
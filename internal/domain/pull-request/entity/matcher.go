@@ -2,6 +2,7 @@ package entity
 
 import (
 	"fmt"
+	"slices"
 )
 
 type PRMatcher struct {
@@ -17,7 +18,8 @@ func (m *PRMatcher) Matches(x interface{}) bool {
 	if m.expected.Name != actual.Name ||
 		m.expected.Id != actual.Id ||
 		m.expected.AuthorId != actual.AuthorId ||
-		m.expected.Status != actual.Status {
+		m.expected.Status != actual.Status ||
+		!slices.Equal(m.expected.Labels, actual.Labels) {
 
 		return false
 	}
@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+)
+
+type MergeCondition string
+
+const (
+	ConditionMinApprovals       MergeCondition = "MIN_APPROVALS"
+	ConditionNoChangesRequested MergeCondition = "NO_CHANGES_REQUESTED"
+	ConditionReviewerFromTeam   MergeCondition = "REVIEWER_FROM_TEAM"
+	ConditionAuthorCannotMerge  MergeCondition = "AUTHOR_CANNOT_MERGE"
+)
+
+type UnmetCondition struct {
+	Condition MergeCondition
+	Message   string
+}
+
+// preconditions of merge, zero value allows any merge
+type MergePolicy struct {
+	MinApprovals int
+	// no current reviewer has CHANGES_REQUESTED verdict
+	NoChangesRequested bool
+	// at least one current reviewer is a member of the team, empty disables the rule
+	RequiredReviewerTeam string
+	// merge is requested by somebody other than author
+	AuthorCannotMerge bool
+}
+
+// returns conditions, which are not met by pr, reviewers are current reviewers of pr with their teams
+func (p MergePolicy) Check(pr PullRequest, reviewers []memberEntity.Member, mergedBy string) []UnmetCondition {
+	unmet := make([]UnmetCondition, 0)
+
+	if approvals := pr.Approvals(); approvals < p.MinApprovals {
+		unmet = append(unmet, UnmetCondition{
+			Condition: ConditionMinApprovals,
+			Message:   fmt.Sprintf("%d approvals required, got %d", p.MinApprovals, approvals),
+		})
+	}
+
+	if p.NoChangesRequested {
+		requestedBy := make([]string, 0)
+
+		for _, state := range pr.ReviewerStates() {
+			if state.Verdict == VerdictChangesRequested {
+				requestedBy = append(requestedBy, state.ReviewerId)
+			}
+		}
+
+		if len(requestedBy) > 0 {
+			unmet = append(unmet, UnmetCondition{
+				Condition: ConditionNoChangesRequested,
+				Message:   fmt.Sprintf("changes requested by %s", strings.Join(requestedBy, ", ")),
+			})
+		}
+	}
+
+	if p.RequiredReviewerTeam != "" {
+		fromTeam := slices.ContainsFunc(reviewers, func(reviewer memberEntity.Member) bool {
+			return reviewer.InTeam(p.RequiredReviewerTeam) && slices.Contains(pr.Reviewers, reviewer.Id)
+		})
+
+		if !fromTeam {
+			unmet = append(unmet, UnmetCondition{
+				Condition: ConditionReviewerFromTeam,
+				Message:   fmt.Sprintf("no reviewer from team %s", p.RequiredReviewerTeam),
+			})
+		}
+	}
+
+	// unknown merger can not be told apart from author
+	if p.AuthorCannotMerge && (mergedBy == "" || mergedBy == pr.AuthorId) {
+		unmet = append(unmet, UnmetCondition{
+			Condition: ConditionAuthorCannotMerge,
+			Message:   "pr must be merged by somebody other than author",
+		})
+	}
+
+	return unmet
+}
@@ -1,14 +1,42 @@
 package entity
 
-import "time"
+import (
+	"slices"
+	"time"
+
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+)
 
 type PRStatus string
 
 const (
+	PRDraft  PRStatus = "DRAFT"
 	PROpen   PRStatus = "OPEN"
 	PRMerged PRStatus = "MERGED"
+	// declined pull request, can be reopened
+	PRClosed PRStatus = "CLOSED"
 )
 
+// status machine of pull request, MERGED is final
+var transitions = map[PRStatus][]PRStatus{
+	PRDraft:  {PROpen, PRClosed},
+	PROpen:   {PRMerged, PRClosed},
+	PRClosed: {PROpen},
+}
+
+func (s PRStatus) IsValid() bool {
+	switch s {
+	case PRDraft, PROpen, PRMerged, PRClosed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s PRStatus) CanTransitionTo(to PRStatus) bool {
+	return slices.Contains(transitions[s], to)
+}
+
 type PullRequest struct {
 	Id        string
 	Name      string
@@ -17,6 +45,10 @@ type PullRequest struct {
 	CreatedAt time.Time
 	MergedAt  time.Time
 	Reviewers []string
+	// normalized labels, reviewers with matching skills are preferred
+	Labels []string
+	// review states of reviewers as stored, PENDING ones have no verdict yet
+	Reviews []Review
 }
 
 func NewPullRequest(id, name, authorId string) PullRequest {
@@ -28,3 +60,94 @@ func NewPullRequest(id, name, authorId string) PullRequest {
 		CreatedAt: time.Now(),
 	}
 }
+
+// draft is not reviewed, so reviewers are assigned when it becomes OPEN
+func NewDraftPullRequest(id, name, authorId string) PullRequest {
+	pr := NewPullRequest(id, name, authorId)
+	pr.Status = PRDraft
+
+	return pr
+}
+
+// members, who can replace reviewer: available, not an author,
+// not a reviewer of pr already and not at review capacity
+func (pr PullRequest) ReplacementCandidates(teamMembers []memberEntity.Member) []memberEntity.Member {
+	currentReviewersMap := make(map[string]struct{}, len(pr.Reviewers))
+
+	for _, reviewer := range pr.Reviewers {
+		currentReviewersMap[reviewer] = struct{}{}
+	}
+
+	candidates := make([]memberEntity.Member, 0, len(teamMembers))
+
+	for _, member := range teamMembers {
+		if !member.IsAvailable() || member.Id == pr.AuthorId {
+			continue
+		}
+
+		// member at capacity is skipped as inactive one
+		if !member.HasReviewCapacity() {
+			continue
+		}
+
+		if _, ok := currentReviewersMap[member.Id]; ok {
+			continue
+		}
+
+		candidates = append(candidates, member)
+	}
+
+	return candidates
+}
+
+// state of every current reviewer, PENDING for ones without verdict
+func (pr PullRequest) ReviewerStates() []Review {
+	reviewsMap := make(map[string]Review, len(pr.Reviews))
+
+	for _, review := range pr.Reviews {
+		reviewsMap[review.ReviewerId] = review
+	}
+
+	states := make([]Review, 0, len(pr.Reviewers))
+
+	for _, reviewer := range pr.Reviewers {
+		review, ok := reviewsMap[reviewer]
+		if !ok {
+			review = Review{
+				ReviewerId: reviewer,
+				Verdict:    VerdictPending,
+			}
+		}
+
+		states = append(states, review)
+	}
+
+	return states
+}
+
+// time of assignment of reviewer, false if it is unknown
+func (pr PullRequest) AssignedAt(reviewerId string) (time.Time, bool) {
+	for _, review := range pr.Reviews {
+		if review.ReviewerId == reviewerId && !review.AssignedAt.IsZero() {
+			return review.AssignedAt, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (pr PullRequest) Age(now time.Time) time.Duration {
+	return now.Sub(pr.CreatedAt)
+}
+
+func (pr PullRequest) Approvals() int {
+	approvals := 0
+
+	for _, state := range pr.ReviewerStates() {
+		if state.Verdict == VerdictApproved {
+			approvals++
+		}
+	}
+
+	return approvals
+}
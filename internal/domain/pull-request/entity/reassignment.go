@@ -0,0 +1,14 @@
+package entity
+
+type Reassignment struct {
+	PullRequestId string
+	OldReviewerId string
+	NewReviewerId string
+}
+
+// result of reassignment of all open reviews of member
+type ReassignReport struct {
+	Reassigned []Reassignment
+	// ids of pull requests, where there are no members to replace reviewer
+	NotReassigned []string
+}
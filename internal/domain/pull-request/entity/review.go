@@ -0,0 +1,38 @@
+package entity
+
+import "time"
+
+type ReviewVerdict string
+
+const (
+	VerdictApproved         ReviewVerdict = "APPROVED"
+	VerdictChangesRequested ReviewVerdict = "CHANGES_REQUESTED"
+	VerdictCommented        ReviewVerdict = "COMMENTED"
+	// state of reviewer without verdict, can not be submitted
+	VerdictPending ReviewVerdict = "PENDING"
+)
+
+func (v ReviewVerdict) IsValid() bool {
+	switch v {
+	case VerdictApproved, VerdictChangesRequested, VerdictCommented:
+		return true
+	default:
+		return false
+	}
+}
+
+// last verdict of reviewer, a new one replaces previous
+type Review struct {
+	ReviewerId string
+	Verdict    ReviewVerdict
+	ReviewedAt time.Time
+	AssignedAt time.Time
+}
+
+func NewReview(reviewerId string, verdict ReviewVerdict) Review {
+	return Review{
+		ReviewerId: reviewerId,
+		Verdict:    verdict,
+		ReviewedAt: time.Now(),
+	}
+}
@@ -1,6 +1,12 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+)
 
 var (
 	ErrTeamOrUserNotFound = errors.New("team or user not found")
@@ -8,4 +14,32 @@ var (
 	ErrNotFound           = errors.New("pr not found")
 	ErrCannotReassign     = errors.New("no members to reassign")
 	ErrAlreadyMerged      = errors.New("pr already merged")
+	ErrNoReviewerCapacity = errors.New("not enough members with review capacity")
+	ErrAlreadyClosed      = errors.New("pr already closed")
+	ErrInvalidTransition  = errors.New("pr status transition is not allowed")
+	ErrInvalidVerdict     = errors.New("invalid review verdict")
+	ErrNotAssigned        = errors.New("reviewer is not assigned to pr")
+	ErrMergePolicy        = errors.New("merge policy is violated")
+	ErrInvalidListQuery   = errors.New("invalid pr list query")
+	ErrInvalidLabels      = errors.New("labels must be non-empty and at most 64 characters long")
+	ErrTeamArchived       = errors.New("team is archived")
 )
+
+// keeps unmet conditions of merge policy, matches ErrMergePolicy
+type MergePolicyError struct {
+	Unmet []prEntity.UnmetCondition
+}
+
+func (e *MergePolicyError) Error() string {
+	conditions := make([]string, 0, len(e.Unmet))
+
+	for _, unmet := range e.Unmet {
+		conditions = append(conditions, string(unmet.Condition))
+	}
+
+	return fmt.Sprintf("%s: %s", ErrMergePolicy.Error(), strings.Join(conditions, ", "))
+}
+
+func (e *MergePolicyError) Unwrap() error {
+	return ErrMergePolicy
+}
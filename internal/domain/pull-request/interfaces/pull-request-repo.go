@@ -3,18 +3,57 @@ package interfaces
 import (
 	"context"
 
+	codeownersEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/entity"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	poolEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/entity"
 	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
 )
 
-// extract assign logic from infrastructure layer
-type AssignHandler func(authorId string, members []memberEntity.Member) []string
-type ReassignHandler func(authorId string, pr prEntity.PullRequest, teamMembers []memberEntity.Member) (string, error)
-type UpdateStatusHandler func(pr prEntity.PullRequest) (prEntity.PullRequest, bool)
+// extract assign logic from infrastructure layer.
+// codeowners is ruleset uploaded for team, nil when there is none.
+// pools are reviewer pools attached to team
+type AssignHandler func(
+	authorId string,
+	teamName string,
+	members []memberEntity.Member,
+	codeowners *codeownersEntity.Ruleset,
+	pools []poolEntity.PoolQuota,
+) ([]string, error)
+
+// teamMembers of handlers replacing reviewer include members of pools attached to team
+type ReassignHandler func(
+	authorId string,
+	pr prEntity.PullRequest,
+	teamName string,
+	teamMembers []memberEntity.Member,
+) (string, error)
+
+// picks member to replace reviewer, who can not review open pr anymore
+type ReplaceHandler func(
+	pr prEntity.PullRequest,
+	teamName string,
+	teamMembers []memberEntity.Member,
+) (string, error)
+
+// returns pr with new status and reviewers, false if nothing to update.
+// reviewers are current reviewers of pr with their teams, pools are reviewer pools attached to team
+type UpdateStatusHandler func(
+	pr prEntity.PullRequest,
+	teamName string,
+	teamMembers []memberEntity.Member,
+	reviewers []memberEntity.Member,
+	pools []poolEntity.PoolQuota,
+) (prEntity.PullRequest, bool, error)
+
+// validates verdict against pr and returns review to store
+type ReviewHandler func(pr prEntity.PullRequest) (prEntity.Review, error)
 
 type PullRequestRepo interface {
-	GetByReviewer(ctx context.Context, reviewerId string, limit int) ([]prEntity.PullRequest, error)
-	Create(ctx context.Context, pr prEntity.PullRequest, assign AssignHandler) (prEntity.PullRequest, error)
+	GetById(ctx context.Context, prId string) (prEntity.PullRequest, error)
+	List(ctx context.Context, query prEntity.PRListQuery) ([]prEntity.PullRequest, error)
+	GetOpenIdsByReviewer(ctx context.Context, reviewerId string) ([]string, error)
+	// pr belongs to team with teamName, author must be its member. Empty teamName means primary team of author
+	Create(ctx context.Context, pr prEntity.PullRequest, teamName string, assign AssignHandler) (prEntity.PullRequest, error)
 	UpdateStatus(
 		ctx context.Context,
 		prId string,
@@ -26,4 +65,5 @@ type PullRequestRepo interface {
 		oldReviewerId string,
 		assign ReassignHandler,
 	) (prEntity.PullRequest, string, error)
+	AddReview(ctx context.Context, prId string, review ReviewHandler) (prEntity.PullRequest, error)
 }
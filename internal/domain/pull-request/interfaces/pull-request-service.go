@@ -3,12 +3,42 @@ package interfaces
 import (
 	"context"
 
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
 	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
 )
 
 type PullRequestService interface {
-	GetByReviewer(ctx context.Context, reviewerId string) ([]prEntity.PullRequest, error)
-	Create(ctx context.Context, prId, prName, authorId string) (prEntity.PullRequest, error)
-	Merge(ctx context.Context, prId string) (prEntity.PullRequest, error)
+	GetById(ctx context.Context, prId string) (prEntity.PullRequest, error)
+	// returns page of pull requests and cursor of the next page, nil on the last page
+	List(ctx context.Context, query prEntity.PRListQuery) ([]prEntity.PullRequest, *pagination.Cursor, error)
+	// limit 0 means the max page size
+	GetByReviewer(ctx context.Context, query prEntity.ReviewQueueQuery) ([]prEntity.PullRequest, *pagination.Cursor, error)
+	// changedFiles are paths changed by pr, their codeowners are preferred as reviewers.
+	// labels are kept with pr, members with matching skills are preferred as reviewers.
+	// teamName selects one of author teams, primary team of author is used when it is empty
+	Create(
+		ctx context.Context,
+		prId, prName, authorId, teamName string,
+		draft bool,
+		changedFiles []string,
+		labels []string,
+	) (prEntity.PullRequest, error)
+	Merge(ctx context.Context, prId string, mergedBy string) (prEntity.PullRequest, error)
+	// stores merge done on code host without merge policy check, returns conditions of policy, which are not met
+	RecordMerge(
+		ctx context.Context,
+		prId string,
+		mergedBy string,
+	) (prEntity.PullRequest, []prEntity.UnmetCondition, error)
+	Close(ctx context.Context, prId string) (prEntity.PullRequest, error)
+	Reopen(ctx context.Context, prId string) (prEntity.PullRequest, error)
+	Ready(ctx context.Context, prId string) (prEntity.PullRequest, error)
+	Review(
+		ctx context.Context,
+		prId string,
+		reviewerId string,
+		verdict prEntity.ReviewVerdict,
+	) (prEntity.PullRequest, error)
 	Reassign(ctx context.Context, prId string, oldReviewerId string) (prEntity.PullRequest, string, error)
+	ReassignOpenReviews(ctx context.Context, reviewerId string) (prEntity.ReassignReport, error)
 }
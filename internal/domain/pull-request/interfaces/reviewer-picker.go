@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+)
+
+// strategy of reviewers choice, candidates are already filtered by domain rules
+// (active, not an author, not a current reviewer)
+type ReviewerPicker interface {
+	Pick(scope PickScope, candidates []memberEntity.Member, count int) []string
+}
+
+// PickScope tells whom reviewers are picked for: strategy is chosen by team,
+// stateful strategies keep separate state for team and each of its pools
+type PickScope struct {
+	TeamName string
+	// empty, when reviewers are picked from team members
+	PoolName string
+}
+
+func TeamScope(teamName string) PickScope {
+	return PickScope{TeamName: teamName}
+}
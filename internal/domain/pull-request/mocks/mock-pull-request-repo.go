@@ -36,34 +36,79 @@ func (m *MockPullRequestRepo) EXPECT() *MockPullRequestRepoMockRecorder {
 	return m.recorder
 }
 
+// AddReview mocks base method.
+func (m *MockPullRequestRepo) AddReview(ctx context.Context, prId string, review interfaces.ReviewHandler) (entity.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReview", ctx, prId, review)
+	ret0, _ := ret[0].(entity.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReview indicates an expected call of AddReview.
+func (mr *MockPullRequestRepoMockRecorder) AddReview(ctx, prId, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReview", reflect.TypeOf((*MockPullRequestRepo)(nil).AddReview), ctx, prId, review)
+}
+
 // Create mocks base method.
-func (m *MockPullRequestRepo) Create(ctx context.Context, pr entity.PullRequest, assign interfaces.AssignHandler) (entity.PullRequest, error) {
+func (m *MockPullRequestRepo) Create(ctx context.Context, pr entity.PullRequest, teamName string, assign interfaces.AssignHandler) (entity.PullRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, pr, assign)
+	ret := m.ctrl.Call(m, "Create", ctx, pr, teamName, assign)
 	ret0, _ := ret[0].(entity.PullRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockPullRequestRepoMockRecorder) Create(ctx, pr, assign interface{}) *gomock.Call {
+func (mr *MockPullRequestRepoMockRecorder) Create(ctx, pr, teamName, assign interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPullRequestRepo)(nil).Create), ctx, pr, teamName, assign)
+}
+
+// GetById mocks base method.
+func (m *MockPullRequestRepo) GetById(ctx context.Context, prId string) (entity.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, prId)
+	ret0, _ := ret[0].(entity.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockPullRequestRepoMockRecorder) GetById(ctx, prId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockPullRequestRepo)(nil).GetById), ctx, prId)
+}
+
+// GetOpenIdsByReviewer mocks base method.
+func (m *MockPullRequestRepo) GetOpenIdsByReviewer(ctx context.Context, reviewerId string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenIdsByReviewer", ctx, reviewerId)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenIdsByReviewer indicates an expected call of GetOpenIdsByReviewer.
+func (mr *MockPullRequestRepoMockRecorder) GetOpenIdsByReviewer(ctx, reviewerId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPullRequestRepo)(nil).Create), ctx, pr, assign)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenIdsByReviewer", reflect.TypeOf((*MockPullRequestRepo)(nil).GetOpenIdsByReviewer), ctx, reviewerId)
 }
 
-// GetByReviewer mocks base method.
-func (m *MockPullRequestRepo) GetByReviewer(ctx context.Context, reviewerId string, limit int) ([]entity.PullRequest, error) {
+// List mocks base method.
+func (m *MockPullRequestRepo) List(ctx context.Context, query entity.PRListQuery) ([]entity.PullRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByReviewer", ctx, reviewerId, limit)
+	ret := m.ctrl.Call(m, "List", ctx, query)
 	ret0, _ := ret[0].([]entity.PullRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByReviewer indicates an expected call of GetByReviewer.
-func (mr *MockPullRequestRepoMockRecorder) GetByReviewer(ctx, reviewerId, limit interface{}) *gomock.Call {
+// List indicates an expected call of List.
+func (mr *MockPullRequestRepoMockRecorder) List(ctx, query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReviewer", reflect.TypeOf((*MockPullRequestRepo)(nil).GetByReviewer), ctx, reviewerId, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPullRequestRepo)(nil).List), ctx, query)
 }
 
 // Reassign mocks base method.
@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"time"
+
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+)
+
+type ReviewEventType string
+
+const (
+	ReviewAssigned   ReviewEventType = "assigned"
+	ReviewUnassigned ReviewEventType = "unassigned"
+	// pr, which user reviews, is merged
+	ReviewMerged ReviewEventType = "merged"
+)
+
+// change of review queue of reviewer
+type ReviewEvent struct {
+	// position in stream, it is assigned on store and grows with every event
+	Seq             int64
+	ReviewerId      string
+	Type            ReviewEventType
+	PullRequestId   string
+	PullRequestName string
+	AuthorId        string
+	CreatedAt       time.Time
+}
+
+func NewReviewEvent(eventType ReviewEventType, reviewerId string, pr prEntity.PullRequest) ReviewEvent {
+	return ReviewEvent{
+		ReviewerId:      reviewerId,
+		Type:            eventType,
+		PullRequestId:   pr.Id,
+		PullRequestName: pr.Name,
+		AuthorId:        pr.AuthorId,
+		CreatedAt:       time.Now(),
+	}
+}
+
+// one event of type for every given reviewer
+func NewReviewEvents(eventType ReviewEventType, reviewerIds []string, pr prEntity.PullRequest) []ReviewEvent {
+	events := make([]ReviewEvent, 0, len(reviewerIds))
+
+	for _, reviewerId := range reviewerIds {
+		events = append(events, NewReviewEvent(eventType, reviewerId, pr))
+	}
+
+	return events
+}
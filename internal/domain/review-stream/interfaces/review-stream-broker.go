@@ -0,0 +1,8 @@
+package interfaces
+
+type ReviewStreamBroker interface {
+	// returned channel gets a signal, when new events of reviewer may be stored.
+	// Signals are coalesced, so subscriber reads everything after its last seen event.
+	// Channel is closed, when broker stops. Returned func unsubscribes
+	Subscribe(reviewerId string) (<-chan struct{}, func())
+}
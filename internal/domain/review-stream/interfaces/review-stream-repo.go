@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/entity"
+)
+
+type ReviewStreamRepo interface {
+	// up to limit events of reviewer with seq greater than after in seq order
+	GetAfter(ctx context.Context, reviewerId string, after int64, limit int) ([]entity.ReviewEvent, error)
+	// seq of the latest stored event of any reviewer, 0 for empty stream
+	GetLastSeq(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/entity"
+)
+
+// ReviewEventSink writes events to client connection
+type ReviewEventSink interface {
+	Send(event entity.ReviewEvent) error
+	// keeps idle connection open through proxies
+	KeepAlive() error
+}
+
+type ReviewStreamService interface {
+	// sends events of reviewer to sink until ctx is done or sink fails.
+	// Events after lastEventId are replayed first, stream starts with new events when it is nil
+	Stream(ctx context.Context, reviewerId string, lastEventId *int64, sink ReviewEventSink) error
+}
@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"time"
+
+	integrationEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
+	webhookEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+)
+
+type PublicationStatus string
+
+const (
+	PublicationPending   PublicationStatus = "PENDING"
+	PublicationPublished PublicationStatus = "PUBLISHED"
+	// attempts are exhausted, reviewers have to be copied to code host by hand
+	PublicationDead PublicationStatus = "DEAD"
+)
+
+// change of pr reviewers to push to code host, members are identified by ids
+type Publication struct {
+	Id            int64
+	Link          integrationEntity.HostLink
+	Added         []string
+	Removed       []string
+	Status        PublicationStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	PublishedAt   time.Time
+}
+
+func (p Publication) Published(now time.Time) Publication {
+	p.Attempts++
+	p.Status = PublicationPublished
+	p.LastError = ""
+	p.PublishedAt = now
+
+	return p
+}
+
+// schedules next attempt by policy or moves publication to dead ones, when attempts are exhausted
+func (p Publication) Failed(reason string, policy webhookEntity.RetryPolicy, now time.Time) Publication {
+	p.Attempts++
+	p.LastError = reason
+
+	if p.Attempts >= policy.MaxAttempts {
+		p.Status = PublicationDead
+		return p
+	}
+
+	p.Status = PublicationPending
+	p.NextAttemptAt = now.Add(policy.Backoff(p.Attempts))
+
+	return p
+}
+
+// request of reviewers change on code host, users are identified by logins of the host
+type ReviewerRequest struct {
+	Repository string
+	Number     int64
+	Added      []string
+	Removed    []string
+}
+
+func (r ReviewerRequest) IsEmpty() bool {
+	return len(r.Added) == 0 && len(r.Removed) == 0
+}
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/entity"
+)
+
+type PublicationRepo interface {
+	// claims up to limit PENDING publications due at now, only the oldest pending one of pr is claimed,
+	// so changes of pr reach code host in order. Claimed ones are hidden from other publishers until lease ends
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entity.Publication, error)
+	// stores status, attempts and schedule of publication after attempt
+	SaveAttempt(ctx context.Context, publication entity.Publication) error
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/entity"
+)
+
+// ReviewerPublisher requests review of added reviewers on code host pr and withdraws request of removed ones
+type ReviewerPublisher interface {
+	// error is returned for transport failures and non 2xx responses
+	Publish(ctx context.Context, request entity.ReviewerRequest) error
+}
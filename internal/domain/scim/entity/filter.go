@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// identity providers look up resources with a single equality filter before provisioning them,
+// so only `<attribute> eq "<value>"` is supported
+var filterPattern = regexp.MustCompile(`^\s*([A-Za-z][\w.]*)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+type Filter struct {
+	Attribute string
+	Value     string
+}
+
+// empty filter matches all resources
+func (f Filter) IsEmpty() bool {
+	return f.Attribute == ""
+}
+
+// attribute names are case insensitive in SCIM
+func (f Filter) On(attribute string) bool {
+	return strings.EqualFold(f.Attribute, attribute)
+}
+
+func ParseFilter(filter string) (Filter, bool) {
+	if strings.TrimSpace(filter) == "" {
+		return Filter{}, true
+	}
+
+	match := filterPattern.FindStringSubmatch(filter)
+
+	if match == nil {
+		return Filter{}, false
+	}
+
+	value, err := strconv.Unquote(match[2])
+
+	if err != nil {
+		return Filter{}, false
+	}
+
+	return Filter{
+		Attribute: match[1],
+		Value:     value,
+	}, true
+}
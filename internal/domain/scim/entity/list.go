@@ -0,0 +1,45 @@
+package entity
+
+import (
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	teamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
+)
+
+// MaxPageSize limits count of resources in one list response
+const MaxPageSize = 100
+
+// page of list request, StartIndex is 1-based as in SCIM
+type Page struct {
+	StartIndex int
+	Count      int
+}
+
+// Normalize fixes out of range values, count not greater than 0 means default page size
+func (p Page) Normalize() Page {
+	if p.StartIndex < 1 {
+		p.StartIndex = 1
+	}
+
+	if p.Count <= 0 || p.Count > MaxPageSize {
+		p.Count = MaxPageSize
+	}
+
+	return p
+}
+
+func (p Page) Offset() int {
+	return p.StartIndex - 1
+}
+
+type UserList struct {
+	// number of users matching filter on all pages
+	Total int
+	Page  Page
+	Users []memberEntity.Member
+}
+
+type GroupList struct {
+	Total  int
+	Page   Page
+	Groups []teamEntity.Team
+}
@@ -0,0 +1,24 @@
+package entity
+
+// changes of user from PUT or PATCH request, nil fields are kept
+type UserPatch struct {
+	Username *string
+	Active   *bool
+}
+
+type GroupOperationKind string
+
+const (
+	GroupRename         GroupOperationKind = "RENAME"
+	GroupAddMembers     GroupOperationKind = "ADD_MEMBERS"
+	GroupRemoveMembers  GroupOperationKind = "REMOVE_MEMBERS"
+	GroupReplaceMembers GroupOperationKind = "REPLACE_MEMBERS"
+)
+
+// operations of PUT or PATCH request are applied to group in order
+type GroupOperation struct {
+	Kind GroupOperationKind
+	// new name of group for GroupRename
+	DisplayName string
+	MemberIds   []string
+}
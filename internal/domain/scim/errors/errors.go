@@ -0,0 +1,11 @@
+package errors
+
+import "errors"
+
+var (
+	ErrUserExists      = errors.New("user with this id already exists")
+	ErrInvalidUserName = errors.New("userName must be non-empty")
+	ErrInvalidFilter   = errors.New("only filters like userName eq \"value\" for users and displayName eq \"value\" for groups are supported")
+	ErrInvalidPatch    = errors.New("unsupported patch operation")
+	ErrUnknownMembers  = errors.New("some members of group not found")
+)
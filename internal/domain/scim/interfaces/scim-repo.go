@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+)
+
+type ScimRepo interface {
+	// user is created without teams
+	CreateUser(ctx context.Context, member memberEntity.Member) (memberEntity.Member, error)
+	GetUser(ctx context.Context, id string) (memberEntity.Member, error)
+	// username is matched case insensitively, all users are listed when it is empty
+	ListUsers(ctx context.Context, username string, offset, limit int) ([]memberEntity.Member, int, error)
+	SetUsername(ctx context.Context, id, username string) error
+	// groups are addressed by team id, because it stays the same after rename
+	GetGroupName(ctx context.Context, id string) (string, error)
+	ListGroupNames(ctx context.Context, name string, offset, limit int) ([]string, int, error)
+}
@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/entity"
+	teamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
+)
+
+// maps SCIM users onto members and SCIM groups onto teams
+type ScimService interface {
+	// id is generated, when it is empty
+	CreateUser(ctx context.Context, id, username string, active bool) (memberEntity.Member, error)
+	GetUser(ctx context.Context, id string) (memberEntity.Member, error)
+	ListUsers(ctx context.Context, filter string, page entity.Page) (entity.UserList, error)
+	// deactivation goes through member service, so open reviews are handled as on /users/setIsActive
+	UpdateUser(ctx context.Context, id string, patch entity.UserPatch) (memberEntity.Member, error)
+	// members are referenced by pull requests, so deleted user is only deactivated
+	DeleteUser(ctx context.Context, id string) error
+	CreateGroup(ctx context.Context, name string, memberIds []string) (teamEntity.Team, error)
+	GetGroup(ctx context.Context, id string) (teamEntity.Team, error)
+	ListGroups(ctx context.Context, filter string, page entity.Page) (entity.GroupList, error)
+	// every operation is applied by team service in its own transaction
+	UpdateGroup(ctx context.Context, id string, ops []entity.GroupOperation) (teamEntity.Team, error)
+	// group with OPEN or DRAFT pull requests is not deleted
+	DeleteGroup(ctx context.Context, id string) error
+}
@@ -1,6 +1,20 @@
 package entity
 
+import (
+	"strconv"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
+)
+
 type AssignmentsPerMember struct {
 	MemberId         string
 	AssignmentsCount int
 }
+
+// cursor pointing right after stats row in list sorted by assignments count desc
+func (a AssignmentsPerMember) Cursor() pagination.Cursor {
+	return pagination.Cursor{
+		Value: strconv.Itoa(a.AssignmentsCount),
+		Id:    a.MemberId,
+	}
+}
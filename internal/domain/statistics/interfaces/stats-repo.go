@@ -3,9 +3,17 @@ package interfaces
 import (
 	"context"
 
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/statistics/entity"
 )
 
 type StatsRepo interface {
-	GetAssignmentsPerMember(ctx context.Context, limit, offset int) ([]entity.AssignmentsPerMember, error)
+	// ordered by assignments count desc and member id, after is nil for the first page.
+	// empty teamName means all members, otherwise members of team with assignments to its pull requests
+	GetAssignmentsPerMember(
+		ctx context.Context,
+		teamName string,
+		after *pagination.Cursor,
+		limit int,
+	) ([]entity.AssignmentsPerMember, error)
 }
@@ -3,9 +3,17 @@ package interfaces
 import (
 	"context"
 
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/statistics/entity"
 )
 
 type StatsService interface {
-	GetAssignmentsPerMember(ctx context.Context, limit, offset int) ([]entity.AssignmentsPerMember, error)
+	// returns page of stats and cursor of the next page, nil on the last page. limit 0 means the max page size.
+	// non-empty teamName limits stats to members and pull requests of the team
+	GetAssignmentsPerMember(
+		ctx context.Context,
+		teamName string,
+		after *pagination.Cursor,
+		limit int,
+	) ([]entity.AssignmentsPerMember, *pagination.Cursor, error)
 }
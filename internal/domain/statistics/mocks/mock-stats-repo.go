@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	pagination "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
 	entity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/statistics/entity"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -36,16 +37,16 @@ func (m *MockStatsRepo) EXPECT() *MockStatsRepoMockRecorder {
 }
 
 // GetAssignmentsPerMember mocks base method.
-func (m *MockStatsRepo) GetAssignmentsPerMember(ctx context.Context, limit, offset int) ([]entity.AssignmentsPerMember, error) {
+func (m *MockStatsRepo) GetAssignmentsPerMember(ctx context.Context, teamName string, after *pagination.Cursor, limit int) ([]entity.AssignmentsPerMember, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAssignmentsPerMember", ctx, limit, offset)
+	ret := m.ctrl.Call(m, "GetAssignmentsPerMember", ctx, teamName, after, limit)
 	ret0, _ := ret[0].([]entity.AssignmentsPerMember)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAssignmentsPerMember indicates an expected call of GetAssignmentsPerMember.
-func (mr *MockStatsRepoMockRecorder) GetAssignmentsPerMember(ctx, limit, offset interface{}) *gomock.Call {
+func (mr *MockStatsRepoMockRecorder) GetAssignmentsPerMember(ctx, teamName, after, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignmentsPerMember", reflect.TypeOf((*MockStatsRepo)(nil).GetAssignmentsPerMember), ctx, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignmentsPerMember", reflect.TypeOf((*MockStatsRepo)(nil).GetAssignmentsPerMember), ctx, teamName, after, limit)
 }
@@ -0,0 +1,14 @@
+package entity
+
+import (
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+)
+
+// result of bulk deactivation of team members
+type DeactivationReport struct {
+	// number of members switched from active to inactive
+	Deactivated int
+	Reassigned  []prEntity.Reassignment
+	// pull requests left with less reviewers, because there are no members to replace deactivated ones
+	ShortOfReviewers []string
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+)
+
+// what to do with unfinished (OPEN and DRAFT) pull requests of deleted team
+type DeleteMode string
+
+const (
+	// deletion is refused while team has unfinished pull requests
+	DeleteRefuse DeleteMode = ""
+	DeleteClose  DeleteMode = "CLOSE"
+	// unfinished pull requests are moved to target team, reviewers outside of it are replaced by its members
+	DeleteReassign DeleteMode = "REASSIGN"
+)
+
+func (m DeleteMode) IsValid() bool {
+	switch m {
+	case DeleteRefuse, DeleteClose, DeleteReassign:
+		return true
+	default:
+		return false
+	}
+}
+
+// result of team deletion, finished pull requests stay without team
+type DeletionReport struct {
+	Closed []string
+	Moved  []string
+	// reviewers of moved pull requests replaced by members of target team
+	Reassigned []prEntity.Reassignment
+	// moved pull requests left with less reviewers, because there are no members of target team to replace reviewers
+	ShortOfReviewers []string
+}
@@ -9,6 +9,15 @@ type Team struct {
 	Id      string
 	Name    string
 	Members []memberEntity.Member
+	// archived team keeps members and history, but new pull requests can not be created in it
+	Archived bool
+}
+
+// same limit as team_name column
+const maxNameLength = 64
+
+func IsValidName(name string) bool {
+	return name != "" && len(name) <= maxNameLength
 }
 
 func NewTeam(name string, members []memberEntity.Member) Team {
@@ -0,0 +1,25 @@
+package entity
+
+// review of removed member on OPEN pull request of team, it is dropped by upsert
+type DroppedReview struct {
+	PullRequestId string
+	ReviewerId    string
+}
+
+// added member, which already belongs to other teams, it keeps those memberships
+type MemberOfOtherTeams struct {
+	MemberId string
+	// teams of member before upsert
+	Teams []string
+}
+
+// changes of team roster made by upsert, or planned by it in dry run
+type UpsertDiff struct {
+	// ids of members, which were not in team, including members of other teams
+	Added   []string
+	Removed []string
+	// added members, who stay members of their other teams too
+	AlsoMemberOf []MemberOfOtherTeams
+	// reviewers removed from OPEN pull requests of team
+	DroppedReviews []DroppedReview
+}
@@ -3,7 +3,15 @@ package errors
 import "errors"
 
 var (
-	ErrTeamNotFound      = errors.New("team not found")
-	ErrTeamExists        = errors.New("team already exists")
-	ErrMemberOfOtherTeam = errors.New("user is already member of other team")
+	ErrTeamNotFound        = errors.New("team not found")
+	ErrTeamExists          = errors.New("team already exists")
+	ErrDeactivationTimeout = errors.New("deactivation exceeded latency budget")
+	ErrInvalidTeamName     = errors.New("team name must be non-empty and at most 64 characters long")
+	ErrTeamArchived        = errors.New("team is archived")
+	ErrTeamHasOpenPRs      = errors.New("team has open pull requests")
+	ErrInvalidDeleteMode   = errors.New("force must be CLOSE or REASSIGN")
+	ErrInvalidTargetTeam   = errors.New("target team must be set for REASSIGN and differ from deleted team")
+	ErrMemberNotInTeam     = errors.New("user is not member of team")
+	ErrNoMembers           = errors.New("members list must not be empty")
+	ErrInvalidMoveTarget   = errors.New("target team must be set and differ from source team")
 )
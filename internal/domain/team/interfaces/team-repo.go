@@ -3,7 +3,9 @@ package interfaces
 import (
 	"context"
 
+	codeownersEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/entity"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	prInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
 	teamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
 )
 
@@ -11,7 +13,48 @@ import (
 type TeamMatcher func(currentTeam teamEntity.Team) bool
 
 type TeamRepo interface {
-	Upsert(ctx context.Context, team teamEntity.Team, matcher TeamMatcher) error
+	// changes are rolled back in dry run, diff is the same as for real upsert
+	Upsert(ctx context.Context, team teamEntity.Team, matcher TeamMatcher, dryRun bool) (teamEntity.UpsertDiff, error)
+	// inserts new team with members, existing team is never touched and reported as ErrTeamExists
+	Create(ctx context.Context, team teamEntity.Team) error
+	// renames team and sets its members to the given ones in one transaction, removed members lose their
+	// reviews on OPEN pull requests of team as in RemoveMembers
+	Replace(ctx context.Context, name string, team teamEntity.Team) (teamEntity.Team, error)
 	GetByName(ctx context.Context, name string) (teamEntity.Team, error)
-	SetActivityForAll(ctx context.Context, name string, activity memberEntity.MemberActivity) error
+	// deactivates active members of team except keepActive,
+	// replace is nil, when open reviews of members should not be reassigned
+	DeactivateMembers(
+		ctx context.Context,
+		name string,
+		keepActive []string,
+		replace prInterfaces.ReplaceHandler,
+	) (teamEntity.DeactivationReport, error)
+	// replaces ruleset uploaded for team
+	SetCodeowners(ctx context.Context, name string, ruleset codeownersEntity.Ruleset) error
+	// returns ruleset uploaded for team, nil when there is none
+	GetCodeowners(ctx context.Context, name string) (*codeownersEntity.Ruleset, error)
+	// team keeps its id, so its pull requests, members and pools are kept too
+	Rename(ctx context.Context, name, newName string) error
+	SetArchived(ctx context.Context, name string, archived bool) error
+	// removes team with its memberships, codeowners and pool attachments, finished pull requests stay without team.
+	// closeHandler closes pull requests in DeleteClose mode, targetTeam and replace are used only by DeleteReassign mode
+	Delete(
+		ctx context.Context,
+		name string,
+		mode teamEntity.DeleteMode,
+		targetTeam string,
+		closeHandler prInterfaces.UpdateStatusHandler,
+		replace prInterfaces.ReplaceHandler,
+	) (teamEntity.DeletionReport, error)
+	// creates unknown users and adds them to team, current members are kept as is
+	AddMembers(ctx context.Context, name string, members []memberEntity.Member) (teamEntity.Team, error)
+	// removes members from team together with their reviews on OPEN pull requests of team
+	RemoveMembers(ctx context.Context, name string, memberIds []string) (teamEntity.Team, error)
+	// same as removal from team and adding to targetTeam, returns source and target teams after move
+	MoveMembers(
+		ctx context.Context,
+		name string,
+		targetTeam string,
+		memberIds []string,
+	) (teamEntity.Team, teamEntity.Team, error)
 }
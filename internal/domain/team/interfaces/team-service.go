@@ -3,12 +3,57 @@ package interfaces
 import (
 	"context"
 
+	codeownersEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/entity"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
 	teamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
 )
 
 type TeamService interface {
-	Upsert(ctx context.Context, name string, membersList []memberEntity.Member) error
+	// nothing is stored in dry run, only diff of roster is computed
+	Upsert(
+		ctx context.Context,
+		name string,
+		membersList []memberEntity.Member,
+		dryRun bool,
+	) (teamEntity.UpsertDiff, error)
+	// unlike Upsert, existing team is reported as conflict instead of replacing its members
+	Create(ctx context.Context, name string, members []memberEntity.Member) error
+	// applies new name and full member list of team at once
+	Replace(
+		ctx context.Context,
+		name string,
+		newName string,
+		members []memberEntity.Member,
+	) (teamEntity.Team, error)
 	GetByName(ctx context.Context, name string) (teamEntity.Team, error)
-	DeactivateAll(ctx context.Context, name string) error
+	// reassignReviews overrides config default, when it is not nil
+	DeactivateAll(
+		ctx context.Context,
+		name string,
+		keepActive []string,
+		reassignReviews *bool,
+	) (teamEntity.DeactivationReport, error)
+	// parses CODEOWNERS text and stores it for team, it overrides rules from config
+	SetCodeowners(ctx context.Context, name string, rules string) (codeownersEntity.Ruleset, error)
+	// returns ruleset used to pick reviewers of team and where it comes from
+	GetCodeowners(ctx context.Context, name string) (codeownersEntity.Ruleset, codeownersEntity.Source, error)
+	Rename(ctx context.Context, name, newName string) error
+	// archived team blocks new pull requests, history stays available
+	SetArchived(ctx context.Context, name string, archived bool) error
+	// mode says what to do with OPEN and DRAFT pull requests of team, targetTeam is required for DeleteReassign
+	Delete(
+		ctx context.Context,
+		name string,
+		mode teamEntity.DeleteMode,
+		targetTeam string,
+	) (teamEntity.DeletionReport, error)
+	AddMembers(ctx context.Context, name string, members []memberEntity.Member) (teamEntity.Team, error)
+	RemoveMembers(ctx context.Context, name string, memberIds []string) (teamEntity.Team, error)
+	// moved members become members of targetTeam, it becomes primary for those, whose primary team was source one
+	MoveMembers(
+		ctx context.Context,
+		name string,
+		targetTeam string,
+		memberIds []string,
+	) (teamEntity.Team, teamEntity.Team, error)
 }
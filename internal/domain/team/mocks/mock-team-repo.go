@@ -8,9 +8,11 @@ import (
 	context "context"
 	reflect "reflect"
 
-	entity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
-	entity0 "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
-	interfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/interfaces"
+	entity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/entity"
+	entity0 "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	interfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+	entity1 "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
+	interfaces0 "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/interfaces"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -37,11 +39,70 @@ func (m *MockTeamRepo) EXPECT() *MockTeamRepoMockRecorder {
 	return m.recorder
 }
 
+// AddMembers mocks base method.
+func (m *MockTeamRepo) AddMembers(ctx context.Context, name string, members []entity0.Member) (entity1.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMembers", ctx, name, members)
+	ret0, _ := ret[0].(entity1.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMembers indicates an expected call of AddMembers.
+func (mr *MockTeamRepoMockRecorder) AddMembers(ctx, name, members interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMembers", reflect.TypeOf((*MockTeamRepo)(nil).AddMembers), ctx, name, members)
+}
+
+// Create mocks base method.
+func (m *MockTeamRepo) Create(ctx context.Context, team entity1.Team) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, team)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTeamRepoMockRecorder) Create(ctx, team interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTeamRepo)(nil).Create), ctx, team)
+}
+
+// DeactivateMembers mocks base method.
+func (m *MockTeamRepo) DeactivateMembers(ctx context.Context, name string, keepActive []string, replace interfaces.ReplaceHandler) (entity1.DeactivationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateMembers", ctx, name, keepActive, replace)
+	ret0, _ := ret[0].(entity1.DeactivationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateMembers indicates an expected call of DeactivateMembers.
+func (mr *MockTeamRepoMockRecorder) DeactivateMembers(ctx, name, keepActive, replace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateMembers", reflect.TypeOf((*MockTeamRepo)(nil).DeactivateMembers), ctx, name, keepActive, replace)
+}
+
+// Delete mocks base method.
+func (m *MockTeamRepo) Delete(ctx context.Context, name string, mode entity1.DeleteMode, targetTeam string, closeHandler interfaces.UpdateStatusHandler, replace interfaces.ReplaceHandler) (entity1.DeletionReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name, mode, targetTeam, closeHandler, replace)
+	ret0, _ := ret[0].(entity1.DeletionReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTeamRepoMockRecorder) Delete(ctx, name, mode, targetTeam, closeHandler, replace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTeamRepo)(nil).Delete), ctx, name, mode, targetTeam, closeHandler, replace)
+}
+
 // GetByName mocks base method.
-func (m *MockTeamRepo) GetByName(ctx context.Context, name string) (entity0.Team, error) {
+func (m *MockTeamRepo) GetByName(ctx context.Context, name string) (entity1.Team, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetByName", ctx, name)
-	ret0, _ := ret[0].(entity0.Team)
+	ret0, _ := ret[0].(entity1.Team)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -52,30 +113,120 @@ func (mr *MockTeamRepoMockRecorder) GetByName(ctx, name interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockTeamRepo)(nil).GetByName), ctx, name)
 }
 
-// SetActivityForAll mocks base method.
-func (m *MockTeamRepo) SetActivityForAll(ctx context.Context, name string, activity entity.MemberActivity) error {
+// GetCodeowners mocks base method.
+func (m *MockTeamRepo) GetCodeowners(ctx context.Context, name string) (*entity.Ruleset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCodeowners", ctx, name)
+	ret0, _ := ret[0].(*entity.Ruleset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCodeowners indicates an expected call of GetCodeowners.
+func (mr *MockTeamRepoMockRecorder) GetCodeowners(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodeowners", reflect.TypeOf((*MockTeamRepo)(nil).GetCodeowners), ctx, name)
+}
+
+// MoveMembers mocks base method.
+func (m *MockTeamRepo) MoveMembers(ctx context.Context, name string, targetTeam string, memberIds []string) (entity1.Team, entity1.Team, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetActivityForAll", ctx, name, activity)
+	ret := m.ctrl.Call(m, "MoveMembers", ctx, name, targetTeam, memberIds)
+	ret0, _ := ret[0].(entity1.Team)
+	ret1, _ := ret[1].(entity1.Team)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MoveMembers indicates an expected call of MoveMembers.
+func (mr *MockTeamRepoMockRecorder) MoveMembers(ctx, name, targetTeam, memberIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMembers", reflect.TypeOf((*MockTeamRepo)(nil).MoveMembers), ctx, name, targetTeam, memberIds)
+}
+
+// RemoveMembers mocks base method.
+func (m *MockTeamRepo) RemoveMembers(ctx context.Context, name string, memberIds []string) (entity1.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMembers", ctx, name, memberIds)
+	ret0, _ := ret[0].(entity1.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMembers indicates an expected call of RemoveMembers.
+func (mr *MockTeamRepoMockRecorder) RemoveMembers(ctx, name, memberIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMembers", reflect.TypeOf((*MockTeamRepo)(nil).RemoveMembers), ctx, name, memberIds)
+}
+
+// Rename mocks base method.
+func (m *MockTeamRepo) Rename(ctx context.Context, name string, newName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", ctx, name, newName)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SetActivityForAll indicates an expected call of SetActivityForAll.
-func (mr *MockTeamRepoMockRecorder) SetActivityForAll(ctx, name, activity interface{}) *gomock.Call {
+// Rename indicates an expected call of Rename.
+func (mr *MockTeamRepoMockRecorder) Rename(ctx, name, newName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActivityForAll", reflect.TypeOf((*MockTeamRepo)(nil).SetActivityForAll), ctx, name, activity)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockTeamRepo)(nil).Rename), ctx, name, newName)
 }
 
-// Upsert mocks base method.
-func (m *MockTeamRepo) Upsert(ctx context.Context, team entity0.Team, matcher interfaces.TeamMatcher) error {
+// Replace mocks base method.
+func (m *MockTeamRepo) Replace(ctx context.Context, name string, team entity1.Team) (entity1.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", ctx, name, team)
+	ret0, _ := ret[0].(entity1.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockTeamRepoMockRecorder) Replace(ctx, name, team interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockTeamRepo)(nil).Replace), ctx, name, team)
+}
+
+// SetArchived mocks base method.
+func (m *MockTeamRepo) SetArchived(ctx context.Context, name string, archived bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetArchived", ctx, name, archived)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetArchived indicates an expected call of SetArchived.
+func (mr *MockTeamRepoMockRecorder) SetArchived(ctx, name, archived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArchived", reflect.TypeOf((*MockTeamRepo)(nil).SetArchived), ctx, name, archived)
+}
+
+// SetCodeowners mocks base method.
+func (m *MockTeamRepo) SetCodeowners(ctx context.Context, name string, ruleset entity.Ruleset) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Upsert", ctx, team, matcher)
+	ret := m.ctrl.Call(m, "SetCodeowners", ctx, name, ruleset)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// SetCodeowners indicates an expected call of SetCodeowners.
+func (mr *MockTeamRepoMockRecorder) SetCodeowners(ctx, name, ruleset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCodeowners", reflect.TypeOf((*MockTeamRepo)(nil).SetCodeowners), ctx, name, ruleset)
+}
+
+// Upsert mocks base method.
+func (m *MockTeamRepo) Upsert(ctx context.Context, team entity1.Team, matcher interfaces0.TeamMatcher, dryRun bool) (entity1.UpsertDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, team, matcher, dryRun)
+	ret0, _ := ret[0].(entity1.UpsertDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Upsert indicates an expected call of Upsert.
-func (mr *MockTeamRepoMockRecorder) Upsert(ctx, team, matcher interface{}) *gomock.Call {
+func (mr *MockTeamRepoMockRecorder) Upsert(ctx, team, matcher, dryRun interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockTeamRepo)(nil).Upsert), ctx, team, matcher)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockTeamRepo)(nil).Upsert), ctx, team, matcher, dryRun)
 }
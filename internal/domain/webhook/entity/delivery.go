@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"time"
+
+	eventEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/event/entity"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "PENDING"
+	DeliveryDelivered DeliveryStatus = "DELIVERED"
+	// attempts are exhausted, delivery waits for manual replay
+	DeliveryDead DeliveryStatus = "DEAD"
+)
+
+func (s DeliveryStatus) IsValid() bool {
+	return s == DeliveryPending || s == DeliveryDelivered || s == DeliveryDead
+}
+
+// delivery of event to webhook, it is identified by the pair of their ids
+type Delivery struct {
+	Event         eventEntity.Event
+	WebhookId     string
+	Url           string
+	Secret        string
+	Status        DeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	DeliveredAt   time.Time
+}
+
+func (d Delivery) Delivered(now time.Time) Delivery {
+	d.Attempts++
+	d.Status = DeliveryDelivered
+	d.LastError = ""
+	d.DeliveredAt = now
+
+	return d
+}
+
+// schedules next attempt by policy or moves delivery to dead letters, when attempts are exhausted
+func (d Delivery) Failed(reason string, policy RetryPolicy, now time.Time) Delivery {
+	d.Attempts++
+	d.LastError = reason
+
+	if d.Attempts >= policy.MaxAttempts {
+		d.Status = DeliveryDead
+		return d
+	}
+
+	d.Status = DeliveryPending
+	d.NextAttemptAt = now.Add(policy.Backoff(d.Attempts))
+
+	return d
+}
+
+// request, which delivers event to webhook
+type WebhookRequest struct {
+	Url     string
+	Headers map[string]string
+	Body    []byte
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// events to deliver to webhook again, at least one criterion is required
+type ReplayFilter struct {
+	EventIds []string
+	// events created since the time
+	Since *time.Time
+	// only events, whose delivery is dead
+	DeadOnly bool
+}
+
+func (f ReplayFilter) IsEmpty() bool {
+	return len(f.EventIds) == 0 && f.Since == nil && !f.DeadOnly
+}
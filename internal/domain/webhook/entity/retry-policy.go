@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// exponential backoff: base delay doubles after every failed attempt up to max delay
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// delay before next attempt after given number of failed attempts
+func (p RetryPolicy) Backoff(failedAttempts int) time.Duration {
+	delay := p.BaseDelay
+
+	for i := 1; i < failedAttempts; i++ {
+		delay *= 2
+
+		if delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+
+	return min(delay, p.MaxDelay)
+}
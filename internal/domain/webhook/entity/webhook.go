@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"net/url"
+	"slices"
+	"time"
+
+	eventEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/event/entity"
+	"github.com/google/uuid"
+)
+
+type Webhook struct {
+	Id  string
+	Url string
+	// key of HMAC signature of deliveries
+	Secret string
+	// empty list subscribes webhook to every event type
+	EventTypes []eventEntity.EventType
+	CreatedAt  time.Time
+}
+
+func NewWebhook(url, secret string, eventTypes []eventEntity.EventType) Webhook {
+	if eventTypes == nil {
+		eventTypes = []eventEntity.EventType{}
+	}
+
+	return Webhook{
+		Id:         uuid.NewString(),
+		Url:        url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		CreatedAt:  time.Now(),
+	}
+}
+
+func (w Webhook) IsValid() bool {
+	parsed, err := url.Parse(w.Url)
+
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return false
+	}
+
+	if w.Secret == "" {
+		return false
+	}
+
+	for _, eventType := range w.EventTypes {
+		if !eventType.IsValid() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (w Webhook) IsSubscribed(eventType eventEntity.EventType) bool {
+	return len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, eventType)
+}
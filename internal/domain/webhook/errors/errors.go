@@ -0,0 +1,10 @@
+package errors
+
+import "errors"
+
+var (
+	ErrWebhookNotFound = errors.New("webhook not found")
+	ErrInvalidWebhook  = errors.New("invalid webhook")
+	ErrInvalidReplay   = errors.New("replay filter is empty")
+	ErrInvalidStatus   = errors.New("invalid delivery status")
+)
@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+)
+
+type WebhookRepo interface {
+	Register(ctx context.Context, webhook entity.Webhook) error
+	List(ctx context.Context) ([]entity.Webhook, error)
+	// deliveries of webhook are removed with it
+	Delete(ctx context.Context, webhookId string) error
+	// claims up to limit PENDING deliveries due at now, claimed ones are hidden
+	// from other dispatchers until lease ends, so crashed dispatcher does not lose them
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entity.Delivery, error)
+	// stores status, attempts and schedule of delivery after attempt
+	SaveAttempt(ctx context.Context, delivery entity.Delivery) error
+	GetDeliveries(
+		ctx context.Context,
+		webhookId string,
+		status entity.DeliveryStatus,
+		limit int,
+	) ([]entity.Delivery, error)
+	// queues matched events for webhook again, returns number of queued deliveries
+	Replay(ctx context.Context, webhookId string, filter entity.ReplayFilter) (int, error)
+}
@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+)
+
+type WebhookSender interface {
+	// error is returned for transport failures and non 2xx responses
+	Send(ctx context.Context, request entity.WebhookRequest) error
+}
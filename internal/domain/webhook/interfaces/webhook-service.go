@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	eventEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/event/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+)
+
+type WebhookService interface {
+	// secret is generated, when it is empty
+	Register(ctx context.Context, url, secret string, eventTypes []eventEntity.EventType) (entity.Webhook, error)
+	List(ctx context.Context) ([]entity.Webhook, error)
+	Delete(ctx context.Context, webhookId string) error
+	GetDeliveries(ctx context.Context, webhookId string, status entity.DeliveryStatus) ([]entity.Delivery, error)
+	Replay(ctx context.Context, webhookId string, filter entity.ReplayFilter) (int, error)
+}
@@ -0,0 +1,167 @@
+package pgbroker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/clients/postgres"
+	reviewstreampg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/review-stream"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog"
+)
+
+const (
+	minReconnectInterval = time.Second
+	maxReconnectInterval = time.Minute
+	// idle connection is checked, so broken one is reconnected
+	pingInterval = 90 * time.Second
+)
+
+// ReviewStreamBrokerPg listens for review stream notifications, so subscribers learn about events
+// stored by any replica of service. One connection is shared by all subscribers of replica
+type ReviewStreamBrokerPg struct {
+	listener *pq.Listener
+	logger   zerolog.Logger
+
+	mu          sync.Mutex
+	subscribers map[string]map[chan struct{}]struct{}
+	stopped     bool
+}
+
+func CreateReviewStreamBrokerPg(cfg *config.PostgresConfig, log zerolog.Logger) (*ReviewStreamBrokerPg, error) {
+	logger := log.With().Str("job", "review-stream-broker").Logger()
+
+	listener := pq.NewListener(
+		postgres.DataSource(cfg),
+		minReconnectInterval,
+		maxReconnectInterval,
+		func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				logger.Error().Err(err).Msg("review stream listener connection problem")
+			}
+		},
+	)
+
+	if err := listener.Listen(reviewstreampg.NotifyChannel); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("failed to listen review stream channel: %w", err)
+	}
+
+	return &ReviewStreamBrokerPg{
+		listener:    listener,
+		logger:      logger,
+		subscribers: make(map[string]map[chan struct{}]struct{}),
+	}, nil
+}
+
+func (b *ReviewStreamBrokerPg) Subscribe(reviewerId string) (<-chan struct{}, func()) {
+	// buffer of one signal is enough, subscriber reads all new events on wake up
+	signal := make(chan struct{}, 1)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.stopped {
+		close(signal)
+		return signal, func() {}
+	}
+
+	if b.subscribers[reviewerId] == nil {
+		b.subscribers[reviewerId] = make(map[chan struct{}]struct{})
+	}
+
+	b.subscribers[reviewerId][signal] = struct{}{}
+
+	unsubscribe := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.subscribers[reviewerId], signal)
+
+		if len(b.subscribers[reviewerId]) == 0 {
+			delete(b.subscribers, reviewerId)
+		}
+	}
+
+	return signal, unsubscribe
+}
+
+// Run dispatches notifications to subscribers until ctx is done, then closes their channels
+func (b *ReviewStreamBrokerPg) Run(ctx context.Context) {
+	defer b.stop()
+
+	defer func() {
+		if err := b.listener.Close(); err != nil {
+			b.logger.Error().Err(err).Msg("failed to close review stream listener")
+		}
+	}()
+
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			if err := b.listener.Ping(); err != nil {
+				b.logger.Error().Err(err).Msg("failed to ping review stream listener")
+			}
+
+		case notification := <-b.listener.Notify:
+			// nil is sent after reconnect, notifications could be lost meanwhile
+			if notification == nil {
+				b.wakeAll()
+				continue
+			}
+
+			b.wake(notification.Extra)
+		}
+	}
+}
+
+func (b *ReviewStreamBrokerPg) wake(reviewerId string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for signal := range b.subscribers[reviewerId] {
+		notify(signal)
+	}
+}
+
+func (b *ReviewStreamBrokerPg) wakeAll() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, signals := range b.subscribers {
+		for signal := range signals {
+			notify(signal)
+		}
+	}
+}
+
+func (b *ReviewStreamBrokerPg) stop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.stopped = true
+
+	for reviewerId, signals := range b.subscribers {
+		for signal := range signals {
+			close(signal)
+		}
+
+		delete(b.subscribers, reviewerId)
+	}
+}
+
+func notify(signal chan struct{}) {
+	select {
+	case signal <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,75 @@
+package codehostclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// response body is quoted in errors up to the limit
+const maxErrorBodyBytes = 512
+
+type apiClient struct {
+	client  *http.Client
+	baseUrl string
+	headers map[string]string
+}
+
+// sends json request to api and decodes response to out, when it is not nil
+func (c *apiClient) do(ctx context.Context, method, path string, body, out any) error {
+	var reader io.Reader
+
+	if body != nil {
+		raw, err := json.Marshal(body)
+
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+
+		reader = bytes.NewReader(raw)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.baseUrl, "/")+path, reader)
+
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	for header, value := range c.headers {
+		req.Header.Set(header, value)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+
+	if err != nil {
+		return fmt.Errorf("failed to send %s %s: %w", method, path, err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+
+		return fmt.Errorf("unexpected status %d of %s %s: %s", resp.StatusCode, method, path, string(msg))
+	}
+
+	if out == nil {
+		// body is drained, so connection is reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response of %s %s: %w", method, path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package codehostclient_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/entity"
+	codehostclient "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/clients/code-host"
+	"github.com/stretchr/testify/assert"
+)
+
+// request received by code host stand-in
+type recordedRequest struct {
+	Method string
+	Path   string
+	Auth   string
+	Body   string
+}
+
+// code host stand-in, which records requests and replies by method and path
+func standIn(t *testing.T, authHeader string, replies map[string]string, status int) (*httptest.Server, *[]recordedRequest) {
+	var requests []recordedRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		requests = append(requests, recordedRequest{
+			Method: r.Method,
+			Path:   r.URL.RequestURI(),
+			Auth:   r.Header.Get(authHeader),
+			Body:   string(body),
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+
+		if reply, ok := replies[r.Method+" "+r.URL.RequestURI()]; ok {
+			_, _ = w.Write([]byte(reply))
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestGithubPublisher(t *testing.T) {
+	type testCase struct {
+		what string
+
+		request          entity.ReviewerRequest
+		status           int
+		expectedRequests []recordedRequest
+		expectedError    string
+	}
+
+	const path = "/repos/acme/pr-service/pulls/42/requested_reviewers"
+
+	testCases := []testCase{
+		{
+			what: "reassignment withdraws old reviewer first",
+
+			request: entity.ReviewerRequest{
+				Repository: "acme/pr-service",
+				Number:     42,
+				Added:      []string{"hubot"},
+				Removed:    []string{"octocat"},
+			},
+			status: http.StatusOK,
+			expectedRequests: []recordedRequest{
+				{Method: "DELETE", Path: path, Auth: "Bearer gh-token", Body: `{"reviewers":["octocat"]}`},
+				{Method: "POST", Path: path, Auth: "Bearer gh-token", Body: `{"reviewers":["hubot"]}`},
+			},
+		},
+
+		{
+			what: "only added reviewers are requested",
+
+			request: entity.ReviewerRequest{
+				Repository: "acme/pr-service",
+				Number:     42,
+				Added:      []string{"octocat", "hubot"},
+			},
+			status: http.StatusCreated,
+			expectedRequests: []recordedRequest{
+				{Method: "POST", Path: path, Auth: "Bearer gh-token", Body: `{"reviewers":["octocat","hubot"]}`},
+			},
+		},
+
+		{
+			what: "host is unavailable",
+
+			request: entity.ReviewerRequest{
+				Repository: "acme/pr-service",
+				Number:     42,
+				Added:      []string{"hubot"},
+			},
+			status: http.StatusBadGateway,
+			expectedRequests: []recordedRequest{
+				{Method: "POST", Path: path, Auth: "Bearer gh-token", Body: `{"reviewers":["hubot"]}`},
+			},
+			expectedError: "failed to request reviewers: unexpected status 502 of POST " + path + ": ",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			server, requests := standIn(t, "Authorization", nil, tc.status)
+
+			publisher := codehostclient.CreateGithubPublisher(server.URL, "gh-token", time.Second)
+
+			err := publisher.Publish(context.Background(), tc.request)
+
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedError)
+			}
+
+			assert.Equal(t, tc.expectedRequests, *requests)
+		})
+	}
+}
+
+func TestGitlabPublisher(t *testing.T) {
+	type testCase struct {
+		what string
+
+		request          entity.ReviewerRequest
+		replies          map[string]string
+		expectedRequests []recordedRequest
+		expectedError    string
+	}
+
+	const path = "/projects/1/merge_requests/17"
+
+	testCases := []testCase{
+		{
+			what: "reviewers list is replaced with changed one",
+
+			request: entity.ReviewerRequest{
+				Repository: "1",
+				Number:     17,
+				Added:      []string{"jane"},
+				Removed:    []string{"root"},
+			},
+			replies: map[string]string{
+				"GET " + path:              `{"iid":17,"reviewers":[{"id":1,"username":"root"},{"id":5,"username":"alex"}]}`,
+				"GET /users?username=jane": `[{"id":2,"username":"jane"}]`,
+			},
+			expectedRequests: []recordedRequest{
+				{Method: "GET", Path: path, Auth: "gl-token"},
+				{Method: "GET", Path: "/users?username=jane", Auth: "gl-token"},
+				{Method: "PUT", Path: path, Auth: "gl-token", Body: `{"reviewer_ids":[5,2]}`},
+			},
+		},
+
+		{
+			what: "unknown user is not requested",
+
+			request: entity.ReviewerRequest{
+				Repository: "1",
+				Number:     17,
+				Added:      []string{"ghost"},
+			},
+			replies: map[string]string{
+				"GET " + path:               `{"iid":17,"reviewers":[]}`,
+				"GET /users?username=ghost": `[]`,
+			},
+			expectedRequests: []recordedRequest{
+				{Method: "GET", Path: path, Auth: "gl-token"},
+				{Method: "GET", Path: "/users?username=ghost", Auth: "gl-token"},
+			},
+			expectedError: "user ghost is not found",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			server, requests := standIn(t, "PRIVATE-TOKEN", tc.replies, http.StatusOK)
+
+			publisher := codehostclient.CreateGitlabPublisher(server.URL, "gl-token", time.Second)
+
+			err := publisher.Publish(context.Background(), tc.request)
+
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedError)
+			}
+
+			assert.Equal(t, tc.expectedRequests, *requests)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package codehostclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/interfaces"
+)
+
+type GithubPublisher struct {
+	api apiClient
+}
+
+// CreateGithubPublisher creates publisher for GitHub REST api at apiUrl, token needs pull requests write permission
+func CreateGithubPublisher(apiUrl, token string, timeout time.Duration) interfaces.ReviewerPublisher {
+	return &GithubPublisher{
+		api: apiClient{
+			client:  &http.Client{Timeout: timeout},
+			baseUrl: apiUrl,
+			headers: map[string]string{
+				"Accept":               "application/vnd.github+json",
+				"Authorization":        "Bearer " + token,
+				"X-GitHub-Api-Version": "2022-11-28",
+			},
+		},
+	}
+}
+
+type githubReviewersBody struct {
+	Reviewers []string `json:"reviewers"`
+}
+
+// removed reviewers are withdrawn first, so reassignment does not exceed reviewers limit of pr
+func (p *GithubPublisher) Publish(ctx context.Context, request entity.ReviewerRequest) error {
+	path := fmt.Sprintf("/repos/%s/pulls/%d/requested_reviewers", request.Repository, request.Number)
+
+	if len(request.Removed) > 0 {
+		if err := p.api.do(ctx, http.MethodDelete, path, githubReviewersBody{Reviewers: request.Removed}, nil); err != nil {
+			return fmt.Errorf("failed to remove requested reviewers: %w", err)
+		}
+	}
+
+	if len(request.Added) > 0 {
+		if err := p.api.do(ctx, http.MethodPost, path, githubReviewersBody{Reviewers: request.Added}, nil); err != nil {
+			return fmt.Errorf("failed to request reviewers: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package codehostclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/interfaces"
+)
+
+type GitlabPublisher struct {
+	api apiClient
+}
+
+// CreateGitlabPublisher creates publisher for GitLab REST api v4 at apiUrl, token needs api scope
+func CreateGitlabPublisher(apiUrl, token string, timeout time.Duration) interfaces.ReviewerPublisher {
+	return &GitlabPublisher{
+		api: apiClient{
+			client:  &http.Client{Timeout: timeout},
+			baseUrl: apiUrl,
+			headers: map[string]string{
+				"PRIVATE-TOKEN": token,
+			},
+		},
+	}
+}
+
+type gitlabUser struct {
+	Id       int64  `json:"id"`
+	Username string `json:"username"`
+}
+
+type gitlabMergeRequest struct {
+	Reviewers []gitlabUser `json:"reviewers"`
+}
+
+type gitlabReviewersBody struct {
+	ReviewerIds []int64 `json:"reviewer_ids"`
+}
+
+// GitLab replaces the whole reviewers list, so the current one is read and changed by request
+func (p *GitlabPublisher) Publish(ctx context.Context, request entity.ReviewerRequest) error {
+	path := fmt.Sprintf("/projects/%s/merge_requests/%d", url.PathEscape(request.Repository), request.Number)
+
+	var mr gitlabMergeRequest
+
+	if err := p.api.do(ctx, http.MethodGet, path, nil, &mr); err != nil {
+		return fmt.Errorf("failed to get merge request: %w", err)
+	}
+
+	reviewerIds := make([]int64, 0, len(mr.Reviewers)+len(request.Added))
+
+	for _, reviewer := range mr.Reviewers {
+		if !slices.Contains(request.Removed, reviewer.Username) {
+			reviewerIds = append(reviewerIds, reviewer.Id)
+		}
+	}
+
+	for _, username := range request.Added {
+		userId, err := p.userId(ctx, username)
+
+		if err != nil {
+			return err
+		}
+
+		if !slices.Contains(reviewerIds, userId) {
+			reviewerIds = append(reviewerIds, userId)
+		}
+	}
+
+	if err := p.api.do(ctx, http.MethodPut, path, gitlabReviewersBody{ReviewerIds: reviewerIds}, nil); err != nil {
+		return fmt.Errorf("failed to update merge request reviewers: %w", err)
+	}
+
+	return nil
+}
+
+func (p *GitlabPublisher) userId(ctx context.Context, username string) (int64, error) {
+	var users []gitlabUser
+
+	if err := p.api.do(ctx, http.MethodGet, "/users?username="+url.QueryEscape(username), nil, &users); err != nil {
+		return 0, fmt.Errorf("failed to find user %s: %w", username, err)
+	}
+
+	if len(users) == 0 {
+		return 0, fmt.Errorf("user %s is not found", username)
+	}
+
+	return users[0].Id, nil
+}
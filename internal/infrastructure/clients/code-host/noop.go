@@ -0,0 +1,19 @@
+package codehostclient
+
+import (
+	"context"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/interfaces"
+)
+
+// NoopPublisher is used for hostings without api token, publications are completed without calls
+type NoopPublisher struct{}
+
+func CreateNoopPublisher() interfaces.ReviewerPublisher {
+	return NoopPublisher{}
+}
+
+func (NoopPublisher) Publish(ctx context.Context, request entity.ReviewerRequest) error {
+	return nil
+}
@@ -8,8 +8,8 @@ import (
 	_ "github.com/lib/pq"
 )
 
-func CreateConnection(cfg *config.PostgresConfig) (*sqlx.DB, error) {
-	ds := fmt.Sprintf("user=%s password=%s dbname=%s host=%s port=%d sslmode=%s",
+func DataSource(cfg *config.PostgresConfig) string {
+	return fmt.Sprintf("user=%s password=%s dbname=%s host=%s port=%d sslmode=%s",
 		cfg.User,
 		cfg.Password,
 		cfg.Database,
@@ -17,8 +17,10 @@ func CreateConnection(cfg *config.PostgresConfig) (*sqlx.DB, error) {
 		cfg.Port,
 		"disable",
 	)
+}
 
-	db, err := sqlx.Connect("postgres", ds)
+func CreateConnection(cfg *config.PostgresConfig) (*sqlx.DB, error) {
+	db, err := sqlx.Connect("postgres", DataSource(cfg))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed connection to db: %w", err)
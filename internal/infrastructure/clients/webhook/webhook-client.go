@@ -0,0 +1,52 @@
+package webhookclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/interfaces"
+)
+
+type WebhookClient struct {
+	client *http.Client
+}
+
+func CreateWebhookClient(timeout time.Duration) interfaces.WebhookSender {
+	return &WebhookClient{
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (c *WebhookClient) Send(ctx context.Context, request entity.WebhookRequest) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.Url, bytes.NewReader(request.Body))
+
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+
+	for header, value := range request.Headers {
+		req.Header.Set(header, value)
+	}
+
+	resp, err := c.client.Do(req)
+
+	if err != nil {
+		return fmt.Errorf("failed to send webhook request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	// body is drained, so connection is reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
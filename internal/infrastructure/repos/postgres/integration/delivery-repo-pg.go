@@ -0,0 +1,54 @@
+package integrationrepopg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/interfaces"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+)
+
+type DeliveryRepoPg struct {
+	db     *sqlx.DB
+	logger zerolog.Logger
+}
+
+func CreateDeliveryRepoPg(db *sqlx.DB, log zerolog.Logger) interfaces.DeliveryRepo {
+	return &DeliveryRepoPg{
+		db:     db,
+		logger: log,
+	}
+}
+
+func (r *DeliveryRepoPg) Claim(ctx context.Context, source, deliveryId string) (bool, error) {
+	query := `
+	INSERT INTO ingested_delivery(source, delivery_id)
+	VALUES ($1, $2)
+	ON CONFLICT (source, delivery_id) DO NOTHING
+	`
+
+	res, err := r.db.ExecContext(ctx, query, source, deliveryId)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to insert delivery: %w", err)
+	}
+
+	inserted, err := res.RowsAffected()
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get inserted deliveries count: %w", err)
+	}
+
+	return inserted > 0, nil
+}
+
+func (r *DeliveryRepoPg) Release(ctx context.Context, source, deliveryId string) error {
+	query := "DELETE FROM ingested_delivery WHERE source = $1 AND delivery_id = $2"
+
+	if _, err := r.db.ExecContext(ctx, query, source, deliveryId); err != nil {
+		return fmt.Errorf("failed to delete delivery: %w", err)
+	}
+
+	return nil
+}
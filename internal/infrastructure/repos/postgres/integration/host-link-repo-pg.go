@@ -0,0 +1,96 @@
+package integrationrepopg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/interfaces"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+)
+
+type HostLinkRepoPg struct {
+	db     *sqlx.DB
+	logger zerolog.Logger
+}
+
+func CreateHostLinkRepoPg(db *sqlx.DB, log zerolog.Logger) interfaces.HostLinkRepo {
+	return &HostLinkRepoPg{
+		db:     db,
+		logger: log,
+	}
+}
+
+func (r *HostLinkRepoPg) Save(ctx context.Context, link entity.HostLink) error {
+	tx, err := r.db.Beginx()
+
+	if err != nil {
+		return fmt.Errorf("failed to begin tx while save host link: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				r.logger.Error().Err(err).Msg("failed to rollback transaction")
+			}
+		}
+	}()
+
+	// stored link is never overwritten, so pr is not pointed to other hosting pr
+	query := `
+	INSERT INTO pr_host_link(pr_id, source, repository, number)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (pr_id) DO NOTHING
+	`
+
+	res, err := tx.ExecContext(
+		ctx,
+		query,
+		link.PullRequestId,
+		link.Source,
+		link.Repository,
+		link.Number,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to save host link: %w", err)
+	}
+
+	inserted, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows while save host link: %w", err)
+	}
+
+	if inserted == 0 {
+		// reviewers of linked pr are already enqueued
+		if err = tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit tx while save host link: %w", err)
+		}
+
+		return nil
+	}
+
+	// reviewers were assigned before pr had link, so their publication was not enqueued with creation
+	query = `
+	INSERT INTO reviewer_publication(pr_id, added)
+	SELECT $1, ARRAY(
+		SELECT member_id
+		FROM assigned_reviewer
+		WHERE pr_id = $1
+		ORDER BY member_id
+	)
+	WHERE EXISTS (SELECT 1 FROM assigned_reviewer WHERE pr_id = $1)
+	`
+
+	if _, err = tx.ExecContext(ctx, query, link.PullRequestId); err != nil {
+		return fmt.Errorf("failed to enqueue publication of linked pr reviewers: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx while save host link: %w", err)
+	}
+
+	return nil
+}
@@ -1,12 +1,18 @@
 package dto
 
-import "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+import (
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	"github.com/lib/pq"
+)
 
 type MemberDTO struct {
-	Id       string  `db:"id"`
-	Activity string  `db:"activity"`
-	Name     string  `db:"username"`
-	TeamName *string `db:"team_name"`
+	Id             string  `db:"id"`
+	Activity       string  `db:"activity"`
+	Name           string  `db:"username"`
+	TeamName       *string `db:"team_name"`
+	MaxOpenReviews *int    `db:"max_open_reviews"`
+	// names of all teams of member
+	Teams pq.StringArray `db:"teams"`
 }
 
 func (m MemberDTO) ToMemberEntity() entity.Member {
@@ -16,9 +22,11 @@ func (m MemberDTO) ToMemberEntity() entity.Member {
 	}
 
 	return entity.Member{
-		Id:       m.Id,
-		Activity: entity.MemberActivity(m.Activity),
-		Username: m.Name,
-		TeamName: teamName,
+		Id:             m.Id,
+		Activity:       entity.MemberActivity(m.Activity),
+		Username:       m.Name,
+		TeamName:       teamName,
+		MaxOpenReviews: m.MaxOpenReviews,
+		Teams:          m.Teams,
 	}
 }
@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+)
+
+type UnavailabilityDTO struct {
+	Id       string    `db:"id"`
+	MemberId string    `db:"member_id"`
+	Kind     string    `db:"kind"`
+	StartsAt time.Time `db:"starts_at"`
+	EndsAt   time.Time `db:"ends_at"`
+}
+
+func (u UnavailabilityDTO) ToUnavailabilityEntity() entity.Unavailability {
+	return entity.Unavailability{
+		Id:       u.Id,
+		MemberId: u.MemberId,
+		Kind:     entity.UnavailabilityKind(u.Kind),
+		StartsAt: u.StartsAt,
+		EndsAt:   u.EndsAt,
+	}
+}
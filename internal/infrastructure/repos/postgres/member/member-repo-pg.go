@@ -6,14 +6,22 @@ import (
 	"errors"
 	"fmt"
 
+	eventEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/event/entity"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
 	memberErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/errors"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/interfaces"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/member/dto"
+	outboxpg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/outbox"
+	reviewspg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/reviews"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/rs/zerolog"
 )
 
+const foreignKeyViolation = "23503"
+
 type MemberRepoPg struct {
 	db     *sqlx.DB
 	logger zerolog.Logger
@@ -30,11 +38,15 @@ func (r *MemberRepoPg) SetActivity(
 	ctx context.Context,
 	userId string,
 	activity memberEntity.MemberActivity,
-) (memberEntity.Member, error) {
+	replace prInterfaces.ReplaceHandler,
+) (memberEntity.Member, prEntity.ReassignReport, error) {
 	tx, err := r.db.Beginx()
 
 	if err != nil {
-		return memberEntity.Member{}, fmt.Errorf("failed to begin tx while set activity to postgres: %w", err)
+		return memberEntity.Member{}, prEntity.ReassignReport{}, fmt.Errorf(
+			"failed to begin tx while set activity to postgres: %w",
+			err,
+		)
 	}
 
 	defer func() {
@@ -48,7 +60,20 @@ func (r *MemberRepoPg) SetActivity(
 	var member dto.MemberDTO
 
 	query := `
-	SELECT m.id, m.username, m.activity, t.team_name
+	SELECT
+		m.id,
+		m.username,
+		m.activity,
+		m.max_open_reviews,
+		t.team_name,
+		ARRAY(
+			SELECT mt.team_name
+			FROM team_membership AS tm
+			INNER JOIN team AS mt
+				ON mt.id = tm.team_id
+			WHERE tm.member_id = m.id
+			ORDER BY mt.team_name
+		) AS teams
 	FROM team_member AS m
 	LEFT JOIN team AS t
 		ON m.team_id = t.id
@@ -57,26 +82,356 @@ func (r *MemberRepoPg) SetActivity(
 
 	if err = tx.GetContext(ctx, &member, query, userId); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return memberEntity.Member{}, memberErrors.ErrMemberNotFound
+			err = memberErrors.ErrMemberNotFound
+			return memberEntity.Member{}, prEntity.ReassignReport{}, err
 		}
 
-		return memberEntity.Member{}, fmt.Errorf("failed to get member in postgres: %w", err)
+		return memberEntity.Member{}, prEntity.ReassignReport{}, fmt.Errorf("failed to get member in postgres: %w", err)
 	}
 
 	query = "UPDATE team_member SET activity = $1 WHERE id = $2"
 	_, err = tx.ExecContext(ctx, query, string(activity), userId)
 
 	if err != nil {
-		return memberEntity.Member{}, fmt.Errorf("failed to update member activity in postgres: %w", err)
+		return memberEntity.Member{}, prEntity.ReassignReport{}, fmt.Errorf(
+			"failed to update member activity in postgres: %w",
+			err,
+		)
+	}
+
+	report := prEntity.ReassignReport{
+		Reassigned:    []prEntity.Reassignment{},
+		NotReassigned: []string{},
+	}
+
+	if replace != nil {
+		report, err = reviewspg.ReassignOpenReviews(ctx, tx, []string{userId}, replace)
+
+		if err != nil {
+			return memberEntity.Member{}, prEntity.ReassignReport{}, err
+		}
+	}
+
+	deactivated := memberEntity.MemberActivity(member.Activity) == memberEntity.MemberActive &&
+		activity == memberEntity.MemberInactive
+
+	if deactivated {
+		if err = outboxpg.Write(ctx, tx, eventEntity.NewMemberDeactivatedEvent(userId)); err != nil {
+			return memberEntity.Member{}, prEntity.ReassignReport{}, fmt.Errorf(
+				"failed to write event while set activity: %w",
+				err,
+			)
+		}
 	}
 
 	if err = tx.Commit(); err != nil {
-		return memberEntity.Member{}, fmt.Errorf("failed to commit tx while set activity postgres: %w", err)
+		return memberEntity.Member{}, prEntity.ReassignReport{}, fmt.Errorf(
+			"failed to commit tx while set activity postgres: %w",
+			err,
+		)
 	}
 
 	res := member.ToMemberEntity()
 
 	res.Activity = activity
 
+	return res, report, nil
+}
+
+func (r *MemberRepoPg) SetMaxOpenReviews(
+	ctx context.Context,
+	userId string,
+	maxOpenReviews *int,
+) (memberEntity.Member, error) {
+	query := `
+	WITH updated AS (
+		UPDATE team_member SET max_open_reviews = $1 WHERE id = $2
+		RETURNING id, username, activity, max_open_reviews, team_id
+	)
+	SELECT
+		u.id,
+		u.username,
+		u.activity,
+		u.max_open_reviews,
+		t.team_name,
+		ARRAY(
+			SELECT mt.team_name
+			FROM team_membership AS tm
+			INNER JOIN team AS mt
+				ON mt.id = tm.team_id
+			WHERE tm.member_id = u.id
+			ORDER BY mt.team_name
+		) AS teams
+	FROM updated AS u
+	LEFT JOIN team AS t
+		ON u.team_id = t.id
+	`
+
+	var member dto.MemberDTO
+
+	if err := r.db.GetContext(ctx, &member, query, maxOpenReviews, userId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return memberEntity.Member{}, memberErrors.ErrMemberNotFound
+		}
+
+		return memberEntity.Member{}, fmt.Errorf("failed to update member max open reviews in postgres: %w", err)
+	}
+
+	return member.ToMemberEntity(), nil
+}
+
+func (r *MemberRepoPg) AddUnavailability(
+	ctx context.Context,
+	unavailability memberEntity.Unavailability,
+) (memberEntity.Unavailability, error) {
+	query := `
+	INSERT INTO member_unavailability(id, member_id, kind, starts_at, ends_at)
+	VALUES ($1, $2, $3, $4, $5)
+	`
+
+	if _, err := r.db.ExecContext(
+		ctx,
+		query,
+		unavailability.Id,
+		unavailability.MemberId,
+		string(unavailability.Kind),
+		unavailability.StartsAt,
+		unavailability.EndsAt,
+	); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == foreignKeyViolation {
+				return memberEntity.Unavailability{}, memberErrors.ErrMemberNotFound
+			}
+		}
+
+		return memberEntity.Unavailability{}, fmt.Errorf("failed to add unavailability to postgres: %w", err)
+	}
+
+	return unavailability, nil
+}
+
+func (r *MemberRepoPg) GetUnavailability(ctx context.Context, userId string) ([]memberEntity.Unavailability, error) {
+	var exists bool
+
+	query := "SELECT EXISTS (SELECT 1 FROM team_member WHERE id = $1)"
+
+	if err := r.db.GetContext(ctx, &exists, query, userId); err != nil {
+		return []memberEntity.Unavailability{}, fmt.Errorf("failed to check member in postgres: %w", err)
+	}
+
+	if !exists {
+		return []memberEntity.Unavailability{}, memberErrors.ErrMemberNotFound
+	}
+
+	query = `
+	SELECT id, member_id, kind, starts_at, ends_at
+	FROM member_unavailability
+	WHERE member_id = $1
+	ORDER BY starts_at
+	`
+
+	var unavailability []dto.UnavailabilityDTO
+
+	if err := r.db.SelectContext(ctx, &unavailability, query, userId); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return []memberEntity.Unavailability{}, fmt.Errorf("failed to select unavailability from postgres: %w", err)
+		}
+	}
+
+	res := make([]memberEntity.Unavailability, 0, len(unavailability))
+
+	for _, u := range unavailability {
+		res = append(res, u.ToUnavailabilityEntity())
+	}
+
+	return res, nil
+}
+
+func (r *MemberRepoPg) UpdateUnavailability(
+	ctx context.Context,
+	unavailability memberEntity.Unavailability,
+) (memberEntity.Unavailability, error) {
+	// range may be moved, so its start has to be processed by background job again
+	query := `
+	UPDATE member_unavailability
+	SET kind = $1, starts_at = $2, ends_at = $3, reviews_reassigned = FALSE
+	WHERE id = $4
+	RETURNING id, member_id, kind, starts_at, ends_at
+	`
+
+	var updated dto.UnavailabilityDTO
+
+	if err := r.db.GetContext(
+		ctx,
+		&updated,
+		query,
+		string(unavailability.Kind),
+		unavailability.StartsAt,
+		unavailability.EndsAt,
+		unavailability.Id,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return memberEntity.Unavailability{}, memberErrors.ErrUnavailabilityNotFound
+		}
+
+		return memberEntity.Unavailability{}, fmt.Errorf("failed to update unavailability in postgres: %w", err)
+	}
+
+	return updated.ToUnavailabilityEntity(), nil
+}
+
+func (r *MemberRepoPg) DeleteUnavailability(ctx context.Context, unavailabilityId string) error {
+	query := "DELETE FROM member_unavailability WHERE id = $1"
+
+	res, err := r.db.ExecContext(ctx, query, unavailabilityId)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete unavailability from postgres: %w", err)
+	}
+
+	deleted, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("failed to get deleted unavailability count: %w", err)
+	}
+
+	if deleted == 0 {
+		return memberErrors.ErrUnavailabilityNotFound
+	}
+
+	return nil
+}
+
+func (r *MemberRepoPg) GetStartedUnavailability(ctx context.Context) ([]memberEntity.Unavailability, error) {
+	query := `
+	SELECT id, member_id, kind, starts_at, ends_at
+	FROM member_unavailability
+	WHERE starts_at <= NOW() AND NOW() < ends_at AND NOT reviews_reassigned
+	ORDER BY starts_at
+	`
+
+	var unavailability []dto.UnavailabilityDTO
+
+	if err := r.db.SelectContext(ctx, &unavailability, query); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return []memberEntity.Unavailability{}, fmt.Errorf("failed to select started unavailability: %w", err)
+		}
+	}
+
+	res := make([]memberEntity.Unavailability, 0, len(unavailability))
+
+	for _, u := range unavailability {
+		res = append(res, u.ToUnavailabilityEntity())
+	}
+
 	return res, nil
 }
+
+func (r *MemberRepoPg) MarkReviewsReassigned(ctx context.Context, unavailabilityId string) error {
+	query := "UPDATE member_unavailability SET reviews_reassigned = TRUE WHERE id = $1"
+
+	if _, err := r.db.ExecContext(ctx, query, unavailabilityId); err != nil {
+		return fmt.Errorf("failed to mark reviews of unavailability as reassigned: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MemberRepoPg) GetSkills(ctx context.Context, userId string) ([]string, error) {
+	return r.changeSkills(ctx, userId, "get", nil)
+}
+
+func (r *MemberRepoPg) SetSkills(ctx context.Context, userId string, skills []string) ([]string, error) {
+	return r.changeSkills(ctx, userId, "set", func(tx *sqlx.Tx) error {
+		query := "DELETE FROM member_skill WHERE member_id = $1"
+
+		if _, err := tx.ExecContext(ctx, query, userId); err != nil {
+			return err
+		}
+
+		return insertSkills(ctx, tx, userId, skills)
+	})
+}
+
+func (r *MemberRepoPg) AddSkills(ctx context.Context, userId string, skills []string) ([]string, error) {
+	return r.changeSkills(ctx, userId, "add", func(tx *sqlx.Tx) error {
+		return insertSkills(ctx, tx, userId, skills)
+	})
+}
+
+func (r *MemberRepoPg) DeleteSkills(ctx context.Context, userId string, skills []string) ([]string, error) {
+	return r.changeSkills(ctx, userId, "delete", func(tx *sqlx.Tx) error {
+		query := "DELETE FROM member_skill WHERE member_id = $1 AND skill = ANY($2::VARCHAR[])"
+
+		_, err := tx.ExecContext(ctx, query, userId, pq.Array(skills))
+
+		return err
+	})
+}
+
+// checks member, applies change, when it is not nil, and selects resulting skills in one tx
+func (r *MemberRepoPg) changeSkills(
+	ctx context.Context,
+	userId string,
+	action string,
+	change func(tx *sqlx.Tx) error,
+) ([]string, error) {
+	tx, err := r.db.Beginx()
+
+	if err != nil {
+		return []string{}, fmt.Errorf("failed to begin tx while %s skills in postgres: %w", action, err)
+	}
+
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				r.logger.Error().Err(err).Msg("failed to rollback transaction")
+			}
+		}
+	}()
+
+	var exists bool
+
+	query := "SELECT EXISTS (SELECT 1 FROM team_member WHERE id = $1)"
+
+	if err = tx.GetContext(ctx, &exists, query, userId); err != nil {
+		return []string{}, fmt.Errorf("failed to check member in postgres: %w", err)
+	}
+
+	if !exists {
+		err = memberErrors.ErrMemberNotFound
+		return []string{}, err
+	}
+
+	if change != nil {
+		if err = change(tx); err != nil {
+			return []string{}, fmt.Errorf("failed to %s skills in postgres: %w", action, err)
+		}
+	}
+
+	skills := []string{}
+
+	query = "SELECT skill FROM member_skill WHERE member_id = $1 ORDER BY skill"
+
+	if err = tx.SelectContext(ctx, &skills, query, userId); err != nil {
+		return []string{}, fmt.Errorf("failed to select skills from postgres: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return []string{}, fmt.Errorf("failed to commit tx while %s skills in postgres: %w", action, err)
+	}
+
+	return skills, nil
+}
+
+func insertSkills(ctx context.Context, tx *sqlx.Tx, userId string, skills []string) error {
+	query := `
+	INSERT INTO member_skill(member_id, skill)
+	SELECT $1, UNNEST($2::VARCHAR[])
+	ON CONFLICT DO NOTHING
+	`
+
+	_, err := tx.ExecContext(ctx, query, userId, pq.Array(skills))
+
+	return err
+}
@@ -0,0 +1,52 @@
+package outboxpg
+
+import (
+	"context"
+	"fmt"
+
+	eventEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/event/entity"
+	webhookEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+	"github.com/jmoiron/sqlx"
+)
+
+// Write stores events in tx of the change they describe, so events are neither lost
+// nor published for rolled back changes. Deliveries are created for every subscribed webhook
+func Write(ctx context.Context, tx *sqlx.Tx, events ...eventEntity.Event) error {
+	for _, event := range events {
+		query := `
+		INSERT INTO outbox(id, event_type, payload, created_at)
+		VALUES ($1, $2, $3, $4)
+		`
+
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			event.Id,
+			string(event.Type),
+			[]byte(event.Payload),
+			event.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to write event to outbox: %w", err)
+		}
+
+		query = `
+		INSERT INTO webhook_delivery(event_id, webhook_id, status, next_attempt_at)
+		SELECT $1, id, $2, $3
+		FROM webhook
+		WHERE cardinality(event_types) = 0 OR $4 = ANY(event_types)
+		`
+
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			event.Id,
+			string(webhookEntity.DeliveryPending),
+			event.CreatedAt,
+			string(event.Type),
+		); err != nil {
+			return fmt.Errorf("failed to create webhook deliveries of event: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	poolEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/entity"
+	"github.com/lib/pq"
+)
+
+type TeamQuotaDTO struct {
+	PoolId         string `db:"pool_id"`
+	TeamName       string `db:"team_name"`
+	ReviewersCount int    `db:"reviewers_count"`
+}
+
+type PoolDTO struct {
+	Id        string         `db:"id"`
+	Name      string         `db:"pool_name"`
+	MemberIds pq.StringArray `db:"member_ids"`
+	Teams     []TeamQuotaDTO
+}
+
+func (p PoolDTO) ToPoolEntity() poolEntity.Pool {
+	memberIds := []string(p.MemberIds)
+	if memberIds == nil {
+		memberIds = []string{}
+	}
+
+	teams := make([]poolEntity.TeamQuota, 0, len(p.Teams))
+
+	for _, team := range p.Teams {
+		teams = append(teams, poolEntity.TeamQuota{
+			TeamName:       team.TeamName,
+			ReviewersCount: team.ReviewersCount,
+		})
+	}
+
+	return poolEntity.Pool{
+		Id:        p.Id,
+		Name:      p.Name,
+		MemberIds: memberIds,
+		Teams:     teams,
+	}
+}
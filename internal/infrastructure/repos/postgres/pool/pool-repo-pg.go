@@ -0,0 +1,343 @@
+package poolrepopg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	poolEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/entity"
+	poolErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/interfaces"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/pool/dto"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog"
+)
+
+type PoolRepoPg struct {
+	db     *sqlx.DB
+	logger zerolog.Logger
+}
+
+func CreatePoolRepoPg(db *sqlx.DB, log zerolog.Logger) interfaces.PoolRepo {
+	return &PoolRepoPg{
+		db:     db,
+		logger: log,
+	}
+}
+
+func (r *PoolRepoPg) Upsert(ctx context.Context, pool poolEntity.Pool) (poolEntity.Pool, error) {
+	tx, err := r.db.Beginx()
+
+	if err != nil {
+		return poolEntity.Pool{}, fmt.Errorf("failed to begin tx while upsert pool to postgres: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				r.logger.Error().Err(err).Msg("failed to rollback transaction")
+			}
+		}
+	}()
+
+	query := `
+	INSERT INTO reviewer_pool(id, pool_name) VALUES ($1, $2)
+	ON CONFLICT(pool_name) DO NOTHING
+	`
+
+	if _, err = tx.ExecContext(ctx, query, pool.Id, pool.Name); err != nil {
+		return poolEntity.Pool{}, fmt.Errorf("failed to upsert pool into postgres table: %w", err)
+	}
+
+	poolId, err := getPoolId(ctx, tx, pool.Name)
+
+	if err != nil {
+		return poolEntity.Pool{}, err
+	}
+
+	var found int
+
+	query = "SELECT COUNT(*) FROM team_member WHERE id = ANY($1::VARCHAR[])"
+
+	if err = tx.GetContext(ctx, &found, query, pq.Array(pool.MemberIds)); err != nil {
+		return poolEntity.Pool{}, fmt.Errorf("failed to check members of pool: %w", err)
+	}
+
+	// member ids are unique, so every one of them has to be found
+	if found != len(pool.MemberIds) {
+		err = poolErrors.ErrMemberNotFound
+		return poolEntity.Pool{}, err
+	}
+
+	query = "DELETE FROM reviewer_pool_member WHERE pool_id = $1"
+
+	if _, err = tx.ExecContext(ctx, query, poolId); err != nil {
+		return poolEntity.Pool{}, fmt.Errorf("failed to remove old members of pool: %w", err)
+	}
+
+	query = `
+	INSERT INTO reviewer_pool_member(pool_id, member_id)
+	SELECT $1, UNNEST($2::VARCHAR[])
+	`
+
+	if _, err = tx.ExecContext(ctx, query, poolId, pq.Array(pool.MemberIds)); err != nil {
+		return poolEntity.Pool{}, fmt.Errorf("failed to add members of pool: %w", err)
+	}
+
+	stored, err := r.getPool(ctx, tx, pool.Name)
+
+	if err != nil {
+		return poolEntity.Pool{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return poolEntity.Pool{}, fmt.Errorf("failed to commit tx while upsert pool postgres: %w", err)
+	}
+
+	return stored, nil
+}
+
+func (r *PoolRepoPg) GetByName(ctx context.Context, name string) (poolEntity.Pool, error) {
+	return r.getPool(ctx, r.db, name)
+}
+
+func (r *PoolRepoPg) List(ctx context.Context) ([]poolEntity.Pool, error) {
+	return r.selectPools(ctx, r.db, "")
+}
+
+func (r *PoolRepoPg) Delete(ctx context.Context, name string) error {
+	query := "DELETE FROM reviewer_pool WHERE pool_name = $1"
+
+	res, err := r.db.ExecContext(ctx, query, name)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete pool from postgres: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows while delete pool: %w", err)
+	}
+
+	if affected == 0 {
+		return poolErrors.ErrPoolNotFound
+	}
+
+	return nil
+}
+
+func (r *PoolRepoPg) AttachTeam(
+	ctx context.Context,
+	poolName, teamName string,
+	reviewersCount int,
+) (poolEntity.Pool, error) {
+	tx, err := r.db.Beginx()
+
+	if err != nil {
+		return poolEntity.Pool{}, fmt.Errorf("failed to begin tx while attach team to pool in postgres: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				r.logger.Error().Err(err).Msg("failed to rollback transaction")
+			}
+		}
+	}()
+
+	poolId, err := getPoolId(ctx, tx, poolName)
+
+	if err != nil {
+		return poolEntity.Pool{}, err
+	}
+
+	var teamId string
+
+	query := "SELECT id FROM team WHERE team_name = $1"
+
+	if err = tx.GetContext(ctx, &teamId, query, teamName); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = teamErrors.ErrTeamNotFound
+			return poolEntity.Pool{}, err
+		}
+
+		return poolEntity.Pool{}, fmt.Errorf("failed to get team while attach it to pool: %w", err)
+	}
+
+	query = `
+	INSERT INTO team_pool(team_id, pool_id, reviewers_count) VALUES ($1, $2, $3)
+	ON CONFLICT(team_id, pool_id) DO UPDATE
+	SET reviewers_count = EXCLUDED.reviewers_count
+	`
+
+	if _, err = tx.ExecContext(ctx, query, teamId, poolId, reviewersCount); err != nil {
+		return poolEntity.Pool{}, fmt.Errorf("failed to attach team to pool in postgres: %w", err)
+	}
+
+	pool, err := r.getPool(ctx, tx, poolName)
+
+	if err != nil {
+		return poolEntity.Pool{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return poolEntity.Pool{}, fmt.Errorf("failed to commit tx while attach team to pool: %w", err)
+	}
+
+	return pool, nil
+}
+
+func (r *PoolRepoPg) DetachTeam(ctx context.Context, poolName, teamName string) (poolEntity.Pool, error) {
+	tx, err := r.db.Beginx()
+
+	if err != nil {
+		return poolEntity.Pool{}, fmt.Errorf("failed to begin tx while detach team from pool in postgres: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				r.logger.Error().Err(err).Msg("failed to rollback transaction")
+			}
+		}
+	}()
+
+	poolId, err := getPoolId(ctx, tx, poolName)
+
+	if err != nil {
+		return poolEntity.Pool{}, err
+	}
+
+	query := `
+	DELETE FROM team_pool
+	USING team AS t
+	WHERE team_pool.team_id = t.id AND team_pool.pool_id = $1 AND t.team_name = $2
+	`
+
+	res, err := tx.ExecContext(ctx, query, poolId, teamName)
+
+	if err != nil {
+		return poolEntity.Pool{}, fmt.Errorf("failed to detach team from pool in postgres: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return poolEntity.Pool{}, fmt.Errorf("failed to get affected rows while detach team from pool: %w", err)
+	}
+
+	if affected == 0 {
+		err = poolErrors.ErrTeamNotAttached
+		return poolEntity.Pool{}, err
+	}
+
+	pool, err := r.getPool(ctx, tx, poolName)
+
+	if err != nil {
+		return poolEntity.Pool{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return poolEntity.Pool{}, fmt.Errorf("failed to commit tx while detach team from pool: %w", err)
+	}
+
+	return pool, nil
+}
+
+func getPoolId(ctx context.Context, tx *sqlx.Tx, name string) (string, error) {
+	var poolId string
+
+	query := "SELECT id FROM reviewer_pool WHERE pool_name = $1"
+
+	if err := tx.GetContext(ctx, &poolId, query, name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", poolErrors.ErrPoolNotFound
+		}
+
+		return "", fmt.Errorf("failed to get pool from postgres: %w", err)
+	}
+
+	return poolId, nil
+}
+
+func (r *PoolRepoPg) getPool(ctx context.Context, q sqlx.QueryerContext, name string) (poolEntity.Pool, error) {
+	pools, err := r.selectPools(ctx, q, name)
+
+	if err != nil {
+		return poolEntity.Pool{}, err
+	}
+
+	if len(pools) == 0 {
+		return poolEntity.Pool{}, poolErrors.ErrPoolNotFound
+	}
+
+	return pools[0], nil
+}
+
+// selects pools with their members and teams ordered by name, empty name selects all pools
+func (r *PoolRepoPg) selectPools(ctx context.Context, q sqlx.QueryerContext, name string) ([]poolEntity.Pool, error) {
+	query := `
+	SELECT
+		p.id,
+		p.pool_name,
+		COALESCE(
+			ARRAY_AGG(m.member_id ORDER BY m.member_id) FILTER (WHERE m.member_id IS NOT NULL),
+			'{}'
+		) AS member_ids
+	FROM reviewer_pool AS p
+	LEFT JOIN reviewer_pool_member AS m
+		ON m.pool_id = p.id
+	WHERE $1::VARCHAR = '' OR p.pool_name = $1
+	GROUP BY p.id
+	ORDER BY p.pool_name
+	`
+
+	var pools []dto.PoolDTO
+
+	if err := sqlx.SelectContext(ctx, q, &pools, query, name); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return []poolEntity.Pool{}, fmt.Errorf("failed to select pools: %w", err)
+		}
+	}
+
+	poolIds := make([]string, 0, len(pools))
+
+	for _, pool := range pools {
+		poolIds = append(poolIds, pool.Id)
+	}
+
+	query = `
+	SELECT tp.pool_id, t.team_name, tp.reviewers_count
+	FROM team_pool AS tp
+	INNER JOIN team AS t
+		ON t.id = tp.team_id
+	WHERE tp.pool_id = ANY($1::VARCHAR[])
+	ORDER BY t.team_name
+	`
+
+	var teams []dto.TeamQuotaDTO
+
+	if err := sqlx.SelectContext(ctx, q, &teams, query, pq.Array(poolIds)); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return []poolEntity.Pool{}, fmt.Errorf("failed to select teams of pools: %w", err)
+		}
+	}
+
+	res := make([]poolEntity.Pool, 0, len(pools))
+
+	for _, pool := range pools {
+		for _, team := range teams {
+			if team.PoolId == pool.Id {
+				pool.Teams = append(pool.Teams, team)
+			}
+		}
+
+		res = append(res, pool.ToPoolEntity())
+	}
+
+	return res, nil
+}
@@ -1,15 +1,37 @@
 package dto
 
-import "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+import (
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	"github.com/lib/pq"
+)
 
 type MemberDTO struct {
-	Id       string `db:"id"`
-	Activity string `db:"activity"`
+	Id             string         `db:"id"`
+	Activity       string         `db:"activity"`
+	OpenReviews    int            `db:"open_reviews"`
+	MaxOpenReviews *int           `db:"max_open_reviews"`
+	Unavailable    bool           `db:"unavailable"`
+	TeamName       string         `db:"team_name"`
+	Teams          pq.StringArray `db:"teams"`
+	Skills         pq.StringArray `db:"skills"`
 }
 
 func (m MemberDTO) ToMemberEntity() entity.Member {
 	return entity.Member{
-		Id:       m.Id,
-		Activity: entity.MemberActivity(m.Activity),
+		Id:             m.Id,
+		Activity:       entity.MemberActivity(m.Activity),
+		OpenReviews:    m.OpenReviews,
+		MaxOpenReviews: m.MaxOpenReviews,
+		Unavailable:    m.Unavailable,
+		TeamName:       m.TeamName,
+		Teams:          m.Teams,
+		Skills:         m.Skills,
 	}
 }
+
+// member of reviewer pool attached to team
+type PoolMemberDTO struct {
+	PoolName       string `db:"pool_name"`
+	ReviewersCount int    `db:"reviewers_count"`
+	MemberDTO
+}
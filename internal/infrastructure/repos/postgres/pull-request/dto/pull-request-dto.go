@@ -16,6 +16,7 @@ type PullRequestDTO struct {
 	TeamId    string         `db:"team_id"`
 	MergedAt  *time.Time     `db:"merged_at"`
 	Reviewers pq.StringArray `db:"reviewers"`
+	Labels    pq.StringArray `db:"labels"`
 }
 
 func (pr PullRequestDTO) ToPullRequestEntity() entity.PullRequest {
@@ -29,6 +30,11 @@ func (pr PullRequestDTO) ToPullRequestEntity() entity.PullRequest {
 		members = []string{}
 	}
 
+	labels := pr.Labels
+	if pr.Labels == nil {
+		labels = []string{}
+	}
+
 	return entity.PullRequest{
 		Id:        pr.Id,
 		Name:      pr.Name,
@@ -37,5 +43,6 @@ func (pr PullRequestDTO) ToPullRequestEntity() entity.PullRequest {
 		CreatedAt: pr.CreatedAt,
 		MergedAt:  mergedAt,
 		Reviewers: members,
+		Labels:    labels,
 	}
 }
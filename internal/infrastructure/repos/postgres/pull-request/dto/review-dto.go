@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+)
+
+type ReviewDTO struct {
+	PullRequestId string     `db:"pr_id"`
+	ReviewerId    string     `db:"member_id"`
+	Verdict       *string    `db:"verdict"`
+	ReviewedAt    *time.Time `db:"reviewed_at"`
+	AssignedAt    time.Time  `db:"assigned_at"`
+}
+
+func (r ReviewDTO) ToReviewEntity() entity.Review {
+	review := entity.Review{
+		ReviewerId: r.ReviewerId,
+		Verdict:    entity.VerdictPending,
+		AssignedAt: r.AssignedAt,
+	}
+
+	if r.Verdict != nil {
+		review.Verdict = entity.ReviewVerdict(*r.Verdict)
+	}
+
+	if r.ReviewedAt != nil {
+		review.ReviewedAt = *r.ReviewedAt
+	}
+
+	return review
+}
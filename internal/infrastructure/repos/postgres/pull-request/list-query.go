@@ -0,0 +1,111 @@
+package pullrequestrepopg
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+)
+
+// columns of pr_with_members by sort field, whitelist keeps query safe from injections
+var sortColumns = map[prEntity.PRSortField]string{
+	prEntity.PRSortCreatedAt: "p.created_at",
+	prEntity.PRSortName:      "p.pr_name",
+}
+
+// builds keyset paginated query, so deep pages cost the same as the first one
+func buildListQuery(listQuery prEntity.PRListQuery) (string, []any, error) {
+	sortColumn, ok := sortColumns[listQuery.SortBy]
+
+	if !ok {
+		return "", nil, fmt.Errorf("unknown sort field: %s", listQuery.SortBy)
+	}
+
+	conditions := make([]string, 0)
+	args := make([]any, 0)
+
+	addCondition := func(condition string, values ...any) {
+		placeholders := make([]any, 0, len(values))
+
+		for _, value := range values {
+			args = append(args, value)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+		}
+
+		conditions = append(conditions, fmt.Sprintf(condition, placeholders...))
+	}
+
+	filter := listQuery.Filter
+
+	if filter.AuthorId != "" {
+		addCondition("p.author_id = %s", filter.AuthorId)
+	}
+
+	if filter.TeamName != "" {
+		addCondition("p.team_id = (SELECT id FROM team WHERE team_name = %s)", filter.TeamName)
+	}
+
+	if filter.Status != "" {
+		addCondition("p.pr_status = %s", string(filter.Status))
+	}
+
+	if filter.ReviewerId != "" {
+		addCondition("%s = ANY(p.reviewers)", filter.ReviewerId)
+	}
+
+	if filter.CreatedFrom != nil {
+		addCondition("p.created_at >= %s", *filter.CreatedFrom)
+	}
+
+	if filter.CreatedTo != nil {
+		addCondition("p.created_at < %s", *filter.CreatedTo)
+	}
+
+	direction, comparison := "ASC", ">"
+	if listQuery.Order == pagination.OrderDesc {
+		direction, comparison = "DESC", "<"
+	}
+
+	if listQuery.After != nil {
+		var value any = listQuery.After.Value
+
+		if listQuery.SortBy == prEntity.PRSortCreatedAt {
+			createdAt, err := time.Parse(time.RFC3339Nano, listQuery.After.Value)
+
+			if err != nil {
+				return "", nil, pagination.ErrInvalidCursor
+			}
+
+			value = createdAt
+		}
+
+		addCondition(fmt.Sprintf("(%s, p.id) %s (%%s, %%s)", sortColumn, comparison), value, listQuery.After.Id)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	args = append(args, listQuery.Limit)
+
+	query := fmt.Sprintf(`
+	SELECT
+		p.id,
+		p.pr_name,
+		p.author_id,
+		p.pr_status,
+		p.created_at,
+		p.merged_at,
+		p.reviewers,
+		p.labels
+	FROM pr_with_members AS p
+	%s
+	ORDER BY %s %s, p.id %s
+	LIMIT $%d
+	`, where, sortColumn, direction, direction, len(args))
+
+	return query, args, nil
+}
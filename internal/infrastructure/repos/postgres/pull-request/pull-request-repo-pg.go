@@ -5,12 +5,18 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 
-	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	eventEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/event/entity"
 	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
 	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+	reviewStreamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/entity"
+	outboxpg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/outbox"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/pull-request/dto"
+	reviewstreampg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/review-stream"
+	reviewerpublishpg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/reviewer-publish"
+	reviewspg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/reviews"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	"github.com/rs/zerolog"
@@ -30,7 +36,7 @@ func CreatePullRequestRepoPg(db *sqlx.DB, log zerolog.Logger) interfaces.PullReq
 	}
 }
 
-func (r *PullRequestRepoPg) GetByReviewer(ctx context.Context, reviewerId string, limit int) ([]prEntity.PullRequest, error) {
+func (r *PullRequestRepoPg) GetById(ctx context.Context, prId string) (prEntity.PullRequest, error) {
 	query := `
 	SELECT
 		id,
@@ -39,34 +45,99 @@ func (r *PullRequestRepoPg) GetByReviewer(ctx context.Context, reviewerId string
 		pr_status,
 		created_at,
 		merged_at,
-		reviewers
-	FROM pr_with_members WHERE $1 = ANY(reviewers)
-	ORDER BY created_at
-	LIMIT $2
+		reviewers,
+		labels
+	FROM pr_with_members WHERE id = $1
 	`
 
+	var pr dto.PullRequestDTO
+
+	if err := r.db.GetContext(ctx, &pr, query, prId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return prEntity.PullRequest{}, prErrors.ErrNotFound
+		}
+
+		return prEntity.PullRequest{}, fmt.Errorf("failed to get pr by id: %w", err)
+	}
+
+	reviews, err := reviewspg.GetReviews(ctx, r.db, prId)
+
+	if err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to get reviews of pr: %w", err)
+	}
+
+	res := pr.ToPullRequestEntity()
+	res.Reviews = reviews
+
+	return res, nil
+}
+
+func (r *PullRequestRepoPg) List(ctx context.Context, listQuery prEntity.PRListQuery) ([]prEntity.PullRequest, error) {
+	query, args, err := buildListQuery(listQuery)
+
+	if err != nil {
+		return []prEntity.PullRequest{}, err
+	}
+
 	var prs []dto.PullRequestDTO
 
-	if err := r.db.SelectContext(ctx, &prs, query, reviewerId, limit); err != nil {
+	if err := r.db.SelectContext(ctx, &prs, query, args...); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return []prEntity.PullRequest{}, nil
 		}
 
-		return []prEntity.PullRequest{}, fmt.Errorf("failed to select PRs by reviewer: %w", err)
+		return []prEntity.PullRequest{}, fmt.Errorf("failed to select PRs list: %w", err)
+	}
+
+	prIds := make([]string, 0, len(prs))
+
+	for _, pr := range prs {
+		prIds = append(prIds, pr.Id)
+	}
+
+	reviews, err := reviewspg.GetReviewsByPullRequests(ctx, r.db, prIds)
+
+	if err != nil {
+		return []prEntity.PullRequest{}, fmt.Errorf("failed to get reviews of PRs list: %w", err)
 	}
 
 	res := make([]prEntity.PullRequest, 0, len(prs))
 
 	for _, pr := range prs {
-		res = append(res, pr.ToPullRequestEntity())
+		prEnt := pr.ToPullRequestEntity()
+		prEnt.Reviews = reviews[pr.Id]
+
+		res = append(res, prEnt)
 	}
 
 	return res, nil
 }
 
+func (r *PullRequestRepoPg) GetOpenIdsByReviewer(ctx context.Context, reviewerId string) ([]string, error) {
+	query := `
+	SELECT pr.id
+	FROM assigned_reviewer AS a
+	INNER JOIN pull_request AS pr
+		ON pr.id = a.pr_id
+	WHERE a.member_id = $1 AND pr.pr_status = $2
+	ORDER BY pr.created_at
+	`
+
+	var ids []string
+
+	if err := r.db.SelectContext(ctx, &ids, query, reviewerId, string(prEntity.PROpen)); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return []string{}, fmt.Errorf("failed to select open PRs by reviewer: %w", err)
+		}
+	}
+
+	return ids, nil
+}
+
 func (r *PullRequestRepoPg) Create(
 	ctx context.Context,
 	pr prEntity.PullRequest,
+	teamName string,
 	assign interfaces.AssignHandler,
 ) (prEntity.PullRequest, error) {
 	tx, err := r.db.Beginx()
@@ -84,12 +155,27 @@ func (r *PullRequestRepoPg) Create(
 	}()
 
 	var team struct {
-		Id *string `db:"team_id"`
+		Id       *string `db:"team_id"`
+		Name     *string `db:"team_name"`
+		Archived bool    `db:"archived"`
 	}
 
-	query := "SELECT team_id FROM team_member WHERE id = $1"
+	// primary team of author is used by default
+	query := `
+	SELECT m.team_id, t.team_name, t.archived_at IS NOT NULL AS archived
+	FROM team_member AS m
+	LEFT JOIN team AS t
+		ON m.team_id = t.id
+	WHERE m.id = $1 AND $2::VARCHAR = ''
+	UNION ALL
+	SELECT t.id AS team_id, t.team_name, t.archived_at IS NOT NULL AS archived
+	FROM team_membership AS tm
+	INNER JOIN team AS t
+		ON tm.team_id = t.id
+	WHERE tm.member_id = $1 AND t.team_name = $2
+	`
 
-	if err = tx.GetContext(ctx, &team, query, pr.AuthorId); err != nil {
+	if err = tx.GetContext(ctx, &team, query, pr.AuthorId, teamName); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return prEntity.PullRequest{}, prErrors.ErrTeamOrUserNotFound
 		}
@@ -102,9 +188,14 @@ func (r *PullRequestRepoPg) Create(
 		return prEntity.PullRequest{}, err
 	}
 
+	if team.Archived {
+		err = prErrors.ErrTeamArchived
+		return prEntity.PullRequest{}, err
+	}
+
 	query = `
-	INSERT INTO pull_request(id, pr_name, author_id, team_id, pr_status, created_at)
-	VALUES ($1, $2, $3, $4, $5, $6)
+	INSERT INTO pull_request(id, pr_name, author_id, team_id, pr_status, created_at, labels)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
 
 	if _, err = tx.ExecContext(
@@ -116,6 +207,7 @@ func (r *PullRequestRepoPg) Create(
 		team.Id,
 		string(pr.Status),
 		pr.CreatedAt,
+		pq.Array(pr.Labels),
 	); err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			if pqErr.Code == primaryKeyViolation {
@@ -126,23 +218,29 @@ func (r *PullRequestRepoPg) Create(
 		return prEntity.PullRequest{}, fmt.Errorf("failed to create pr in postgres: %w", err)
 	}
 
-	var members []dto.MemberDTO
+	members, err := reviewspg.GetTeamMembers(ctx, tx, *team.Id)
+
+	if err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to get team members while create pr: %w", err)
+	}
 
-	query = "SELECT id, activity FROM team_member WHERE team_id = $1"
+	codeowners, err := reviewspg.GetCodeowners(ctx, tx, *team.Id)
 
-	if err = tx.SelectContext(ctx, &members, query, team.Id); err != nil {
-		if !errors.Is(err, sql.ErrNoRows) {
-			return prEntity.PullRequest{}, fmt.Errorf("failed to get team members while create pr")
-		}
+	if err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to get codeowners while create pr: %w", err)
 	}
 
-	membersEntities := make([]memberEntity.Member, 0, len(members))
+	pools, err := reviewspg.GetTeamPools(ctx, tx, *team.Id)
 
-	for _, member := range members {
-		membersEntities = append(membersEntities, member.ToMemberEntity())
+	if err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to get pools of team while create pr: %w", err)
 	}
 
-	assigned := assign(pr.AuthorId, membersEntities)
+	assigned, err := assign(pr.AuthorId, *team.Name, members, codeowners, pools)
+
+	if err != nil {
+		return prEntity.PullRequest{}, err
+	}
 
 	for _, reviewer := range assigned {
 		query = `
@@ -155,12 +253,26 @@ func (r *PullRequestRepoPg) Create(
 		}
 	}
 
+	pr.Reviewers = assigned
+
+	if err = outboxpg.Write(ctx, tx, eventEntity.NewPRCreatedEvent(pr)); err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to write event while create pr: %w", err)
+	}
+
+	reviewEvents := reviewStreamEntity.NewReviewEvents(reviewStreamEntity.ReviewAssigned, assigned, pr)
+
+	if err = reviewstreampg.Write(ctx, tx, reviewEvents...); err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to write review events while create pr: %w", err)
+	}
+
+	if err = reviewerpublishpg.Enqueue(ctx, tx, reviewEvents...); err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to enqueue reviewer publication while create pr: %w", err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return prEntity.PullRequest{}, fmt.Errorf("failed to commit tx while create pr postgres: %w", err)
 	}
 
-	pr.Reviewers = assigned
-
 	return pr, nil
 }
 
@@ -172,7 +284,7 @@ func (r *PullRequestRepoPg) UpdateStatus(
 	tx, err := r.db.Beginx()
 
 	if err != nil {
-		return prEntity.PullRequest{}, fmt.Errorf("failed to begin tx while merge pr in postgres: %w", err)
+		return prEntity.PullRequest{}, fmt.Errorf("failed to begin tx while update pr status in postgres: %w", err)
 	}
 
 	defer func() {
@@ -183,45 +295,14 @@ func (r *PullRequestRepoPg) UpdateStatus(
 		}
 	}()
 
-	query := `
-	SELECT
-		id,
-		pr_name,
-		author_id,
-		pr_status,
-		created_at,
-		merged_at,
-		reviewers
-	FROM pr_with_members WHERE id = $1
-	`
-
-	var pr dto.PullRequestDTO
-
-	if err = tx.GetContext(ctx, &pr, query, prId); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return prEntity.PullRequest{}, prErrors.ErrNotFound
-		}
-
-		return prEntity.PullRequest{}, fmt.Errorf("failed to get pr while merge: %w", err)
-	}
-
-	pr.Id = prId
-	prUpdated, updated := updateStatusHandler(pr.ToPullRequestEntity())
+	prUpdated, err := reviewspg.UpdateStatus(ctx, tx, prId, updateStatusHandler)
 
-	if updated {
-		query = `
-		UPDATE pull_request
-		SET pr_status = $1, merged_at = $2 
-		WHERE id = $3
-		`
-
-		if _, err = tx.ExecContext(ctx, query, string(prUpdated.Status), prUpdated.MergedAt, prId); err != nil {
-			return prEntity.PullRequest{}, fmt.Errorf("failed to update status while merge mr: %w", err)
-		}
+	if err != nil {
+		return prEntity.PullRequest{}, err
 	}
 
 	if err = tx.Commit(); err != nil {
-		return prEntity.PullRequest{}, fmt.Errorf("failed to commit tx while merge pr postgres: %w", err)
+		return prEntity.PullRequest{}, fmt.Errorf("failed to commit tx while update pr status in postgres: %w", err)
 	}
 
 	return prUpdated, nil
@@ -257,7 +338,8 @@ func (r *PullRequestRepoPg) Reassign(
 		created_at,
 		merged_at,
 		team_id,
-		reviewers
+		reviewers,
+		labels
 	FROM pr_with_members WHERE id = $1
 	`
 
@@ -271,35 +353,34 @@ func (r *PullRequestRepoPg) Reassign(
 		return prEntity.PullRequest{}, "", fmt.Errorf("failed to get pr to reassign: %w", err)
 	}
 
-	var teamMembers []dto.MemberDTO
+	var teamName string
 
-	query = `
-	SELECT id, activity 
-	FROM team_member 
-	WHERE team_id = $1
-	`
+	query = "SELECT team_name FROM team WHERE id = $1"
 
-	if err = tx.SelectContext(ctx, &teamMembers, query, pr.TeamId); err != nil {
-		if !errors.Is(err, sql.ErrNoRows) {
-			return prEntity.PullRequest{}, "", fmt.Errorf("failed to get current reviewers while reassign: %w", err)
+	if err = tx.GetContext(ctx, &teamName, query, pr.TeamId); err != nil {
+		// team of pr was deleted
+		if errors.Is(err, sql.ErrNoRows) {
+			return prEntity.PullRequest{}, "", prErrors.ErrTeamOrUserNotFound
 		}
+
+		return prEntity.PullRequest{}, "", fmt.Errorf("failed to get team of pr while reassign: %w", err)
 	}
 
-	teamMembersEntities := make([]memberEntity.Member, 0, len(teamMembers))
+	teamMembers, err := reviewspg.GetReplacementCandidates(ctx, tx, pr.TeamId)
 
-	for _, member := range teamMembers {
-		teamMembersEntities = append(teamMembersEntities, member.ToMemberEntity())
+	if err != nil {
+		return prEntity.PullRequest{}, "", fmt.Errorf("failed to get team members while reassign: %w", err)
 	}
 
-	newReviewer, err := assign(pr.AuthorId, pr.ToPullRequestEntity(), teamMembersEntities)
+	newReviewer, err := assign(pr.AuthorId, pr.ToPullRequestEntity(), teamName, teamMembers)
 
 	if err != nil {
 		return prEntity.PullRequest{}, "", err
 	}
 
-	query = "DELETE FROM assigned_reviewer WHERE member_id = $1"
+	query = "DELETE FROM assigned_reviewer WHERE member_id = $1 AND pr_id = $2"
 
-	if _, err = tx.ExecContext(ctx, query, oldReviewerId); err != nil {
+	if _, err = tx.ExecContext(ctx, query, oldReviewerId, prId); err != nil {
 		return prEntity.PullRequest{}, "", fmt.Errorf("failed to remove old reviewer: %w", err)
 	}
 
@@ -309,6 +390,38 @@ func (r *PullRequestRepoPg) Reassign(
 		return prEntity.PullRequest{}, "", fmt.Errorf("failed to remove old reviewer")
 	}
 
+	// verdict of old reviewer is removed with assignment
+	reviews, err := reviewspg.GetReviews(ctx, tx, prId)
+
+	if err != nil {
+		return prEntity.PullRequest{}, "", fmt.Errorf("failed to get reviews while reassign: %w", err)
+	}
+
+	event := eventEntity.NewPRReassignedEvent(prEntity.Reassignment{
+		PullRequestId: prId,
+		OldReviewerId: oldReviewerId,
+		NewReviewerId: newReviewer,
+	})
+
+	if err = outboxpg.Write(ctx, tx, event); err != nil {
+		return prEntity.PullRequest{}, "", fmt.Errorf("failed to write event while reassign: %w", err)
+	}
+
+	reassignedPr := pr.ToPullRequestEntity()
+
+	reviewEvents := []reviewStreamEntity.ReviewEvent{
+		reviewStreamEntity.NewReviewEvent(reviewStreamEntity.ReviewUnassigned, oldReviewerId, reassignedPr),
+		reviewStreamEntity.NewReviewEvent(reviewStreamEntity.ReviewAssigned, newReviewer, reassignedPr),
+	}
+
+	if err = reviewstreampg.Write(ctx, tx, reviewEvents...); err != nil {
+		return prEntity.PullRequest{}, "", fmt.Errorf("failed to write review events while reassign: %w", err)
+	}
+
+	if err = reviewerpublishpg.Enqueue(ctx, tx, reviewEvents...); err != nil {
+		return prEntity.PullRequest{}, "", fmt.Errorf("failed to enqueue reviewer publication while reassign: %w", err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return prEntity.PullRequest{}, "", fmt.Errorf("failed to commit tx while merge pr postgres: %w", err)
 	}
@@ -319,5 +432,91 @@ func (r *PullRequestRepoPg) Reassign(
 		}
 	}
 
-	return pr.ToPullRequestEntity(), newReviewer, nil
+	res := pr.ToPullRequestEntity()
+	res.Reviews = reviews
+
+	return res, newReviewer, nil
+}
+
+func (r *PullRequestRepoPg) AddReview(
+	ctx context.Context,
+	prId string,
+	reviewHandler interfaces.ReviewHandler,
+) (prEntity.PullRequest, error) {
+	tx, err := r.db.Beginx()
+
+	if err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to begin tx while add review in postgres: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				r.logger.Error().Err(err).Msg("failed to rollback transaction")
+			}
+		}
+	}()
+
+	query := `
+	SELECT
+		id,
+		pr_name,
+		author_id,
+		pr_status,
+		created_at,
+		merged_at,
+		reviewers,
+		labels
+	FROM pr_with_members WHERE id = $1
+	`
+
+	var pr dto.PullRequestDTO
+
+	if err = tx.GetContext(ctx, &pr, query, prId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return prEntity.PullRequest{}, prErrors.ErrNotFound
+		}
+
+		return prEntity.PullRequest{}, fmt.Errorf("failed to get pr while add review: %w", err)
+	}
+
+	reviews, err := reviewspg.GetReviews(ctx, tx, prId)
+
+	if err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to get reviews while add review: %w", err)
+	}
+
+	res := pr.ToPullRequestEntity()
+	res.Reviews = reviews
+
+	review, err := reviewHandler(res)
+
+	if err != nil {
+		return prEntity.PullRequest{}, err
+	}
+
+	if assignedAt, ok := res.AssignedAt(review.ReviewerId); ok {
+		review.AssignedAt = assignedAt
+	}
+
+	query = `
+	UPDATE assigned_reviewer
+	SET verdict = $1, reviewed_at = $2
+	WHERE pr_id = $3 AND member_id = $4
+	`
+
+	if _, err = tx.ExecContext(ctx, query, string(review.Verdict), review.ReviewedAt, prId, review.ReviewerId); err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to store review in postgres: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to commit tx while add review: %w", err)
+	}
+
+	res.Reviews = slices.DeleteFunc(res.Reviews, func(r prEntity.Review) bool {
+		return r.ReviewerId == review.ReviewerId
+	})
+	res.Reviews = append(res.Reviews, review)
+
+	return res, nil
 }
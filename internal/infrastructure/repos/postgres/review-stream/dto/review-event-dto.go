@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/entity"
+)
+
+type ReviewEventDTO struct {
+	Seq        int64     `db:"seq"`
+	ReviewerId string    `db:"reviewer_id"`
+	EventType  string    `db:"event_type"`
+	PrId       string    `db:"pr_id"`
+	PrName     string    `db:"pr_name"`
+	AuthorId   string    `db:"author_id"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+func (e *ReviewEventDTO) ToReviewEventEntity() entity.ReviewEvent {
+	return entity.ReviewEvent{
+		Seq:             e.Seq,
+		ReviewerId:      e.ReviewerId,
+		Type:            entity.ReviewEventType(e.EventType),
+		PullRequestId:   e.PrId,
+		PullRequestName: e.PrName,
+		AuthorId:        e.AuthorId,
+		CreatedAt:       e.CreatedAt,
+	}
+}
@@ -0,0 +1,124 @@
+package reviewstreampg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/interfaces"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/review-stream/dto"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+)
+
+// NotifyChannel gets id of reviewer on commit of tx, which stored events of the reviewer
+const NotifyChannel = "review_stream"
+
+// key of advisory lock, which serializes writers of the stream
+const writeLockKey = 7311
+
+type ReviewStreamRepoPg struct {
+	db     *sqlx.DB
+	logger zerolog.Logger
+}
+
+func CreateReviewStreamRepoPg(db *sqlx.DB, log zerolog.Logger) interfaces.ReviewStreamRepo {
+	return &ReviewStreamRepoPg{
+		db:     db,
+		logger: log,
+	}
+}
+
+func (r *ReviewStreamRepoPg) GetAfter(
+	ctx context.Context,
+	reviewerId string,
+	after int64,
+	limit int,
+) ([]entity.ReviewEvent, error) {
+	query := `
+	SELECT seq, reviewer_id, event_type, pr_id, pr_name, author_id, created_at
+	FROM review_event
+	WHERE reviewer_id = $1 AND seq > $2
+	ORDER BY seq
+	LIMIT $3
+	`
+
+	var events []dto.ReviewEventDTO
+
+	if err := r.db.SelectContext(ctx, &events, query, reviewerId, after, limit); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []entity.ReviewEvent{}, nil
+		}
+
+		return []entity.ReviewEvent{}, fmt.Errorf("failed to select review events: %w", err)
+	}
+
+	res := make([]entity.ReviewEvent, 0, len(events))
+
+	for _, event := range events {
+		res = append(res, event.ToReviewEventEntity())
+	}
+
+	return res, nil
+}
+
+func (r *ReviewStreamRepoPg) GetLastSeq(ctx context.Context) (int64, error) {
+	var seq int64
+
+	query := "SELECT COALESCE(MAX(seq), 0) FROM review_event"
+
+	if err := r.db.GetContext(ctx, &seq, query); err != nil {
+		return 0, fmt.Errorf("failed to get last review event seq: %w", err)
+	}
+
+	return seq, nil
+}
+
+// Write stores events in tx of the change they describe and notifies listeners on commit.
+// Writers are serialized till commit, so events become visible in seq order
+// and readers, which continue after the last seen seq, do not skip events of concurrent tx
+func Write(ctx context.Context, tx *sqlx.Tx, events ...entity.ReviewEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", writeLockKey); err != nil {
+		return fmt.Errorf("failed to lock review stream: %w", err)
+	}
+
+	notified := make(map[string]struct{}, len(events))
+
+	for _, event := range events {
+		query := `
+		INSERT INTO review_event(reviewer_id, event_type, pr_id, pr_name, author_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		`
+
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			event.ReviewerId,
+			string(event.Type),
+			event.PullRequestId,
+			event.PullRequestName,
+			event.AuthorId,
+			event.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to write review event: %w", err)
+		}
+
+		if _, ok := notified[event.ReviewerId]; ok {
+			continue
+		}
+
+		notified[event.ReviewerId] = struct{}{}
+
+		if _, err := tx.ExecContext(ctx, "SELECT pg_notify($1, $2)", NotifyChannel, event.ReviewerId); err != nil {
+			return fmt.Errorf("failed to notify about review event: %w", err)
+		}
+	}
+
+	return nil
+}
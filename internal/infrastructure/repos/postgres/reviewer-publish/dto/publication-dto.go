@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"time"
+
+	integrationEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/entity"
+	"github.com/lib/pq"
+)
+
+type PublicationDTO struct {
+	Id            int64          `db:"id"`
+	PullRequestId string         `db:"pr_id"`
+	Source        string         `db:"source"`
+	Repository    string         `db:"repository"`
+	Number        int64          `db:"number"`
+	Added         pq.StringArray `db:"added"`
+	Removed       pq.StringArray `db:"removed"`
+	Status        string         `db:"status"`
+	Attempts      int            `db:"attempts"`
+	NextAttemptAt time.Time      `db:"next_attempt_at"`
+	LastError     *string        `db:"last_error"`
+	PublishedAt   *time.Time     `db:"published_at"`
+}
+
+func (p PublicationDTO) ToPublicationEntity() entity.Publication {
+	publication := entity.Publication{
+		Id: p.Id,
+		Link: integrationEntity.HostLink{
+			PullRequestId: p.PullRequestId,
+			Source:        p.Source,
+			Repository:    p.Repository,
+			Number:        p.Number,
+		},
+		Added:         p.Added,
+		Removed:       p.Removed,
+		Status:        entity.PublicationStatus(p.Status),
+		Attempts:      p.Attempts,
+		NextAttemptAt: p.NextAttemptAt,
+	}
+
+	if p.LastError != nil {
+		publication.LastError = *p.LastError
+	}
+
+	if p.PublishedAt != nil {
+		publication.PublishedAt = *p.PublishedAt
+	}
+
+	return publication
+}
@@ -0,0 +1,201 @@
+package reviewerpublishpg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	reviewStreamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/reviewer-publish/interfaces"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/reviewer-publish/dto"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog"
+)
+
+type PublicationRepoPg struct {
+	db     *sqlx.DB
+	logger zerolog.Logger
+}
+
+func CreatePublicationRepoPg(db *sqlx.DB, log zerolog.Logger) interfaces.PublicationRepo {
+	return &PublicationRepoPg{
+		db:     db,
+		logger: log,
+	}
+}
+
+func (r *PublicationRepoPg) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]entity.Publication, error) {
+	// publication waits for older pending ones of its pr, so code host gets changes in order.
+	// Skip locked and moving next attempt to the end of lease work as for webhook deliveries
+	query := `
+	WITH due AS (
+		SELECT p.id
+		FROM reviewer_publication AS p
+		WHERE p.status = $1 AND p.next_attempt_at <= $2
+			AND NOT EXISTS (
+				SELECT 1
+				FROM reviewer_publication AS older
+				WHERE older.pr_id = p.pr_id AND older.status = $1 AND older.id < p.id
+			)
+		ORDER BY p.id
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	), claimed AS (
+		UPDATE reviewer_publication AS p
+		SET next_attempt_at = $4
+		FROM due
+		WHERE p.id = due.id
+		RETURNING p.*
+	)
+	SELECT
+		c.id,
+		c.pr_id,
+		l.source,
+		l.repository,
+		l.number,
+		c.added,
+		c.removed,
+		c.status,
+		c.attempts,
+		c.next_attempt_at,
+		c.last_error,
+		c.published_at
+	FROM claimed AS c
+	INNER JOIN pr_host_link AS l
+		ON l.pr_id = c.pr_id
+	ORDER BY c.id
+	`
+
+	var publications []dto.PublicationDTO
+
+	if err := r.db.SelectContext(
+		ctx,
+		&publications,
+		query,
+		string(entity.PublicationPending),
+		now,
+		limit,
+		now.Add(lease),
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []entity.Publication{}, nil
+		}
+
+		return []entity.Publication{}, fmt.Errorf("failed to claim due publications: %w", err)
+	}
+
+	res := make([]entity.Publication, 0, len(publications))
+
+	for _, publication := range publications {
+		res = append(res, publication.ToPublicationEntity())
+	}
+
+	return res, nil
+}
+
+func (r *PublicationRepoPg) SaveAttempt(ctx context.Context, publication entity.Publication) error {
+	var lastError *string
+	if publication.LastError != "" {
+		lastError = &publication.LastError
+	}
+
+	var publishedAt *time.Time
+	if publication.Status == entity.PublicationPublished {
+		publishedAt = &publication.PublishedAt
+	}
+
+	query := `
+	UPDATE reviewer_publication
+	SET status = $1, attempts = $2, next_attempt_at = $3, last_error = $4, published_at = $5
+	WHERE id = $6
+	`
+
+	if _, err := r.db.ExecContext(
+		ctx,
+		query,
+		string(publication.Status),
+		publication.Attempts,
+		publication.NextAttemptAt,
+		lastError,
+		publishedAt,
+		publication.Id,
+	); err != nil {
+		return fmt.Errorf("failed to save publication attempt: %w", err)
+	}
+
+	return nil
+}
+
+type reviewerChange struct {
+	added   []string
+	removed []string
+}
+
+// Enqueue stores assignment changes from review events in tx of the change they describe,
+// so code host outage does not fail it. Changes are queued only for prs linked to code host
+func Enqueue(ctx context.Context, tx *sqlx.Tx, events ...reviewStreamEntity.ReviewEvent) error {
+	var prIds []string
+	changes := make(map[string]*reviewerChange)
+
+	for _, event := range events {
+		if event.Type != reviewStreamEntity.ReviewAssigned && event.Type != reviewStreamEntity.ReviewUnassigned {
+			continue
+		}
+
+		change, ok := changes[event.PullRequestId]
+
+		if !ok {
+			change = &reviewerChange{
+				added:   []string{},
+				removed: []string{},
+			}
+			changes[event.PullRequestId] = change
+			prIds = append(prIds, event.PullRequestId)
+		}
+
+		if event.Type == reviewStreamEntity.ReviewAssigned {
+			change.added = append(change.added, event.ReviewerId)
+		} else {
+			change.removed = append(change.removed, event.ReviewerId)
+		}
+	}
+
+	for _, prId := range prIds {
+		change := changes[prId]
+
+		// reviewer, who is unassigned and assigned back in the same change, is left as is
+		added := slices.DeleteFunc(slices.Clone(change.added), func(id string) bool {
+			return slices.Contains(change.removed, id)
+		})
+		removed := slices.DeleteFunc(slices.Clone(change.removed), func(id string) bool {
+			return slices.Contains(change.added, id)
+		})
+
+		if len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+
+		query := `
+		INSERT INTO reviewer_publication(pr_id, added, removed)
+		SELECT pr_id, $2, $3
+		FROM pr_host_link
+		WHERE pr_id = $1
+		`
+
+		if _, err := tx.ExecContext(ctx, query, prId, pq.Array(added), pq.Array(removed)); err != nil {
+			return fmt.Errorf("failed to enqueue reviewer publication: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,658 @@
+package reviewspg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	codeownersEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/entity"
+	eventEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/event/entity"
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	poolEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/entity"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+	reviewStreamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/entity"
+	outboxpg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/outbox"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/pull-request/dto"
+	reviewstreampg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/review-stream"
+	reviewerpublishpg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/reviewer-publish"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// GetTeamMembers selects members of team with everything needed to pick reviewers
+func GetTeamMembers(ctx context.Context, tx *sqlx.Tx, teamId string) ([]memberEntity.Member, error) {
+	query := `
+	SELECT
+		m.id,
+		m.activity,
+		m.max_open_reviews,
+		COALESCE(o.open_reviews, 0) AS open_reviews,
+		u.member_id IS NOT NULL AS unavailable,
+		COALESCE(s.skills, '{}') AS skills
+	FROM team_member AS m
+	LEFT JOIN open_reviews_per_members AS o
+		ON o.member_id = m.id
+	LEFT JOIN unavailable_members AS u
+		ON u.member_id = m.id
+	LEFT JOIN (
+		SELECT member_id, ARRAY_AGG(skill) AS skills
+		FROM member_skill
+		GROUP BY member_id
+	) AS s
+		ON s.member_id = m.id
+	WHERE m.id IN (SELECT member_id FROM team_membership WHERE team_id = $1)
+	`
+
+	var members []dto.MemberDTO
+
+	if err := tx.SelectContext(ctx, &members, query, teamId); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return []memberEntity.Member{}, fmt.Errorf("failed to select team members: %w", err)
+		}
+	}
+
+	res := make([]memberEntity.Member, 0, len(members))
+
+	for _, member := range members {
+		res = append(res, member.ToMemberEntity())
+	}
+
+	return res, nil
+}
+
+// GetTeamPools selects reviewer pools attached to team with members needed to pick reviewers,
+// pools without members are skipped
+func GetTeamPools(ctx context.Context, tx *sqlx.Tx, teamId string) ([]poolEntity.PoolQuota, error) {
+	query := `
+	SELECT
+		p.pool_name,
+		tp.reviewers_count,
+		m.id,
+		m.activity,
+		m.max_open_reviews,
+		COALESCE(o.open_reviews, 0) AS open_reviews,
+		u.member_id IS NOT NULL AS unavailable,
+		COALESCE(s.skills, '{}') AS skills
+	FROM team_pool AS tp
+	INNER JOIN reviewer_pool AS p
+		ON p.id = tp.pool_id
+	INNER JOIN reviewer_pool_member AS pm
+		ON pm.pool_id = tp.pool_id
+	INNER JOIN team_member AS m
+		ON m.id = pm.member_id
+	LEFT JOIN open_reviews_per_members AS o
+		ON o.member_id = m.id
+	LEFT JOIN unavailable_members AS u
+		ON u.member_id = m.id
+	LEFT JOIN (
+		SELECT member_id, ARRAY_AGG(skill) AS skills
+		FROM member_skill
+		GROUP BY member_id
+	) AS s
+		ON s.member_id = m.id
+	WHERE tp.team_id = $1
+	ORDER BY p.pool_name
+	`
+
+	var members []dto.PoolMemberDTO
+
+	if err := tx.SelectContext(ctx, &members, query, teamId); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return []poolEntity.PoolQuota{}, fmt.Errorf("failed to select pools of team: %w", err)
+		}
+	}
+
+	res := make([]poolEntity.PoolQuota, 0)
+
+	for _, member := range members {
+		if len(res) == 0 || res[len(res)-1].PoolName != member.PoolName {
+			res = append(res, poolEntity.PoolQuota{
+				PoolName:       member.PoolName,
+				ReviewersCount: member.ReviewersCount,
+			})
+		}
+
+		last := &res[len(res)-1]
+		last.Members = append(last.Members, member.ToMemberEntity())
+	}
+
+	return res, nil
+}
+
+// GetReplacementCandidates selects members of team together with members of pools attached to it
+func GetReplacementCandidates(ctx context.Context, tx *sqlx.Tx, teamId string) ([]memberEntity.Member, error) {
+	members, err := GetTeamMembers(ctx, tx, teamId)
+
+	if err != nil {
+		return []memberEntity.Member{}, err
+	}
+
+	pools, err := GetTeamPools(ctx, tx, teamId)
+
+	if err != nil {
+		return []memberEntity.Member{}, err
+	}
+
+	return poolEntity.MergeCandidates(members, pools), nil
+}
+
+// GetCodeowners selects ruleset uploaded for team, nil when there is none
+func GetCodeowners(ctx context.Context, q sqlx.QueryerContext, teamId string) (*codeownersEntity.Ruleset, error) {
+	var rules string
+
+	query := "SELECT rules FROM team_codeowners WHERE team_id = $1"
+
+	if err := sqlx.GetContext(ctx, q, &rules, query, teamId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to select codeowners of team: %w", err)
+	}
+
+	ruleset, err := codeownersEntity.Parse(rules)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse stored codeowners of team: %w", err)
+	}
+
+	return &ruleset, nil
+}
+
+// GetReviewers selects current reviewers of pr with all their teams
+func GetReviewers(ctx context.Context, tx *sqlx.Tx, prId string) ([]memberEntity.Member, error) {
+	query := `
+	SELECT
+		m.id,
+		m.activity,
+		COALESCE(t.team_name, '') AS team_name,
+		ARRAY(
+			SELECT mt.team_name
+			FROM team_membership AS tm
+			INNER JOIN team AS mt
+				ON mt.id = tm.team_id
+			WHERE tm.member_id = m.id
+			ORDER BY mt.team_name
+		) AS teams
+	FROM assigned_reviewer AS a
+	INNER JOIN team_member AS m
+		ON m.id = a.member_id
+	LEFT JOIN team AS t
+		ON t.id = m.team_id
+	WHERE a.pr_id = $1
+	`
+
+	var reviewers []dto.MemberDTO
+
+	if err := tx.SelectContext(ctx, &reviewers, query, prId); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return []memberEntity.Member{}, fmt.Errorf("failed to select reviewers of pr: %w", err)
+		}
+	}
+
+	res := make([]memberEntity.Member, 0, len(reviewers))
+
+	for _, reviewer := range reviewers {
+		res = append(res, reviewer.ToMemberEntity())
+	}
+
+	return res, nil
+}
+
+// GetReviews selects review states of reviewers of pr
+func GetReviews(ctx context.Context, q sqlx.QueryerContext, prId string) ([]prEntity.Review, error) {
+	reviews, err := GetReviewsByPullRequests(ctx, q, []string{prId})
+
+	if err != nil {
+		return []prEntity.Review{}, err
+	}
+
+	if res, ok := reviews[prId]; ok {
+		return res, nil
+	}
+
+	return []prEntity.Review{}, nil
+}
+
+// GetReviewsByPullRequests selects review states for page of pull requests with one query, pr id -> reviews
+func GetReviewsByPullRequests(
+	ctx context.Context,
+	q sqlx.QueryerContext,
+	prIds []string,
+) (map[string][]prEntity.Review, error) {
+	query := `
+	SELECT pr_id, member_id, verdict, reviewed_at, assigned_at
+	FROM assigned_reviewer
+	WHERE pr_id = ANY($1::VARCHAR[])
+	ORDER BY assigned_at, member_id
+	`
+
+	var reviews []dto.ReviewDTO
+
+	if err := sqlx.SelectContext(ctx, q, &reviews, query, pq.Array(prIds)); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to select reviews of pull requests: %w", err)
+		}
+	}
+
+	res := make(map[string][]prEntity.Review, len(prIds))
+
+	for _, review := range reviews {
+		res[review.PullRequestId] = append(res[review.PullRequestId], review.ToReviewEntity())
+	}
+
+	return res, nil
+}
+
+type teamCandidates struct {
+	name    string
+	members []memberEntity.Member
+	// member id -> index in members
+	index map[string]int
+}
+
+// ReassignOpenReviews removes reviewers from their OPEN pull requests and replaces them
+// with members picked by replace. Reviewers have to be deactivated in tx already,
+// so they are not picked again. Pull requests without replacement stay short of reviewers.
+func ReassignOpenReviews(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	reviewerIds []string,
+	replace interfaces.ReplaceHandler,
+) (prEntity.ReassignReport, error) {
+	if len(reviewerIds) == 0 {
+		return prEntity.ReassignReport{
+			Reassigned:    []prEntity.Reassignment{},
+			NotReassigned: []string{},
+		}, nil
+	}
+
+	query := `
+	SELECT
+		id,
+		pr_name,
+		author_id,
+		pr_status,
+		created_at,
+		merged_at,
+		team_id,
+		reviewers,
+		labels
+	FROM pr_with_members
+	WHERE pr_status = $1 AND reviewers && $2::VARCHAR[]
+	ORDER BY created_at
+	`
+
+	var prs []dto.PullRequestDTO
+
+	if err := tx.SelectContext(ctx, &prs, query, string(prEntity.PROpen), pq.Array(reviewerIds)); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return prEntity.ReassignReport{}, fmt.Errorf("failed to select open PRs of reviewers: %w", err)
+		}
+	}
+
+	removed := make(map[string]struct{}, len(reviewerIds))
+
+	for _, id := range reviewerIds {
+		removed[id] = struct{}{}
+	}
+
+	return reassignReviews(ctx, tx, prs, func(_ *teamCandidates, reviewer string) bool {
+		_, ok := removed[reviewer]
+		return ok
+	}, replace)
+}
+
+// ReassignForeignReviews replaces reviewers of OPEN pull requests, who are not members of team of pull request
+// or of pools attached to it, with members picked by replace. It is used after pull requests are moved to another team
+func ReassignForeignReviews(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	prIds []string,
+	replace interfaces.ReplaceHandler,
+) (prEntity.ReassignReport, error) {
+	if len(prIds) == 0 {
+		return prEntity.ReassignReport{
+			Reassigned:    []prEntity.Reassignment{},
+			NotReassigned: []string{},
+		}, nil
+	}
+
+	query := `
+	SELECT
+		id,
+		pr_name,
+		author_id,
+		pr_status,
+		created_at,
+		merged_at,
+		team_id,
+		reviewers,
+		labels
+	FROM pr_with_members
+	WHERE pr_status = $1 AND id = ANY($2::VARCHAR[])
+	ORDER BY created_at
+	`
+
+	var prs []dto.PullRequestDTO
+
+	if err := tx.SelectContext(ctx, &prs, query, string(prEntity.PROpen), pq.Array(prIds)); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return prEntity.ReassignReport{}, fmt.Errorf("failed to select open PRs to reassign: %w", err)
+		}
+	}
+
+	return reassignReviews(ctx, tx, prs, func(team *teamCandidates, reviewer string) bool {
+		_, ok := team.index[reviewer]
+		return !ok
+	}, replace)
+}
+
+// replaces reviewers of prs, for whom removed is true, and writes events of reassignment
+func reassignReviews(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	prs []dto.PullRequestDTO,
+	removed func(team *teamCandidates, reviewer string) bool,
+	replace interfaces.ReplaceHandler,
+) (prEntity.ReassignReport, error) {
+	report := prEntity.ReassignReport{
+		Reassigned:    []prEntity.Reassignment{},
+		NotReassigned: []string{},
+	}
+
+	if len(prs) == 0 {
+		return report, nil
+	}
+
+	// members are loaded once per team, load of picked reviewers is tracked in memory
+	teams := make(map[string]*teamCandidates)
+
+	oldReviewers := make([]string, 0, len(prs))
+	oldReviewersPrs := make([]string, 0, len(prs))
+
+	newReviewers := make([]string, 0, len(prs))
+	newReviewersPrs := make([]string, 0, len(prs))
+
+	reviewEvents := make([]reviewStreamEntity.ReviewEvent, 0, 2*len(prs))
+
+	for _, prDTO := range prs {
+		team, ok := teams[prDTO.TeamId]
+
+		if !ok {
+			loaded, err := getTeamCandidates(ctx, tx, prDTO.TeamId)
+
+			if err != nil {
+				return report, err
+			}
+
+			teams[prDTO.TeamId] = loaded
+			team = loaded
+		}
+
+		pr := prDTO.ToPullRequestEntity()
+		pr.Id = prDTO.Id
+
+		short := false
+
+		for _, reviewer := range prDTO.Reviewers {
+			if !removed(team, reviewer) {
+				continue
+			}
+
+			oldReviewers = append(oldReviewers, reviewer)
+			oldReviewersPrs = append(oldReviewersPrs, pr.Id)
+
+			reviewEvents = append(
+				reviewEvents,
+				reviewStreamEntity.NewReviewEvent(reviewStreamEntity.ReviewUnassigned, reviewer, pr),
+			)
+
+			newReviewer, err := replace(pr, team.name, team.members)
+
+			if err != nil {
+				if errors.Is(err, prErrors.ErrCannotReassign) {
+					short = true
+					continue
+				}
+
+				return report, err
+			}
+
+			pr.Reviewers = append(pr.Reviewers, newReviewer)
+
+			if i, ok := team.index[newReviewer]; ok {
+				team.members[i].OpenReviews++
+			}
+
+			newReviewers = append(newReviewers, newReviewer)
+			newReviewersPrs = append(newReviewersPrs, pr.Id)
+
+			reviewEvents = append(
+				reviewEvents,
+				reviewStreamEntity.NewReviewEvent(reviewStreamEntity.ReviewAssigned, newReviewer, pr),
+			)
+
+			report.Reassigned = append(report.Reassigned, prEntity.Reassignment{
+				PullRequestId: pr.Id,
+				OldReviewerId: reviewer,
+				NewReviewerId: newReviewer,
+			})
+		}
+
+		if short {
+			report.NotReassigned = append(report.NotReassigned, pr.Id)
+		}
+	}
+
+	query := `
+	DELETE FROM assigned_reviewer AS a
+	USING UNNEST($1::VARCHAR[], $2::VARCHAR[]) AS r(member_id, pr_id)
+	WHERE a.member_id = r.member_id AND a.pr_id = r.pr_id
+	`
+
+	if _, err := tx.ExecContext(ctx, query, pq.Array(oldReviewers), pq.Array(oldReviewersPrs)); err != nil {
+		return report, fmt.Errorf("failed to remove reviewers from open PRs: %w", err)
+	}
+
+	query = `
+	INSERT INTO assigned_reviewer(member_id, pr_id)
+	SELECT * FROM UNNEST($1::VARCHAR[], $2::VARCHAR[])
+	`
+
+	if _, err := tx.ExecContext(ctx, query, pq.Array(newReviewers), pq.Array(newReviewersPrs)); err != nil {
+		return report, fmt.Errorf("failed to add new reviewers to open PRs: %w", err)
+	}
+
+	events := make([]eventEntity.Event, 0, len(report.Reassigned))
+
+	for _, reassignment := range report.Reassigned {
+		events = append(events, eventEntity.NewPRReassignedEvent(reassignment))
+	}
+
+	if err := outboxpg.Write(ctx, tx, events...); err != nil {
+		return report, fmt.Errorf("failed to write reassignment events: %w", err)
+	}
+
+	if err := reviewstreampg.Write(ctx, tx, reviewEvents...); err != nil {
+		return report, fmt.Errorf("failed to write review events of reassignment: %w", err)
+	}
+
+	if err := reviewerpublishpg.Enqueue(ctx, tx, reviewEvents...); err != nil {
+		return report, fmt.Errorf("failed to enqueue reviewer publications of reassignment: %w", err)
+	}
+
+	return report, nil
+}
+
+func getTeamCandidates(ctx context.Context, tx *sqlx.Tx, teamId string) (*teamCandidates, error) {
+	var name string
+
+	query := "SELECT team_name FROM team WHERE id = $1"
+
+	if err := tx.GetContext(ctx, &name, query, teamId); err != nil {
+		return nil, fmt.Errorf("failed to get team of pr while reassign: %w", err)
+	}
+
+	members, err := GetReplacementCandidates(ctx, tx, teamId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(members))
+
+	for i, member := range members {
+		index[member.Id] = i
+	}
+
+	return &teamCandidates{
+		name:    name,
+		members: members,
+		index:   index,
+	}, nil
+}
+
+// UpdateStatus loads pr with its team, members, pools and reviews, applies handler and stores pr,
+// when handler updates it. Newly assigned reviewers, review stream and status events are written in tx
+func UpdateStatus(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	prId string,
+	updateStatusHandler interfaces.UpdateStatusHandler,
+) (prEntity.PullRequest, error) {
+	query := `
+	SELECT
+		id,
+		pr_name,
+		author_id,
+		pr_status,
+		created_at,
+		merged_at,
+		team_id,
+		reviewers,
+		labels
+	FROM pr_with_members WHERE id = $1
+	`
+
+	var pr dto.PullRequestDTO
+
+	if err := tx.GetContext(ctx, &pr, query, prId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return prEntity.PullRequest{}, prErrors.ErrNotFound
+		}
+
+		return prEntity.PullRequest{}, fmt.Errorf("failed to get pr while update status: %w", err)
+	}
+
+	var teamName string
+
+	query = "SELECT team_name FROM team WHERE id = $1"
+
+	if err := tx.GetContext(ctx, &teamName, query, pr.TeamId); err != nil {
+		// team of pr was deleted
+		if errors.Is(err, sql.ErrNoRows) {
+			return prEntity.PullRequest{}, prErrors.ErrTeamOrUserNotFound
+		}
+
+		return prEntity.PullRequest{}, fmt.Errorf("failed to get team of pr while update status: %w", err)
+	}
+
+	teamMembers, err := GetTeamMembers(ctx, tx, pr.TeamId)
+
+	if err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to get team members while update status: %w", err)
+	}
+
+	pools, err := GetTeamPools(ctx, tx, pr.TeamId)
+
+	if err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to get pools of team while update status: %w", err)
+	}
+
+	reviews, err := GetReviews(ctx, tx, prId)
+
+	if err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to get reviews while update status: %w", err)
+	}
+
+	reviewers, err := GetReviewers(ctx, tx, prId)
+
+	if err != nil {
+		return prEntity.PullRequest{}, fmt.Errorf("failed to get reviewers while update status: %w", err)
+	}
+
+	pr.Id = prId
+	prStored := pr.ToPullRequestEntity()
+	prStored.Reviews = reviews
+
+	prUpdated, updated, err := updateStatusHandler(prStored, teamName, teamMembers, reviewers, pools)
+
+	if err != nil {
+		return prEntity.PullRequest{}, err
+	}
+
+	if updated {
+		// entity fills missing merged_at with current time, so it is stored only for merged pr
+		var mergedAt *time.Time
+		if prUpdated.Status == prEntity.PRMerged {
+			mergedAt = &prUpdated.MergedAt
+		}
+
+		query = `
+		UPDATE pull_request
+		SET pr_status = $1, merged_at = $2 
+		WHERE id = $3
+		`
+
+		if _, err := tx.ExecContext(ctx, query, string(prUpdated.Status), mergedAt, prId); err != nil {
+			return prEntity.PullRequest{}, fmt.Errorf("failed to update status of pr: %w", err)
+		}
+
+		var reviewEvents []reviewStreamEntity.ReviewEvent
+
+		// reviewers assigned on entering OPEN
+		for _, reviewer := range prUpdated.Reviewers {
+			if slices.Contains(pr.Reviewers, reviewer) {
+				continue
+			}
+
+			query = "INSERT INTO assigned_reviewer(member_id, pr_id) VALUES ($1, $2)"
+
+			if _, err := tx.ExecContext(ctx, query, reviewer, prId); err != nil {
+				return prEntity.PullRequest{}, fmt.Errorf("failed to add pr reviewer while update status: %w", err)
+			}
+
+			reviewEvents = append(
+				reviewEvents,
+				reviewStreamEntity.NewReviewEvent(reviewStreamEntity.ReviewAssigned, reviewer, prUpdated),
+			)
+		}
+
+		if prUpdated.Status == prEntity.PRMerged {
+			reviewEvents = append(
+				reviewEvents,
+				reviewStreamEntity.NewReviewEvents(reviewStreamEntity.ReviewMerged, prUpdated.Reviewers, prUpdated)...,
+			)
+		}
+
+		if err := reviewstreampg.Write(ctx, tx, reviewEvents...); err != nil {
+			return prEntity.PullRequest{}, fmt.Errorf("failed to write review events while update status: %w", err)
+		}
+
+		if err := reviewerpublishpg.Enqueue(ctx, tx, reviewEvents...); err != nil {
+			return prEntity.PullRequest{}, fmt.Errorf("failed to enqueue reviewer publication while update status: %w", err)
+		}
+
+		if err := outboxpg.Write(ctx, tx, eventEntity.NewPRStatusEvent(prUpdated)); err != nil {
+			return prEntity.PullRequest{}, fmt.Errorf("failed to write event while update status: %w", err)
+		}
+	}
+
+	return prUpdated, nil
+}
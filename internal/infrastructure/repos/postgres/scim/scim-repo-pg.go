@@ -0,0 +1,185 @@
+package scimrepopg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	memberErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/errors"
+	scimErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/interfaces"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
+	memberDto "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/member/dto"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+)
+
+// member with primary team and names of all its teams
+const selectMember = `
+	SELECT
+		m.id,
+		m.username,
+		m.activity,
+		m.max_open_reviews,
+		t.team_name,
+		ARRAY(
+			SELECT mt.team_name
+			FROM team_membership AS tm
+			INNER JOIN team AS mt
+				ON mt.id = tm.team_id
+			WHERE tm.member_id = m.id
+			ORDER BY mt.team_name
+		) AS teams
+	FROM team_member AS m
+	LEFT JOIN team AS t
+		ON m.team_id = t.id
+	`
+
+type ScimRepoPg struct {
+	db     *sqlx.DB
+	logger zerolog.Logger
+}
+
+func CreateScimRepoPg(db *sqlx.DB, log zerolog.Logger) interfaces.ScimRepo {
+	return &ScimRepoPg{
+		db:     db,
+		logger: log,
+	}
+}
+
+func (r *ScimRepoPg) CreateUser(ctx context.Context, member memberEntity.Member) (memberEntity.Member, error) {
+	query := `
+	INSERT INTO team_member(id, username, activity) VALUES ($1, $2, $3)
+	ON CONFLICT(id) DO NOTHING
+	`
+
+	res, err := r.db.ExecContext(ctx, query, member.Id, member.Username, string(member.Activity))
+
+	if err != nil {
+		return memberEntity.Member{}, fmt.Errorf("failed to insert user into postgres table: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return memberEntity.Member{}, fmt.Errorf("failed to get affected rows while create user: %w", err)
+	}
+
+	if affected == 0 {
+		return memberEntity.Member{}, scimErrors.ErrUserExists
+	}
+
+	return r.GetUser(ctx, member.Id)
+}
+
+func (r *ScimRepoPg) GetUser(ctx context.Context, id string) (memberEntity.Member, error) {
+	query := selectMember + "WHERE m.id = $1"
+
+	var member memberDto.MemberDTO
+
+	if err := r.db.GetContext(ctx, &member, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return memberEntity.Member{}, memberErrors.ErrMemberNotFound
+		}
+
+		return memberEntity.Member{}, fmt.Errorf("failed to get user from postgres: %w", err)
+	}
+
+	return member.ToMemberEntity(), nil
+}
+
+func (r *ScimRepoPg) ListUsers(
+	ctx context.Context,
+	username string,
+	offset, limit int,
+) ([]memberEntity.Member, int, error) {
+	// empty username disables filter
+	where := "WHERE ($1 = '' OR LOWER(m.username) = LOWER($1))\n"
+
+	var total int
+
+	query := "SELECT COUNT(*) FROM team_member AS m " + where
+
+	if err := r.db.GetContext(ctx, &total, query, username); err != nil {
+		return nil, 0, fmt.Errorf("failed to count users in postgres: %w", err)
+	}
+
+	query = selectMember + where + "ORDER BY m.id OFFSET $2 LIMIT $3"
+
+	var members []memberDto.MemberDTO
+
+	if err := r.db.SelectContext(ctx, &members, query, username, offset, limit); err != nil {
+		return nil, 0, fmt.Errorf("failed to list users in postgres: %w", err)
+	}
+
+	res := make([]memberEntity.Member, 0, len(members))
+
+	for _, member := range members {
+		res = append(res, member.ToMemberEntity())
+	}
+
+	return res, total, nil
+}
+
+func (r *ScimRepoPg) SetUsername(ctx context.Context, id, username string) error {
+	query := "UPDATE team_member SET username = $2 WHERE id = $1"
+
+	res, err := r.db.ExecContext(ctx, query, id, username)
+
+	if err != nil {
+		return fmt.Errorf("failed to set username in postgres: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows while set username: %w", err)
+	}
+
+	if affected == 0 {
+		return memberErrors.ErrMemberNotFound
+	}
+
+	return nil
+}
+
+func (r *ScimRepoPg) GetGroupName(ctx context.Context, id string) (string, error) {
+	var name string
+
+	query := "SELECT team_name FROM team WHERE id = $1"
+
+	if err := r.db.GetContext(ctx, &name, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", teamErrors.ErrTeamNotFound
+		}
+
+		return "", fmt.Errorf("failed to get group from postgres: %w", err)
+	}
+
+	return name, nil
+}
+
+func (r *ScimRepoPg) ListGroupNames(ctx context.Context, name string, offset, limit int) ([]string, int, error) {
+	// display names are case insensitive in SCIM, but team names are not, so exact match is used
+	where := "WHERE ($1 = '' OR team_name = $1)\n"
+
+	var total int
+
+	query := "SELECT COUNT(*) FROM team " + where
+
+	if err := r.db.GetContext(ctx, &total, query, name); err != nil {
+		return nil, 0, fmt.Errorf("failed to count groups in postgres: %w", err)
+	}
+
+	query = "SELECT team_name FROM team " + where + "ORDER BY team_name OFFSET $2 LIMIT $3"
+
+	names := []string{}
+
+	if err := r.db.SelectContext(ctx, &names, query, name, offset, limit); err != nil {
+		return nil, 0, fmt.Errorf("failed to list groups in postgres: %w", err)
+	}
+
+	return names, total, nil
+}
@@ -5,9 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
 
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/statistics/entity"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/statistics/interfaces"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/statistics/dto"
 	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog"
@@ -25,17 +28,73 @@ func CreateStatsRepoPg(db *sqlx.DB, log zerolog.Logger) interfaces.StatsRepo {
 	}
 }
 
-func (r *StatsRepoPg) GetAssignmentsPerMember(ctx context.Context, limit, offset int) ([]entity.AssignmentsPerMember, error) {
-	query := `
+func (r *StatsRepoPg) GetAssignmentsPerMember(
+	ctx context.Context,
+	teamName string,
+	after *pagination.Cursor,
+	limit int,
+) ([]entity.AssignmentsPerMember, error) {
+	source := "assignments_per_members"
+	args := []any{limit}
+
+	if teamName != "" {
+		var teamId string
+
+		query := "SELECT id FROM team WHERE team_name = $1"
+
+		if err := r.db.GetContext(ctx, &teamId, query, teamName); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return []entity.AssignmentsPerMember{}, teamErrors.ErrTeamNotFound
+			}
+
+			return []entity.AssignmentsPerMember{}, fmt.Errorf("failed to get team while get assignments: %w", err)
+		}
+
+		args = append(args, teamId)
+
+		// members of team with assignments only to pull requests of this team
+		source = fmt.Sprintf(`(
+			SELECT tm.member_id, COUNT(pr.id) AS assignments_count
+			FROM team_membership AS tm
+			LEFT JOIN assigned_reviewer AS a
+				ON a.member_id = tm.member_id
+			LEFT JOIN pull_request AS pr
+				ON pr.id = a.pr_id AND pr.team_id = $%[1]d
+			WHERE tm.team_id = $%[1]d
+			GROUP BY tm.member_id
+		) AS s`, len(args))
+	}
+
+	condition := ""
+
+	if after != nil {
+		afterCount, err := strconv.Atoi(after.Value)
+
+		if err != nil {
+			return []entity.AssignmentsPerMember{}, pagination.ErrInvalidCursor
+		}
+
+		args = append(args, afterCount, after.Id)
+
+		// keyset condition for mixed order: count desc, member id asc
+		condition = fmt.Sprintf(
+			"WHERE assignments_count < $%[1]d OR (assignments_count = $%[1]d AND member_id > $%[2]d)",
+			len(args)-1,
+			len(args),
+		)
+	}
+
+	query := fmt.Sprintf(`
 	SELECT member_id, assignments_count
-	FROM assignments_per_members
+	FROM %s
+	%s
+	ORDER BY assignments_count DESC, member_id
 	LIMIT $1
-	OFFSET $2
-	`
+	`, source, condition)
 
 	var stats []dto.AssignmentsPerMember
 
-	if err := r.db.SelectContext(ctx, &stats, query, limit, offset); err != nil {
+	if err := r.db.SelectContext(ctx, &stats, query, args...); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return []entity.AssignmentsPerMember{}, nil
 		}
@@ -3,6 +3,7 @@ package dto
 import (
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
 	teamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
+	"github.com/lib/pq"
 )
 
 type MemberDTO struct {
@@ -10,6 +11,8 @@ type MemberDTO struct {
 	Username string  `db:"username"`
 	Activity string  `db:"activity"`
 	TeamId   *string `db:"team_id"`
+	// names of all teams of member
+	Teams pq.StringArray `db:"teams"`
 }
 
 func (m MemberDTO) ToMemberEntity() memberEntity.Member {
@@ -18,13 +21,15 @@ func (m MemberDTO) ToMemberEntity() memberEntity.Member {
 		Username: m.Username,
 		Activity: memberEntity.MemberActivity(m.Activity),
 		TeamId:   m.TeamId,
+		Teams:    m.Teams,
 	}
 }
 
 type TeamDTO struct {
-	Id      string `db:"id"`
-	Name    string `db:"team_name"`
-	Members []MemberDTO
+	Id       string `db:"id"`
+	Name     string `db:"team_name"`
+	Archived bool   `db:"archived"`
+	Members  []MemberDTO
 }
 
 func (t TeamDTO) ToTeamEntity() teamEntity.Team {
@@ -35,8 +40,22 @@ func (t TeamDTO) ToTeamEntity() teamEntity.Team {
 	}
 
 	return teamEntity.Team{
-		Id:      t.Id,
-		Name:    t.Name,
-		Members: members,
+		Id:       t.Id,
+		Name:     t.Name,
+		Members:  members,
+		Archived: t.Archived,
+	}
+}
+
+// teams of member before upsert
+type MemberTeamsDTO struct {
+	MemberId string         `db:"member_id"`
+	Teams    pq.StringArray `db:"teams"`
+}
+
+func (m MemberTeamsDTO) ToMemberOfOtherTeamsEntity() teamEntity.MemberOfOtherTeams {
+	return teamEntity.MemberOfOtherTeams{
+		MemberId: m.MemberId,
+		Teams:    m.Teams,
 	}
 }
@@ -5,16 +5,30 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
 
+	codeownersEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/entity"
+	eventEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/event/entity"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+	reviewStreamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/entity"
 	teamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
 	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/interfaces"
+	outboxpg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/outbox"
+	prDto "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/pull-request/dto"
+	reviewstreampg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/review-stream"
+	reviewerpublishpg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/reviewer-publish"
+	reviewspg "github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/reviews"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/team/dto"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/rs/zerolog"
 )
 
+const uniqueViolation = "23505"
+
 type TeamRepoPg struct {
 	db     *sqlx.DB
 	logger zerolog.Logger
@@ -27,11 +41,16 @@ func CreateTeamRepoPg(db *sqlx.DB, log zerolog.Logger) interfaces.TeamRepo {
 	}
 }
 
-func (r *TeamRepoPg) Upsert(ctx context.Context, team teamEntity.Team, matcher interfaces.TeamMatcher) error {
+func (r *TeamRepoPg) Upsert(
+	ctx context.Context,
+	team teamEntity.Team,
+	matcher interfaces.TeamMatcher,
+	dryRun bool,
+) (teamEntity.UpsertDiff, error) {
 	tx, err := r.db.Beginx()
 
 	if err != nil {
-		return fmt.Errorf("failed to begin tx while upsert team to postgres: %w", err)
+		return teamEntity.UpsertDiff{}, fmt.Errorf("failed to begin tx while upsert team to postgres: %w", err)
 	}
 
 	defer func() {
@@ -47,7 +66,7 @@ func (r *TeamRepoPg) Upsert(ctx context.Context, team teamEntity.Team, matcher i
 
 	if err != nil {
 		if !errors.Is(err, teamErrors.ErrTeamNotFound) {
-			return err
+			return teamEntity.UpsertDiff{}, err
 		}
 
 		updateTeam = false
@@ -57,51 +76,81 @@ func (r *TeamRepoPg) Upsert(ctx context.Context, team teamEntity.Team, matcher i
 
 	err = nil
 
+	diff := teamEntity.UpsertDiff{
+		Added:          []string{},
+		Removed:        []string{},
+		AlsoMemberOf:   []teamEntity.MemberOfOtherTeams{},
+		DroppedReviews: []teamEntity.DroppedReview{},
+	}
+
 	if updateTeam && matcher(currentTeam) {
+		// same roster changes nothing, only real upsert reports conflict
+		if dryRun {
+			if err = tx.Rollback(); err != nil {
+				return teamEntity.UpsertDiff{}, fmt.Errorf("failed to rollback dry run of upsert team: %w", err)
+			}
+
+			return diff, nil
+		}
+
 		err = teamErrors.ErrTeamExists
-		return err
+		return teamEntity.UpsertDiff{}, err
+	}
+
+	oldMembers := make(map[string]struct{}, len(currentTeam.Members))
+	for _, member := range currentTeam.Members {
+		oldMembers[member.Id] = struct{}{}
+	}
+
+	for _, member := range team.Members {
+		if _, ok := oldMembers[member.Id]; !ok {
+			diff.Added = append(diff.Added, member.Id)
+		}
 	}
 
+	// memberships are read before upsert, so added members are not yet in team
 	query := `
+	SELECT
+		m.id AS member_id,
+		ARRAY(
+			SELECT t.team_name
+			FROM team_membership AS tm
+			INNER JOIN team AS t
+				ON t.id = tm.team_id
+			WHERE tm.member_id = m.id
+			ORDER BY t.team_name
+		) AS teams
+	FROM team_member AS m
+	WHERE m.id = ANY($1::VARCHAR[])
+		AND EXISTS (SELECT 1 FROM team_membership AS tm WHERE tm.member_id = m.id)
+	ORDER BY m.id
+	`
+
+	var otherTeams []dto.MemberTeamsDTO
+
+	if err = tx.SelectContext(ctx, &otherTeams, query, pq.Array(diff.Added)); err != nil {
+		return teamEntity.UpsertDiff{}, fmt.Errorf("failed to select teams of added members: %w", err)
+	}
+
+	for _, member := range otherTeams {
+		diff.AlsoMemberOf = append(diff.AlsoMemberOf, member.ToMemberOfOtherTeamsEntity())
+	}
+
+	query = `
 	INSERT INTO team(id, team_name) VALUES ($1, $2) 
 	ON CONFLICT
 	DO NOTHING
 	`
 
 	if _, err = tx.ExecContext(ctx, query, team.Id, team.Name); err != nil {
-		return fmt.Errorf("failed to upsert team into postgres table: %w", err)
+		return teamEntity.UpsertDiff{}, fmt.Errorf("failed to upsert team into postgres table: %w", err)
 	}
 
 	newMembers := make(map[string]struct{})
 
-	// upsert new members if they are not members of other team
 	for _, member := range team.Members {
-		var m dto.MemberDTO
-
-		query = `
-		SELECT m.id 
-		FROM team_member as m
-		INNER JOIN team as t 
-			ON t.id = m.team_id 
-		WHERE m.id = $1 AND t.team_name <> $2
-		`
-
-		if err = tx.GetContext(ctx, &m, query, member.Id, team.Name); err == nil {
-			err = teamErrors.ErrMemberOfOtherTeam
-			return err
-		} else if !errors.Is(err, sql.ErrNoRows) {
-			return fmt.Errorf("failed to check if member in other team: %w", err)
-		}
-
-		query := `
-		INSERT INTO team_member(id, username, activity, team_id) VALUES ($1, $2, $3, $4) 
-		ON CONFLICT(id) DO UPDATE 
-		SET team_id = EXCLUDED.team_id
-		WHERE team_member.id = EXCLUDED.id
-		`
-
-		if _, err = tx.ExecContext(ctx, query, member.Id, member.Username, string(member.Activity), team.Id); err != nil {
-			return fmt.Errorf("failed to upsert member of team into postgres table: %w", err)
+		if err = addMember(ctx, tx, team.Id, member); err != nil {
+			return teamEntity.UpsertDiff{}, err
 		}
 
 		newMembers[member.Id] = struct{}{}
@@ -114,34 +163,149 @@ func (r *TeamRepoPg) Upsert(ctx context.Context, team teamEntity.Team, matcher i
 				continue
 			}
 
-			// delete member from reviewers of opened PR
-			query = `
-			DELETE FROM assigned_reviewer 
-			USING pull_request AS pr
-			WHERE assigned_reviewer.pr_id = pr.id 
-				AND pr.pr_status = 'OPEN' 
-				AND assigned_reviewer.member_id = $1
-			`
+			var dropped []string
+
+			if dropped, err = removeMember(ctx, tx, team.Id, oldMember.Id); err != nil {
+				return teamEntity.UpsertDiff{}, err
+			}
+
+			diff.Removed = append(diff.Removed, oldMember.Id)
 
-			if _, err = tx.ExecContext(ctx, query, oldMember.Id); err != nil {
-				return fmt.Errorf("failed to remove member from reviewers: %w", err)
+			for _, prId := range dropped {
+				diff.DroppedReviews = append(diff.DroppedReviews, teamEntity.DroppedReview{
+					PullRequestId: prId,
+					ReviewerId:    oldMember.Id,
+				})
 			}
+		}
+	}
+
+	// dry run makes the same changes to compute exact diff, but never commits them
+	if dryRun {
+		if err = tx.Rollback(); err != nil {
+			return teamEntity.UpsertDiff{}, fmt.Errorf("failed to rollback dry run of upsert team: %w", err)
+		}
+
+		return diff, nil
+	}
+
+	if err = tx.Commit(); err != nil {
+		return teamEntity.UpsertDiff{}, fmt.Errorf("failed to commit tx while upsert team postgres: %w", err)
+	}
+
+	return diff, nil
+}
+
+func (r *TeamRepoPg) Create(ctx context.Context, team teamEntity.Team) error {
+	tx, err := r.db.Beginx()
+
+	if err != nil {
+		return fmt.Errorf("failed to begin tx while create team in postgres: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				r.logger.Error().Err(err).Msg("failed to rollback transaction")
+			}
+		}
+	}()
 
-			query = "UPDATE team_member SET team_id = NULL WHERE id = $1"
+	query := "INSERT INTO team(id, team_name) VALUES ($1, $2)"
 
-			if _, err = tx.ExecContext(ctx, query, oldMember.Id); err != nil {
-				return fmt.Errorf("failed to remove member from team members: %w", err)
+	if _, err = tx.ExecContext(ctx, query, team.Id, team.Name); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == uniqueViolation {
+				err = teamErrors.ErrTeamExists
+				return err
 			}
 		}
+
+		return fmt.Errorf("failed to insert team into postgres table: %w", err)
+	}
+
+	for _, member := range team.Members {
+		if err = addMember(ctx, tx, team.Id, member); err != nil {
+			return err
+		}
 	}
 
 	if err = tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit tx while upsert team postgres: %w", err)
+		return fmt.Errorf("failed to commit tx while create team: %w", err)
 	}
 
 	return nil
 }
 
+func (r *TeamRepoPg) Replace(ctx context.Context, name string, team teamEntity.Team) (teamEntity.Team, error) {
+	tx, err := r.db.Beginx()
+
+	if err != nil {
+		return teamEntity.Team{}, fmt.Errorf("failed to begin tx while replace team in postgres: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				r.logger.Error().Err(err).Msg("failed to rollback transaction")
+			}
+		}
+	}()
+
+	currentTeam, err := r.getTeamWithMembers(ctx, tx, name)
+
+	if err != nil {
+		return teamEntity.Team{}, err
+	}
+
+	if team.Name != currentTeam.Name {
+		query := "UPDATE team SET team_name = $2 WHERE id = $1"
+
+		if _, err = tx.ExecContext(ctx, query, currentTeam.Id, team.Name); err != nil {
+			if pqErr, ok := err.(*pq.Error); ok {
+				if pqErr.Code == uniqueViolation {
+					err = teamErrors.ErrTeamExists
+					return teamEntity.Team{}, err
+				}
+			}
+
+			return teamEntity.Team{}, fmt.Errorf("failed to rename team while replace: %w", err)
+		}
+	}
+
+	newMembers := make(map[string]struct{}, len(team.Members))
+
+	for _, member := range team.Members {
+		if err = addMember(ctx, tx, currentTeam.Id, member); err != nil {
+			return teamEntity.Team{}, err
+		}
+
+		newMembers[member.Id] = struct{}{}
+	}
+
+	for _, oldMember := range currentTeam.Members {
+		if _, ok := newMembers[oldMember.Id]; ok {
+			continue
+		}
+
+		if _, err = removeMember(ctx, tx, currentTeam.Id, oldMember.Id); err != nil {
+			return teamEntity.Team{}, err
+		}
+	}
+
+	replaced, err := r.getTeamWithMembers(ctx, tx, team.Name)
+
+	if err != nil {
+		return teamEntity.Team{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return teamEntity.Team{}, fmt.Errorf("failed to commit tx while replace team: %w", err)
+	}
+
+	return replaced, nil
+}
+
 func (r *TeamRepoPg) GetByName(ctx context.Context, name string) (teamEntity.Team, error) {
 	tx, err := r.db.Beginx()
 
@@ -170,33 +334,534 @@ func (r *TeamRepoPg) GetByName(ctx context.Context, name string) (teamEntity.Tea
 	return res, nil
 }
 
-func (r *TeamRepoPg) SetActivityForAll(ctx context.Context, name string, activity memberEntity.MemberActivity) error {
-	query := `
-	UPDATE team_member 
+func (r *TeamRepoPg) DeactivateMembers(
+	ctx context.Context,
+	name string,
+	keepActive []string,
+	replace prInterfaces.ReplaceHandler,
+) (teamEntity.DeactivationReport, error) {
+	// tx is bound to ctx, so exceeded latency budget rolls back all changes
+	tx, err := r.db.BeginTxx(ctx, nil)
+
+	if err != nil {
+		return teamEntity.DeactivationReport{}, fmt.Errorf("failed to begin tx while deactivate members in postgres: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				r.logger.Error().Err(err).Msg("failed to rollback transaction")
+			}
+		}
+	}()
+
+	var teamId string
+
+	query := "SELECT id FROM team WHERE team_name = $1"
+
+	if err = tx.GetContext(ctx, &teamId, query, name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return teamEntity.DeactivationReport{}, teamErrors.ErrTeamNotFound
+		}
+
+		return teamEntity.DeactivationReport{}, fmt.Errorf("failed to get team while deactivate members: %w", err)
+	}
+
+	// single statement for the whole team, ids of other teams in keep list are ignored
+	query = `
+	UPDATE team_member
 	SET activity = $1
-	WHERE team_id = (
-		SELECT id FROM team WHERE team_name = $2
-	)
-	RETURNING team_id
+	WHERE id IN (SELECT member_id FROM team_membership WHERE team_id = $2)
+		AND activity = $3
+		AND NOT (id = ANY($4::VARCHAR[]))
+	RETURNING id
 	`
 
-	var resp struct {
-		Id string `db:"team_id"`
+	// nil array is sent as NULL, which matches no rows in NOT ANY
+	if keepActive == nil {
+		keepActive = []string{}
+	}
+
+	var deactivatedIds []string
+
+	if err = tx.SelectContext(
+		ctx,
+		&deactivatedIds,
+		query,
+		string(memberEntity.MemberInactive),
+		teamId,
+		string(memberEntity.MemberActive),
+		pq.Array(keepActive),
+	); err != nil {
+		return teamEntity.DeactivationReport{}, fmt.Errorf("failed to deactivate members of team in postgres: %w", err)
+	}
+
+	reviews := prEntity.ReassignReport{
+		Reassigned:    []prEntity.Reassignment{},
+		NotReassigned: []string{},
 	}
 
-	if err := r.db.GetContext(ctx, &resp, query, string(activity), name); err != nil {
+	if replace != nil {
+		reviews, err = reviewspg.ReassignOpenReviews(ctx, tx, deactivatedIds, replace)
+
+		if err != nil {
+			return teamEntity.DeactivationReport{}, err
+		}
+	}
+
+	events := make([]eventEntity.Event, 0, len(deactivatedIds))
+
+	for _, id := range deactivatedIds {
+		events = append(events, eventEntity.NewMemberDeactivatedEvent(id))
+	}
+
+	if err = outboxpg.Write(ctx, tx, events...); err != nil {
+		return teamEntity.DeactivationReport{}, fmt.Errorf("failed to write events while deactivate members: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return teamEntity.DeactivationReport{}, fmt.Errorf("failed to commit tx while deactivate members: %w", err)
+	}
+
+	return teamEntity.DeactivationReport{
+		Deactivated:      len(deactivatedIds),
+		Reassigned:       reviews.Reassigned,
+		ShortOfReviewers: reviews.NotReassigned,
+	}, nil
+}
+
+func (r *TeamRepoPg) SetCodeowners(ctx context.Context, name string, ruleset codeownersEntity.Ruleset) error {
+	query := `
+	INSERT INTO team_codeowners(team_id, rules, updated_at)
+	SELECT id, $2, NOW() FROM team WHERE team_name = $1
+	ON CONFLICT(team_id) DO UPDATE
+	SET rules = EXCLUDED.rules, updated_at = EXCLUDED.updated_at
+	`
+
+	res, err := r.db.ExecContext(ctx, query, name, ruleset.String())
+
+	if err != nil {
+		return fmt.Errorf("failed to upsert codeowners of team: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows while upsert codeowners: %w", err)
+	}
+
+	if affected == 0 {
+		return teamErrors.ErrTeamNotFound
+	}
+
+	return nil
+}
+
+func (r *TeamRepoPg) GetCodeowners(ctx context.Context, name string) (*codeownersEntity.Ruleset, error) {
+	var teamId string
+
+	query := "SELECT id FROM team WHERE team_name = $1"
+
+	if err := r.db.GetContext(ctx, &teamId, query, name); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return teamErrors.ErrTeamNotFound
+			return nil, teamErrors.ErrTeamNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get team while get codeowners: %w", err)
+	}
+
+	return reviewspg.GetCodeowners(ctx, r.db, teamId)
+}
+
+func (r *TeamRepoPg) Rename(ctx context.Context, name, newName string) error {
+	query := "UPDATE team SET team_name = $2 WHERE team_name = $1"
+
+	res, err := r.db.ExecContext(ctx, query, name, newName)
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == uniqueViolation {
+				return teamErrors.ErrTeamExists
+			}
 		}
 
-		return fmt.Errorf("failed to set activity for all members of team in postgres: %w", err)
+		return fmt.Errorf("failed to rename team in postgres: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows while rename team: %w", err)
+	}
+
+	if affected == 0 {
+		return teamErrors.ErrTeamNotFound
+	}
+
+	return nil
+}
+
+func (r *TeamRepoPg) SetArchived(ctx context.Context, name string, archived bool) error {
+	// time of the first archivation is kept on repeated calls
+	query := `
+	UPDATE team
+	SET archived_at = CASE WHEN $2 THEN COALESCE(archived_at, NOW()) END
+	WHERE team_name = $1
+	`
+
+	res, err := r.db.ExecContext(ctx, query, name, archived)
+
+	if err != nil {
+		return fmt.Errorf("failed to set archived of team in postgres: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows while set archived: %w", err)
+	}
+
+	if affected == 0 {
+		return teamErrors.ErrTeamNotFound
 	}
 
 	return nil
 }
 
+func (r *TeamRepoPg) Delete(
+	ctx context.Context,
+	name string,
+	mode teamEntity.DeleteMode,
+	targetTeam string,
+	closeHandler prInterfaces.UpdateStatusHandler,
+	replace prInterfaces.ReplaceHandler,
+) (teamEntity.DeletionReport, error) {
+	tx, err := r.db.Beginx()
+
+	if err != nil {
+		return teamEntity.DeletionReport{}, fmt.Errorf("failed to begin tx while delete team in postgres: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				r.logger.Error().Err(err).Msg("failed to rollback transaction")
+			}
+		}
+	}()
+
+	var teamId string
+
+	query := "SELECT id FROM team WHERE team_name = $1 FOR UPDATE"
+
+	if err = tx.GetContext(ctx, &teamId, query, name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return teamEntity.DeletionReport{}, teamErrors.ErrTeamNotFound
+		}
+
+		return teamEntity.DeletionReport{}, fmt.Errorf("failed to get team while delete team: %w", err)
+	}
+
+	// rows of pull requests are locked, so no status change slips in before deletion
+	query = `
+	SELECT
+		id,
+		pr_name,
+		author_id,
+		pr_status,
+		created_at,
+		merged_at,
+		team_id,
+		reviewers,
+		labels
+	FROM pr_with_members
+	WHERE id IN (
+		SELECT id FROM pull_request
+		WHERE team_id = $1 AND pr_status IN ($2, $3)
+		FOR UPDATE
+	)
+	ORDER BY created_at
+	`
+
+	var unfinished []prDto.PullRequestDTO
+
+	if err = tx.SelectContext(
+		ctx,
+		&unfinished,
+		query,
+		teamId,
+		string(prEntity.PROpen),
+		string(prEntity.PRDraft),
+	); err != nil {
+		return teamEntity.DeletionReport{}, fmt.Errorf("failed to select open PRs while delete team: %w", err)
+	}
+
+	report := teamEntity.DeletionReport{
+		Closed:           []string{},
+		Moved:            []string{},
+		Reassigned:       []prEntity.Reassignment{},
+		ShortOfReviewers: []string{},
+	}
+
+	ids := make([]string, 0, len(unfinished))
+
+	for _, pr := range unfinished {
+		ids = append(ids, pr.Id)
+	}
+
+	switch {
+	case len(unfinished) == 0:
+		// nothing to close or move
+
+	case mode == teamEntity.DeleteClose:
+		// pull requests are closed the same way as by status change, while team still exists
+		for _, id := range ids {
+			if _, err = reviewspg.UpdateStatus(ctx, tx, id, closeHandler); err != nil {
+				return teamEntity.DeletionReport{}, fmt.Errorf("failed to close pr while delete team: %w", err)
+			}
+		}
+
+		report.Closed = ids
+
+	case mode == teamEntity.DeleteReassign:
+		var target struct {
+			Id       string `db:"id"`
+			Archived bool   `db:"archived"`
+		}
+
+		query = "SELECT id, archived_at IS NOT NULL AS archived FROM team WHERE team_name = $1"
+
+		if err = tx.GetContext(ctx, &target, query, targetTeam); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return teamEntity.DeletionReport{}, teamErrors.ErrTeamNotFound
+			}
+
+			return teamEntity.DeletionReport{}, fmt.Errorf("failed to get target team while delete team: %w", err)
+		}
+
+		if target.Archived {
+			err = teamErrors.ErrTeamArchived
+			return teamEntity.DeletionReport{}, err
+		}
+
+		query = "UPDATE pull_request SET team_id = $1 WHERE id = ANY($2::VARCHAR[])"
+
+		if _, err = tx.ExecContext(ctx, query, target.Id, pq.Array(ids)); err != nil {
+			return teamEntity.DeletionReport{}, fmt.Errorf("failed to move PRs while delete team: %w", err)
+		}
+
+		var reassigned prEntity.ReassignReport
+
+		reassigned, err = reviewspg.ReassignForeignReviews(ctx, tx, ids, replace)
+
+		if err != nil {
+			return teamEntity.DeletionReport{}, fmt.Errorf("failed to reassign reviews of moved PRs while delete team: %w", err)
+		}
+
+		report.Moved = ids
+		report.Reassigned = reassigned.Reassigned
+		report.ShortOfReviewers = reassigned.NotReassigned
+
+	default:
+		err = teamErrors.ErrTeamHasOpenPRs
+		return teamEntity.DeletionReport{}, err
+	}
+
+	// members, who lose primary team, get one of remaining teams as primary
+	query = `
+	UPDATE team_member AS m
+	SET team_id = (
+		SELECT tm.team_id FROM team_membership AS tm
+		WHERE tm.member_id = m.id AND tm.team_id <> $1
+		ORDER BY tm.team_id
+		LIMIT 1
+	)
+	WHERE m.team_id = $1
+	`
+
+	if _, err = tx.ExecContext(ctx, query, teamId); err != nil {
+		return teamEntity.DeletionReport{}, fmt.Errorf("failed to reset primary team while delete team: %w", err)
+	}
+
+	// memberships, codeowners and pool attachments are removed by cascade, pull requests lose team
+	query = "DELETE FROM team WHERE id = $1"
+
+	if _, err = tx.ExecContext(ctx, query, teamId); err != nil {
+		return teamEntity.DeletionReport{}, fmt.Errorf("failed to delete team from postgres: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return teamEntity.DeletionReport{}, fmt.Errorf("failed to commit tx while delete team: %w", err)
+	}
+
+	return report, nil
+}
+
+func (r *TeamRepoPg) AddMembers(
+	ctx context.Context,
+	name string,
+	members []memberEntity.Member,
+) (teamEntity.Team, error) {
+	tx, err := r.db.Beginx()
+
+	if err != nil {
+		return teamEntity.Team{}, fmt.Errorf("failed to begin tx while add members in postgres: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				r.logger.Error().Err(err).Msg("failed to rollback transaction")
+			}
+		}
+	}()
+
+	var teamId string
+
+	if teamId, err = getTeamId(ctx, tx, name); err != nil {
+		return teamEntity.Team{}, err
+	}
+
+	for _, member := range members {
+		if err = addMember(ctx, tx, teamId, member); err != nil {
+			return teamEntity.Team{}, err
+		}
+	}
+
+	team, err := r.getTeamWithMembers(ctx, tx, name)
+
+	if err != nil {
+		return teamEntity.Team{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return teamEntity.Team{}, fmt.Errorf("failed to commit tx while add members: %w", err)
+	}
+
+	return team, nil
+}
+
+func (r *TeamRepoPg) RemoveMembers(ctx context.Context, name string, memberIds []string) (teamEntity.Team, error) {
+	tx, err := r.db.Beginx()
+
+	if err != nil {
+		return teamEntity.Team{}, fmt.Errorf("failed to begin tx while remove members in postgres: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				r.logger.Error().Err(err).Msg("failed to rollback transaction")
+			}
+		}
+	}()
+
+	var teamId string
+
+	if teamId, err = getTeamId(ctx, tx, name); err != nil {
+		return teamEntity.Team{}, err
+	}
+
+	if err = lockMembership(ctx, tx, teamId, memberIds); err != nil {
+		return teamEntity.Team{}, err
+	}
+
+	for _, memberId := range memberIds {
+		if _, err = removeMember(ctx, tx, teamId, memberId); err != nil {
+			return teamEntity.Team{}, err
+		}
+	}
+
+	team, err := r.getTeamWithMembers(ctx, tx, name)
+
+	if err != nil {
+		return teamEntity.Team{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return teamEntity.Team{}, fmt.Errorf("failed to commit tx while remove members: %w", err)
+	}
+
+	return team, nil
+}
+
+func (r *TeamRepoPg) MoveMembers(
+	ctx context.Context,
+	name string,
+	targetTeam string,
+	memberIds []string,
+) (teamEntity.Team, teamEntity.Team, error) {
+	tx, err := r.db.Beginx()
+
+	if err != nil {
+		return teamEntity.Team{}, teamEntity.Team{}, fmt.Errorf("failed to begin tx while move members in postgres: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				r.logger.Error().Err(err).Msg("failed to rollback transaction")
+			}
+		}
+	}()
+
+	var teamId, targetId string
+
+	if teamId, err = getTeamId(ctx, tx, name); err != nil {
+		return teamEntity.Team{}, teamEntity.Team{}, err
+	}
+
+	if targetId, err = getTeamId(ctx, tx, targetTeam); err != nil {
+		return teamEntity.Team{}, teamEntity.Team{}, err
+	}
+
+	if err = lockMembership(ctx, tx, teamId, memberIds); err != nil {
+		return teamEntity.Team{}, teamEntity.Team{}, err
+	}
+
+	for _, memberId := range memberIds {
+		query := `
+		INSERT INTO team_membership(team_id, member_id) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+		`
+
+		if _, err = tx.ExecContext(ctx, query, targetId, memberId); err != nil {
+			return teamEntity.Team{}, teamEntity.Team{}, fmt.Errorf("failed to add membership of target team: %w", err)
+		}
+
+		// target team replaces source team as primary, so it is not reset to arbitrary team on removal
+		query = "UPDATE team_member SET team_id = $1 WHERE id = $2 AND team_id = $3"
+
+		if _, err = tx.ExecContext(ctx, query, targetId, memberId, teamId); err != nil {
+			return teamEntity.Team{}, teamEntity.Team{}, fmt.Errorf("failed to move primary team of member: %w", err)
+		}
+
+		if _, err = removeMember(ctx, tx, teamId, memberId); err != nil {
+			return teamEntity.Team{}, teamEntity.Team{}, err
+		}
+	}
+
+	source, err := r.getTeamWithMembers(ctx, tx, name)
+
+	if err != nil {
+		return teamEntity.Team{}, teamEntity.Team{}, err
+	}
+
+	target, err := r.getTeamWithMembers(ctx, tx, targetTeam)
+
+	if err != nil {
+		return teamEntity.Team{}, teamEntity.Team{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return teamEntity.Team{}, teamEntity.Team{}, fmt.Errorf("failed to commit tx while move members: %w", err)
+	}
+
+	return source, target, nil
+}
+
 func (r *TeamRepoPg) getTeamWithMembers(ctx context.Context, tx *sqlx.Tx, name string) (teamEntity.Team, error) {
-	query := "SELECT id, team_name FROM team WHERE team_name = $1"
+	query := "SELECT id, team_name, archived_at IS NOT NULL AS archived FROM team WHERE team_name = $1"
 
 	var team dto.TeamDTO
 	if err := tx.GetContext(ctx, &team, query, name); err != nil {
@@ -207,7 +872,26 @@ func (r *TeamRepoPg) getTeamWithMembers(ctx context.Context, tx *sqlx.Tx, name s
 		return teamEntity.Team{}, fmt.Errorf("failed to select team from postgres table: %w", err)
 	}
 
-	query = "SELECT id, username, activity, team_id FROM team_member WHERE team_id = $1"
+	query = `
+	SELECT
+		m.id,
+		m.username,
+		m.activity,
+		m.team_id,
+		ARRAY(
+			SELECT t.team_name
+			FROM team_membership AS mt
+			INNER JOIN team AS t
+				ON t.id = mt.team_id
+			WHERE mt.member_id = m.id
+			ORDER BY t.team_name
+		) AS teams
+	FROM team_member AS m
+	INNER JOIN team_membership AS tm
+		ON tm.member_id = m.id
+	WHERE tm.team_id = $1
+	ORDER BY m.id
+	`
 
 	if err := tx.SelectContext(ctx, &team.Members, query, team.Id); err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
@@ -217,3 +901,142 @@ func (r *TeamRepoPg) getTeamWithMembers(ctx context.Context, tx *sqlx.Tx, name s
 
 	return team.ToTeamEntity(), nil
 }
+
+func getTeamId(ctx context.Context, tx *sqlx.Tx, name string) (string, error) {
+	var teamId string
+
+	query := "SELECT id FROM team WHERE team_name = $1"
+
+	if err := tx.GetContext(ctx, &teamId, query, name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", teamErrors.ErrTeamNotFound
+		}
+
+		return "", fmt.Errorf("failed to select team id from postgres table: %w", err)
+	}
+
+	return teamId, nil
+}
+
+// locks memberships of members in team, so concurrent edits of same members are serialized
+func lockMembership(ctx context.Context, tx *sqlx.Tx, teamId string, memberIds []string) error {
+	query := `
+	SELECT member_id FROM team_membership
+	WHERE team_id = $1 AND member_id = ANY($2::VARCHAR[])
+	FOR UPDATE
+	`
+
+	var found []string
+
+	if err := tx.SelectContext(ctx, &found, query, teamId, pq.Array(memberIds)); err != nil {
+		return fmt.Errorf("failed to lock memberships of team: %w", err)
+	}
+
+	unique := make(map[string]struct{}, len(memberIds))
+	for _, id := range memberIds {
+		unique[id] = struct{}{}
+	}
+
+	if len(found) != len(unique) {
+		return teamErrors.ErrMemberNotInTeam
+	}
+
+	return nil
+}
+
+// creates unknown member and adds membership, members of other teams keep their primary team
+func addMember(ctx context.Context, tx *sqlx.Tx, teamId string, member memberEntity.Member) error {
+	query := `
+	INSERT INTO team_member(id, username, activity, team_id) VALUES ($1, $2, $3, $4) 
+	ON CONFLICT(id) DO UPDATE 
+	SET team_id = COALESCE(team_member.team_id, EXCLUDED.team_id)
+	WHERE team_member.id = EXCLUDED.id
+	`
+
+	if _, err := tx.ExecContext(ctx, query, member.Id, member.Username, string(member.Activity), teamId); err != nil {
+		return fmt.Errorf("failed to upsert member of team into postgres table: %w", err)
+	}
+
+	query = `
+	INSERT INTO team_membership(team_id, member_id) VALUES ($1, $2)
+	ON CONFLICT DO NOTHING
+	`
+
+	if _, err := tx.ExecContext(ctx, query, teamId, member.Id); err != nil {
+		return fmt.Errorf("failed to add membership of team into postgres table: %w", err)
+	}
+
+	return nil
+}
+
+// drops membership and OPEN reviews of member in team, reviews in other teams stay.
+// returns ids of pull requests, which lost member as reviewer
+func removeMember(ctx context.Context, tx *sqlx.Tx, teamId string, memberId string) ([]string, error) {
+	query := `
+	DELETE FROM assigned_reviewer 
+	USING pull_request AS pr
+	WHERE assigned_reviewer.pr_id = pr.id 
+		AND pr.pr_status = 'OPEN' 
+		AND pr.team_id = $2
+		AND assigned_reviewer.member_id = $1
+	RETURNING pr.id, pr.pr_name, pr.author_id
+	`
+
+	var droppedPrs []prDto.PullRequestDTO
+
+	if err := tx.SelectContext(ctx, &droppedPrs, query, memberId, teamId); err != nil {
+		return nil, fmt.Errorf("failed to remove member from reviewers: %w", err)
+	}
+
+	sort.Slice(droppedPrs, func(i, j int) bool {
+		return droppedPrs[i].Id < droppedPrs[j].Id
+	})
+
+	dropped := make([]string, 0, len(droppedPrs))
+	reviewEvents := make([]reviewStreamEntity.ReviewEvent, 0, len(droppedPrs))
+	events := make([]eventEntity.Event, 0, len(droppedPrs))
+
+	for _, prDTO := range droppedPrs {
+		pr := prDTO.ToPullRequestEntity()
+
+		dropped = append(dropped, pr.Id)
+		reviewEvents = append(
+			reviewEvents,
+			reviewStreamEntity.NewReviewEvent(reviewStreamEntity.ReviewUnassigned, memberId, pr),
+		)
+		events = append(events, eventEntity.NewPRReviewerRemovedEvent(pr.Id, memberId))
+	}
+
+	if err := outboxpg.Write(ctx, tx, events...); err != nil {
+		return nil, fmt.Errorf("failed to write events of removed member: %w", err)
+	}
+
+	if err := reviewstreampg.Write(ctx, tx, reviewEvents...); err != nil {
+		return nil, fmt.Errorf("failed to write review events of removed member: %w", err)
+	}
+
+	if err := reviewerpublishpg.Enqueue(ctx, tx, reviewEvents...); err != nil {
+		return nil, fmt.Errorf("failed to enqueue reviewer publications of removed member: %w", err)
+	}
+
+	query = "DELETE FROM team_membership WHERE team_id = $1 AND member_id = $2"
+
+	if _, err := tx.ExecContext(ctx, query, teamId, memberId); err != nil {
+		return nil, fmt.Errorf("failed to remove member from team members: %w", err)
+	}
+
+	// member left primary team, so one of remaining teams becomes primary
+	query = `
+	UPDATE team_member
+	SET team_id = (
+		SELECT team_id FROM team_membership WHERE member_id = $1 ORDER BY team_id LIMIT 1
+	)
+	WHERE id = $1 AND team_id = $2
+	`
+
+	if _, err := tx.ExecContext(ctx, query, memberId, teamId); err != nil {
+		return nil, fmt.Errorf("failed to reset primary team of member: %w", err)
+	}
+
+	return dropped, nil
+}
@@ -0,0 +1,76 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	eventEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/event/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+	"github.com/lib/pq"
+)
+
+type WebhookDTO struct {
+	Id         string         `db:"id"`
+	Url        string         `db:"url"`
+	Secret     string         `db:"secret"`
+	EventTypes pq.StringArray `db:"event_types"`
+	CreatedAt  time.Time      `db:"created_at"`
+}
+
+func (w WebhookDTO) ToWebhookEntity() entity.Webhook {
+	eventTypes := make([]eventEntity.EventType, 0, len(w.EventTypes))
+
+	for _, eventType := range w.EventTypes {
+		eventTypes = append(eventTypes, eventEntity.EventType(eventType))
+	}
+
+	return entity.Webhook{
+		Id:         w.Id,
+		Url:        w.Url,
+		Secret:     w.Secret,
+		EventTypes: eventTypes,
+		CreatedAt:  w.CreatedAt,
+	}
+}
+
+type DeliveryDTO struct {
+	EventId        string     `db:"event_id"`
+	EventType      string     `db:"event_type"`
+	Payload        []byte     `db:"payload"`
+	EventCreatedAt time.Time  `db:"event_created_at"`
+	WebhookId      string     `db:"webhook_id"`
+	Url            string     `db:"url"`
+	Secret         string     `db:"secret"`
+	Status         string     `db:"status"`
+	Attempts       int        `db:"attempts"`
+	NextAttemptAt  time.Time  `db:"next_attempt_at"`
+	LastError      *string    `db:"last_error"`
+	DeliveredAt    *time.Time `db:"delivered_at"`
+}
+
+func (d DeliveryDTO) ToDeliveryEntity() entity.Delivery {
+	delivery := entity.Delivery{
+		Event: eventEntity.Event{
+			Id:        d.EventId,
+			Type:      eventEntity.EventType(d.EventType),
+			Payload:   json.RawMessage(d.Payload),
+			CreatedAt: d.EventCreatedAt,
+		},
+		WebhookId:     d.WebhookId,
+		Url:           d.Url,
+		Secret:        d.Secret,
+		Status:        entity.DeliveryStatus(d.Status),
+		Attempts:      d.Attempts,
+		NextAttemptAt: d.NextAttemptAt,
+	}
+
+	if d.LastError != nil {
+		delivery.LastError = *d.LastError
+	}
+
+	if d.DeliveredAt != nil {
+		delivery.DeliveredAt = *d.DeliveredAt
+	}
+
+	return delivery
+}
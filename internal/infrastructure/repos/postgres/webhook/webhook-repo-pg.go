@@ -0,0 +1,334 @@
+package webhookrepopg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+	webhookErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/interfaces"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/infrastructure/repos/postgres/webhook/dto"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog"
+)
+
+type WebhookRepoPg struct {
+	db     *sqlx.DB
+	logger zerolog.Logger
+}
+
+func CreateWebhookRepoPg(db *sqlx.DB, log zerolog.Logger) interfaces.WebhookRepo {
+	return &WebhookRepoPg{
+		db:     db,
+		logger: log,
+	}
+}
+
+func (r *WebhookRepoPg) Register(ctx context.Context, webhook entity.Webhook) error {
+	eventTypes := make([]string, 0, len(webhook.EventTypes))
+
+	for _, eventType := range webhook.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	query := `
+	INSERT INTO webhook(id, url, secret, event_types, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+	`
+
+	if _, err := r.db.ExecContext(
+		ctx,
+		query,
+		webhook.Id,
+		webhook.Url,
+		webhook.Secret,
+		pq.Array(eventTypes),
+		webhook.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("failed to register webhook in postgres: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepoPg) List(ctx context.Context) ([]entity.Webhook, error) {
+	query := `
+	SELECT id, url, secret, event_types, created_at
+	FROM webhook
+	ORDER BY created_at
+	`
+
+	var webhooks []dto.WebhookDTO
+
+	if err := r.db.SelectContext(ctx, &webhooks, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []entity.Webhook{}, nil
+		}
+
+		return []entity.Webhook{}, fmt.Errorf("failed to select webhooks: %w", err)
+	}
+
+	res := make([]entity.Webhook, 0, len(webhooks))
+
+	for _, webhook := range webhooks {
+		res = append(res, webhook.ToWebhookEntity())
+	}
+
+	return res, nil
+}
+
+func (r *WebhookRepoPg) Delete(ctx context.Context, webhookId string) error {
+	query := "DELETE FROM webhook WHERE id = $1"
+
+	res, err := r.db.ExecContext(ctx, query, webhookId)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook from postgres: %w", err)
+	}
+
+	deleted, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("failed to get deleted webhooks count: %w", err)
+	}
+
+	if deleted == 0 {
+		return webhookErrors.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (r *WebhookRepoPg) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]entity.Delivery, error) {
+	// skip locked lets several dispatchers claim disjoint batches,
+	// moving next attempt to the end of lease hides claimed deliveries from them
+	query := `
+	WITH due AS (
+		SELECT event_id, webhook_id
+		FROM webhook_delivery
+		WHERE status = $1 AND next_attempt_at <= $2
+		ORDER BY next_attempt_at
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	), claimed AS (
+		UPDATE webhook_delivery AS d
+		SET next_attempt_at = $4
+		FROM due
+		WHERE d.event_id = due.event_id AND d.webhook_id = due.webhook_id
+		RETURNING d.*
+	)
+	SELECT
+		c.event_id,
+		o.event_type,
+		o.payload,
+		o.created_at AS event_created_at,
+		c.webhook_id,
+		w.url,
+		w.secret,
+		c.status,
+		c.attempts,
+		c.next_attempt_at,
+		c.last_error,
+		c.delivered_at
+	FROM claimed AS c
+	INNER JOIN outbox AS o
+		ON o.id = c.event_id
+	INNER JOIN webhook AS w
+		ON w.id = c.webhook_id
+	ORDER BY o.created_at
+	`
+
+	var deliveries []dto.DeliveryDTO
+
+	if err := r.db.SelectContext(
+		ctx,
+		&deliveries,
+		query,
+		string(entity.DeliveryPending),
+		now,
+		limit,
+		now.Add(lease),
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []entity.Delivery{}, nil
+		}
+
+		return []entity.Delivery{}, fmt.Errorf("failed to claim due deliveries: %w", err)
+	}
+
+	res := make([]entity.Delivery, 0, len(deliveries))
+
+	for _, delivery := range deliveries {
+		res = append(res, delivery.ToDeliveryEntity())
+	}
+
+	return res, nil
+}
+
+func (r *WebhookRepoPg) SaveAttempt(ctx context.Context, delivery entity.Delivery) error {
+	var lastError *string
+	if delivery.LastError != "" {
+		lastError = &delivery.LastError
+	}
+
+	var deliveredAt *time.Time
+	if delivery.Status == entity.DeliveryDelivered {
+		deliveredAt = &delivery.DeliveredAt
+	}
+
+	query := `
+	UPDATE webhook_delivery
+	SET status = $1, attempts = $2, next_attempt_at = $3, last_error = $4, delivered_at = $5
+	WHERE event_id = $6 AND webhook_id = $7
+	`
+
+	if _, err := r.db.ExecContext(
+		ctx,
+		query,
+		string(delivery.Status),
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		lastError,
+		deliveredAt,
+		delivery.Event.Id,
+		delivery.WebhookId,
+	); err != nil {
+		return fmt.Errorf("failed to save delivery attempt: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepoPg) GetDeliveries(
+	ctx context.Context,
+	webhookId string,
+	status entity.DeliveryStatus,
+	limit int,
+) ([]entity.Delivery, error) {
+	if err := r.checkExists(ctx, webhookId); err != nil {
+		return []entity.Delivery{}, err
+	}
+
+	query := `
+	SELECT
+		d.event_id,
+		o.event_type,
+		o.payload,
+		o.created_at AS event_created_at,
+		d.webhook_id,
+		w.url,
+		w.secret,
+		d.status,
+		d.attempts,
+		d.next_attempt_at,
+		d.last_error,
+		d.delivered_at
+	FROM webhook_delivery AS d
+	INNER JOIN outbox AS o
+		ON o.id = d.event_id
+	INNER JOIN webhook AS w
+		ON w.id = d.webhook_id
+	WHERE d.webhook_id = $1 AND d.status = $2
+	ORDER BY o.created_at DESC
+	LIMIT $3
+	`
+
+	var deliveries []dto.DeliveryDTO
+
+	if err := r.db.SelectContext(ctx, &deliveries, query, webhookId, string(status), limit); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []entity.Delivery{}, nil
+		}
+
+		return []entity.Delivery{}, fmt.Errorf("failed to select deliveries of webhook: %w", err)
+	}
+
+	res := make([]entity.Delivery, 0, len(deliveries))
+
+	for _, delivery := range deliveries {
+		res = append(res, delivery.ToDeliveryEntity())
+	}
+
+	return res, nil
+}
+
+func (r *WebhookRepoPg) Replay(ctx context.Context, webhookId string, filter entity.ReplayFilter) (int, error) {
+	if err := r.checkExists(ctx, webhookId); err != nil {
+		return 0, err
+	}
+
+	// events missed by webhook, for example sent before its registration, get new deliveries,
+	// existing ones start from scratch
+	query := `
+	INSERT INTO webhook_delivery(event_id, webhook_id, status, attempts, next_attempt_at)
+	SELECT o.id, w.id, $2, 0, NOW()
+	FROM outbox AS o
+	INNER JOIN webhook AS w
+		ON w.id = $1
+	LEFT JOIN webhook_delivery AS d
+		ON d.event_id = o.id AND d.webhook_id = w.id
+	WHERE (cardinality(w.event_types) = 0 OR o.event_type = ANY(w.event_types))
+		AND (cardinality($3::VARCHAR[]) = 0 OR o.id = ANY($3::VARCHAR[]))
+		AND ($4::TIMESTAMP IS NULL OR o.created_at >= $4)
+		AND (NOT $5 OR d.status = $6)
+	ON CONFLICT (event_id, webhook_id) DO UPDATE
+	SET status = EXCLUDED.status,
+		attempts = 0,
+		next_attempt_at = EXCLUDED.next_attempt_at,
+		last_error = NULL,
+		delivered_at = NULL
+	`
+
+	eventIds := filter.EventIds
+	if eventIds == nil {
+		eventIds = []string{}
+	}
+
+	res, err := r.db.ExecContext(
+		ctx,
+		query,
+		webhookId,
+		string(entity.DeliveryPending),
+		pq.Array(eventIds),
+		filter.Since,
+		filter.DeadOnly,
+		string(entity.DeliveryDead),
+	)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to replay events in postgres: %w", err)
+	}
+
+	queued, err := res.RowsAffected()
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to get replayed deliveries count: %w", err)
+	}
+
+	return int(queued), nil
+}
+
+func (r *WebhookRepoPg) checkExists(ctx context.Context, webhookId string) error {
+	var exists bool
+
+	query := "SELECT EXISTS(SELECT 1 FROM webhook WHERE id = $1)"
+
+	if err := r.db.GetContext(ctx, &exists, query, webhookId); err != nil {
+		return fmt.Errorf("failed to check webhook existence: %w", err)
+	}
+
+	if !exists {
+		return webhookErrors.ErrWebhookNotFound
+	}
+
+	return nil
+}
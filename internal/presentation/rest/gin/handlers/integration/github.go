@@ -0,0 +1,199 @@
+package integrationhandlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/docs"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	githubEventHeader     = "X-GitHub-Event"
+	githubSignatureHeader = "X-Hub-Signature-256"
+	// the same for redeliveries of event
+	githubDeliveryHeader = "X-GitHub-Delivery"
+	githubPullRequest    = "pull_request"
+	githubPing           = "ping"
+	// pr ids of hosting are prefixed, so they do not collide with ids of other sources
+	githubIdPrefix = "gh-"
+)
+
+type githubUser struct {
+	Login string `json:"login"`
+}
+
+// fields of pull_request event used by ingestion
+type githubPullRequestEvent struct {
+	Action      string `json:"action"`
+	PullRequest struct {
+		Id       int64       `json:"id"`
+		Number   int64       `json:"number"`
+		Title    string      `json:"title"`
+		User     githubUser  `json:"user"`
+		Draft    bool        `json:"draft"`
+		Merged   bool        `json:"merged"`
+		MergedBy *githubUser `json:"merged_by"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+func (e githubPullRequestEvent) toPREvent() (entity.PREvent, bool) {
+	event := entity.PREvent{
+		PullRequestId: fmt.Sprintf("%s%d", githubIdPrefix, e.PullRequest.Id),
+		Repository:    e.Repository.FullName,
+		Number:        e.PullRequest.Number,
+		Name:          truncate(e.PullRequest.Title, maxPRNameRunes),
+		AuthorLogin:   e.PullRequest.User.Login,
+		Draft:         e.PullRequest.Draft,
+	}
+
+	switch e.Action {
+	case "opened":
+		event.Action = entity.PROpened
+
+	case "ready_for_review":
+		event.Action = entity.PRReady
+
+	case "reopened":
+		event.Action = entity.PRReopened
+
+	case "closed":
+		event.Action = entity.PRClosed
+
+		if e.PullRequest.Merged {
+			event.Action = entity.PRMerged
+
+			if e.PullRequest.MergedBy != nil {
+				event.MergedByLogin = e.PullRequest.MergedBy.Login
+			}
+		}
+
+	default:
+		return entity.PREvent{}, false
+	}
+
+	return event, true
+}
+
+// signature is hex HMAC-SHA256 of raw body prefixed with sha256=
+func validGithubSignature(secret string, body []byte, signature string) bool {
+	received, ok := strings.CutPrefix(signature, "sha256=")
+
+	if !ok {
+		return false
+	}
+
+	decoded, err := hex.DecodeString(received)
+
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(decoded, mac.Sum(nil))
+}
+
+// Add godoc
+// @Summary Принять webhook GitHub о pull request
+// @Description События `pull_request` с действиями opened, ready_for_review, reopened и closed переносятся на PR
+// @Description с идентификатором `gh-<pull_request.id>`. Логины GitHub сопоставляются пользователям по `integrations.github.users`.
+// @Description Запрос подписывается секретом вебхука в заголовке X-Hub-Signature-256, остальные события игнорируются.
+// @Tags Integrations
+// @Accept json
+// @Produce json
+// @Param X-GitHub-Event header string true "Тип события"
+// @Param X-Hub-Signature-256 header string true "Подпись тела запроса"
+// @Param X-GitHub-Delivery header string false "Идентификатор доставки, повторная доставка игнорируется"
+// @Param input body object true "Тело события GitHub"
+// @Success 200 {object} docs.IngestResponse "Событие применено или проигнорировано"
+// @Failure 400 {object} docs.ErrorResponse "Некорректное тело"
+// @Failure 401 {object} docs.ErrorResponse "Неверная подпись"
+// @Failure 404 {object} docs.ErrorResponse "PR или автор не найден"
+// @Failure 409 {object} docs.ErrorResponse "Переход статуса запрещен"
+// @Failure 422 {object} docs.ErrorResponse "Логин не сопоставлен пользователю"
+// @Router /integrations/github/webhook [post]
+func (h *IntegrationHandlers) GithubWebhook(ctx *gin.Context) {
+	log := h.localLogger(ctx, "GithubWebhook")
+
+	body, err := readPayload(ctx)
+
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to read payload")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	if !validGithubSignature(h.cfg.Github.Secret, body, ctx.GetHeader(githubSignatureHeader)) {
+		log.Warn().Msg("invalid signature")
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, docs.NewErrorResponse(
+			"UNAUTHORIZED",
+			"invalid signature",
+		))
+		return
+	}
+
+	switch eventType := ctx.GetHeader(githubEventHeader); eventType {
+	case githubPullRequest:
+
+	// sent once on webhook creation
+	case githubPing:
+		ctx.JSON(http.StatusOK, docs.NewIgnoredResponse("ping"))
+		return
+
+	default:
+		log.Info().Str("event", eventType).Msg("event is ignored")
+		ctx.JSON(http.StatusOK, docs.NewIgnoredResponse("unsupported event"))
+		return
+	}
+
+	var payload githubPullRequestEvent
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	event, ok := payload.toPREvent()
+
+	if !ok {
+		log.Info().Str("action", payload.Action).Msg("action is ignored")
+		ctx.JSON(http.StatusOK, docs.NewIgnoredResponse("unsupported action"))
+		return
+	}
+
+	event.DeliveryId = ctx.GetHeader(githubDeliveryHeader)
+
+	res, err := h.githubService.Ingest(ctx.Request.Context(), event)
+
+	if err != nil {
+		h.respondIngestError(ctx, log, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, docs.ToIngestResponse(res))
+
+	log.Info().
+		Str("pullRequestId", event.PullRequestId).
+		Str("action", string(event.Action)).
+		Bool("ignored", res.Ignored).
+		Int("unmetConditions", len(res.UnmetConditions)).
+		Msg("successfully ingested github event")
+}
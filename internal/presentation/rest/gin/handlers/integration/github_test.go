@@ -0,0 +1,290 @@
+package integrationhandlers_test
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ingestservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/integration"
+	pullrequestservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/pull-request"
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	integrationEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
+	integrationMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/mocks"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
+	prMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/mocks"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/logger"
+	integrationhandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/integration"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const githubSecret = "It's a Secret to Everybody"
+
+func fixture(t *testing.T, hosting, name string) []byte {
+	payload, err := os.ReadFile(filepath.Join("testdata", hosting, name))
+
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %s", name, err.Error())
+	}
+
+	return payload
+}
+
+func githubSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestGithubWebhook(t *testing.T) {
+	log := logger.NewTest()
+
+	prConfig := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	integrationsConfig := config.IntegrationsConfig{
+		Github: config.GitHostingConfig{
+			Secret: githubSecret,
+			Users: map[string]string{
+				"octocat": "u1",
+				"hubot":   "u2",
+			},
+		},
+	}
+
+	const prId = "gh-2134567890"
+
+	openPR := prEntity.PullRequest{
+		Id:        prId,
+		Name:      "Add search by author",
+		AuthorId:  "u1",
+		Status:    prEntity.PROpen,
+		Reviewers: []string{"u3", "u4"},
+	}
+
+	draftPR := prEntity.NewDraftPullRequest(prId, "Add search by author", "u1")
+
+	storedDraftPR := draftPR
+	storedDraftPR.Reviewers = []string{}
+
+	mergedPR := openPR
+	mergedPR.Status = prEntity.PRMerged
+	mergedPR.MergedAt = time.Date(2025, 11, 5, 16, 21, 7, 0, time.UTC)
+
+	type testCase struct {
+		what string
+
+		fixture      string
+		event        string
+		secret       string
+		callCreate   bool
+		expectedPR   prEntity.PullRequest
+		callUpdate   bool
+		repoPR       prEntity.PullRequest
+		repoError    error
+		expectedCode int
+		expectedBody string
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid signature",
+
+			fixture:      "pull_request.opened.json",
+			event:        "pull_request",
+			secret:       "wrong secret",
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: `{"error":{"code":"UNAUTHORIZED","message":"invalid signature"}}`,
+		},
+
+		{
+			what: "ping",
+
+			fixture:      "ping.json",
+			event:        "ping",
+			secret:       githubSecret,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"ignored","reason":"ping"}`,
+		},
+
+		{
+			what: "unsupported action",
+
+			fixture:      "pull_request.synchronize.json",
+			event:        "pull_request",
+			secret:       githubSecret,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"ignored","reason":"unsupported action"}`,
+		},
+
+		{
+			what: "opened pr is created",
+
+			fixture:      "pull_request.opened.json",
+			event:        "pull_request",
+			secret:       githubSecret,
+			callCreate:   true,
+			expectedPR:   prEntity.PullRequest{Id: prId, Name: "Add search by author", AuthorId: "u1", Status: prEntity.PROpen},
+			repoPR:       openPR,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"applied","action":"opened","pr":{"pull_request_id":"gh-2134567890",` +
+				`"pull_request_name":"Add search by author","author_id":"u1","status":"OPEN",` +
+				`"assigned_reviewers":["u3","u4"],` +
+				`"reviewer_states":[{"reviewer_id":"u3","state":"PENDING"},{"reviewer_id":"u4","state":"PENDING"}]}}`,
+		},
+
+		{
+			what: "opened draft is created without reviewers",
+
+			fixture:      "pull_request.opened.draft.json",
+			event:        "pull_request",
+			secret:       githubSecret,
+			callCreate:   true,
+			expectedPR:   draftPR,
+			repoPR:       storedDraftPR,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"applied","action":"opened","pr":{"pull_request_id":"gh-2134567890",` +
+				`"pull_request_name":"Add search by author","author_id":"u1","status":"DRAFT",` +
+				`"assigned_reviewers":[],"reviewer_states":[]}}`,
+		},
+
+		{
+			what: "redelivered opened event is ignored",
+
+			fixture:      "pull_request.opened.json",
+			event:        "pull_request",
+			secret:       githubSecret,
+			callCreate:   true,
+			expectedPR:   prEntity.PullRequest{Id: prId, Name: "Add search by author", AuthorId: "u1", Status: prEntity.PROpen},
+			repoError:    prErrors.ErrAlreadyExists,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"ignored","action":"opened","reason":"pr already exists"}`,
+		},
+
+		{
+			what: "merged pr is merged",
+
+			fixture:      "pull_request.closed.merged.json",
+			event:        "pull_request",
+			secret:       githubSecret,
+			callUpdate:   true,
+			repoPR:       mergedPR,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"applied","action":"merged","pr":{"pull_request_id":"gh-2134567890",` +
+				`"pull_request_name":"Add search by author","author_id":"u1","status":"MERGED",` +
+				`"assigned_reviewers":["u3","u4"],` +
+				`"reviewer_states":[{"reviewer_id":"u3","state":"PENDING"},{"reviewer_id":"u4","state":"PENDING"}]}}`,
+		},
+
+		{
+			what: "merge of unknown pr",
+
+			fixture:      "pull_request.closed.merged.json",
+			event:        "pull_request",
+			secret:       githubSecret,
+			callUpdate:   true,
+			repoError:    prErrors.ErrNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
+		{
+			what: "failed to reopen pr",
+
+			fixture:      "pull_request.reopened.json",
+			event:        "pull_request",
+			secret:       githubSecret,
+			callUpdate:   true,
+			repoError:    errors.New("db is down"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to ingest event: ` +
+				`failed to reopen pr in repo: db is down"}}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+			mockHostLinkRepo := integrationMocks.NewMockHostLinkRepo(ctrl)
+
+			if tc.callCreate {
+				mockPullRequestRepo.EXPECT().Create(
+					gomock.Any(),
+					prEntity.Matcher(tc.expectedPR),
+					"",
+					gomock.Any(),
+				).Return(tc.repoPR, tc.repoError)
+			}
+
+			// link is saved for created pr and for existing one, which may miss it after failed delivery
+			if tc.callCreate && (tc.repoError == nil || errors.Is(tc.repoError, prErrors.ErrAlreadyExists)) {
+				mockHostLinkRepo.EXPECT().Save(gomock.Any(), integrationEntity.HostLink{
+					PullRequestId: prId,
+					Source:        integrationEntity.SourceGithub,
+					Repository:    "acme/pr-service",
+					Number:        42,
+				}).Return(nil)
+			}
+
+			if tc.callUpdate {
+				mockPullRequestRepo.EXPECT().UpdateStatus(
+					gomock.Any(),
+					prId,
+					gomock.Any(),
+				).Return(tc.repoPR, tc.repoError)
+			}
+
+			pullRequestService := pullrequestservice.CreatePullRequestService(
+				mockPullRequestRepo,
+				reviewerpicker.CreateRandomPicker(),
+				nil,
+				&prConfig,
+			)
+
+			githubService := ingestservice.CreateIngestService(
+				pullRequestService,
+				integrationMocks.NewMockDeliveryRepo(ctrl),
+				mockHostLinkRepo,
+				integrationEntity.SourceGithub,
+				integrationsConfig.Github.Users,
+			)
+
+			handlers := integrationhandlers.CreateIntegrationHandlers(githubService, nil, &integrationsConfig, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", handlers.GithubWebhook)
+
+			payload := fixture(t, "github", tc.fixture)
+
+			req := httptest.NewRequest("POST", "/", bytes.NewReader(payload))
+			req.Header.Set("X-GitHub-Event", tc.event)
+			req.Header.Set("X-Hub-Signature-256", githubSignature(tc.secret, payload))
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package integrationhandlers
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/docs"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	gitlabEventHeader = "X-Gitlab-Event"
+	gitlabTokenHeader = "X-Gitlab-Token"
+	// the same for retries and manual resend of event
+	gitlabDeliveryHeader = "Idempotency-Key"
+	gitlabMergeRequest   = "Merge Request Hook"
+	gitlabIdPrefix       = "gl-"
+)
+
+// fields of merge request event used by ingestion
+type gitlabMergeRequestEvent struct {
+	// user, who triggered the event: author on open, merger on merge
+	User struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		Id int64 `json:"id"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		Id     int64  `json:"id"`
+		Iid    int64  `json:"iid"`
+		Title  string `json:"title"`
+		Action string `json:"action"`
+		Draft  bool   `json:"draft"`
+		// draft flag of GitLab before 13.x
+		WorkInProgress bool `json:"work_in_progress"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Draft *struct {
+			Previous bool `json:"previous"`
+			Current  bool `json:"current"`
+		} `json:"draft"`
+	} `json:"changes"`
+}
+
+func (e gitlabMergeRequestEvent) toPREvent() (entity.PREvent, bool) {
+	event := entity.PREvent{
+		PullRequestId: fmt.Sprintf("%s%d", gitlabIdPrefix, e.ObjectAttributes.Id),
+		Repository:    strconv.FormatInt(e.Project.Id, 10),
+		Number:        e.ObjectAttributes.Iid,
+		Name:          truncate(e.ObjectAttributes.Title, maxPRNameRunes),
+		Draft:         e.ObjectAttributes.Draft || e.ObjectAttributes.WorkInProgress,
+	}
+
+	switch e.ObjectAttributes.Action {
+	case "open":
+		event.Action = entity.PROpened
+		event.AuthorLogin = e.User.Username
+
+	case "reopen":
+		event.Action = entity.PRReopened
+
+	case "close":
+		event.Action = entity.PRClosed
+
+	case "merge":
+		event.Action = entity.PRMerged
+		event.MergedByLogin = e.User.Username
+
+	// draft is marked ready by update, other updates do not change status
+	case "update":
+		draft := e.Changes.Draft
+
+		if draft == nil || !draft.Previous || draft.Current {
+			return entity.PREvent{}, false
+		}
+
+		event.Action = entity.PRReady
+
+	default:
+		return entity.PREvent{}, false
+	}
+
+	return event, true
+}
+
+func validGitlabToken(secret, token string) bool {
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(token)) == 1
+}
+
+// Add godoc
+// @Summary Принять webhook GitLab о merge request
+// @Description События `Merge Request Hook` с действиями open, reopen, close, merge и update (снятие draft) переносятся на PR
+// @Description с идентификатором `gl-<object_attributes.id>`. Логины GitLab сопоставляются пользователям по `integrations.gitlab.users`,
+// @Description автором считается пользователь, открывший MR. Запрос подтверждается секретным токеном в заголовке X-Gitlab-Token,
+// @Description повторная доставка с тем же Idempotency-Key игнорируется, остальные события игнорируются.
+// @Tags Integrations
+// @Accept json
+// @Produce json
+// @Param X-Gitlab-Event header string true "Тип события"
+// @Param X-Gitlab-Token header string true "Секретный токен вебхука"
+// @Param Idempotency-Key header string false "Идентификатор доставки, повторная доставка игнорируется"
+// @Param input body object true "Тело события GitLab"
+// @Success 200 {object} docs.IngestResponse "Событие применено или проигнорировано"
+// @Failure 400 {object} docs.ErrorResponse "Некорректное тело"
+// @Failure 401 {object} docs.ErrorResponse "Неверный токен"
+// @Failure 404 {object} docs.ErrorResponse "PR или автор не найден"
+// @Failure 409 {object} docs.ErrorResponse "Переход статуса запрещен"
+// @Failure 422 {object} docs.ErrorResponse "Логин не сопоставлен пользователю"
+// @Router /integrations/gitlab/webhook [post]
+func (h *IntegrationHandlers) GitlabWebhook(ctx *gin.Context) {
+	log := h.localLogger(ctx, "GitlabWebhook")
+
+	if !validGitlabToken(h.cfg.Gitlab.Secret, ctx.GetHeader(gitlabTokenHeader)) {
+		log.Warn().Msg("invalid token")
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, docs.NewErrorResponse(
+			"UNAUTHORIZED",
+			"invalid token",
+		))
+		return
+	}
+
+	if eventType := ctx.GetHeader(gitlabEventHeader); eventType != gitlabMergeRequest {
+		log.Info().Str("event", eventType).Msg("event is ignored")
+		ctx.JSON(http.StatusOK, docs.NewIgnoredResponse("unsupported event"))
+		return
+	}
+
+	body, err := readPayload(ctx)
+
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to read payload")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	var payload gitlabMergeRequestEvent
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	event, ok := payload.toPREvent()
+
+	if !ok {
+		log.Info().Str("action", payload.ObjectAttributes.Action).Msg("action is ignored")
+		ctx.JSON(http.StatusOK, docs.NewIgnoredResponse("unsupported action"))
+		return
+	}
+
+	event.DeliveryId = ctx.GetHeader(gitlabDeliveryHeader)
+
+	res, err := h.gitlabService.Ingest(ctx.Request.Context(), event)
+
+	if err != nil {
+		h.respondIngestError(ctx, log, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, docs.ToIngestResponse(res))
+
+	log.Info().
+		Str("pullRequestId", event.PullRequestId).
+		Str("action", string(event.Action)).
+		Bool("ignored", res.Ignored).
+		Int("unmetConditions", len(res.UnmetConditions)).
+		Msg("successfully ingested gitlab event")
+}
@@ -0,0 +1,312 @@
+package integrationhandlers_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ingestservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/integration"
+	pullrequestservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/pull-request"
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	integrationEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/entity"
+	integrationMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/mocks"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
+	prMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/mocks"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/logger"
+	integrationhandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/integration"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const gitlabToken = "gitlab-secret-token"
+
+func TestGitlabWebhook(t *testing.T) {
+	log := logger.NewTest()
+
+	prConfig := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	integrationsConfig := config.IntegrationsConfig{
+		Gitlab: config.GitHostingConfig{
+			Secret: gitlabToken,
+			Users: map[string]string{
+				"root": "u1",
+				"jane": "u2",
+			},
+		},
+	}
+
+	const prId = "gl-92341"
+
+	openPR := prEntity.PullRequest{
+		Id:        prId,
+		Name:      "Add search by author",
+		AuthorId:  "u1",
+		Status:    prEntity.PROpen,
+		Reviewers: []string{"u3", "u4"},
+	}
+
+	draftPR := prEntity.NewDraftPullRequest(prId, "Draft: Add search by author", "u1")
+
+	storedDraftPR := draftPR
+	storedDraftPR.Reviewers = []string{}
+
+	closedPR := openPR
+	closedPR.Status = prEntity.PRClosed
+
+	type testCase struct {
+		what string
+
+		fixture      string
+		event        string
+		token        string
+		deliveryId   string
+		callClaim    bool
+		claimed      bool
+		callRelease  bool
+		callCreate   bool
+		expectedPR   prEntity.PullRequest
+		callUpdate   bool
+		repoPR       prEntity.PullRequest
+		repoError    error
+		expectedCode int
+		expectedBody string
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid token",
+
+			fixture:      "merge_request.open.json",
+			event:        "Merge Request Hook",
+			token:        "wrong token",
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: `{"error":{"code":"UNAUTHORIZED","message":"invalid token"}}`,
+		},
+
+		{
+			what: "unsupported event",
+
+			fixture:      "merge_request.open.json",
+			event:        "Push Hook",
+			token:        gitlabToken,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"ignored","reason":"unsupported event"}`,
+		},
+
+		{
+			what: "update without draft change",
+
+			fixture:      "merge_request.update.json",
+			event:        "Merge Request Hook",
+			token:        gitlabToken,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"ignored","reason":"unsupported action"}`,
+		},
+
+		{
+			what: "opened mr is created",
+
+			fixture:      "merge_request.open.json",
+			event:        "Merge Request Hook",
+			token:        gitlabToken,
+			deliveryId:   "a6b3c1f0-5d2e-4b7a-9c41-0f3e2d1c8b7a",
+			callClaim:    true,
+			claimed:      true,
+			callCreate:   true,
+			expectedPR:   prEntity.PullRequest{Id: prId, Name: "Add search by author", AuthorId: "u1", Status: prEntity.PROpen},
+			repoPR:       openPR,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"applied","action":"opened","pr":{"pull_request_id":"gl-92341",` +
+				`"pull_request_name":"Add search by author","author_id":"u1","status":"OPEN",` +
+				`"assigned_reviewers":["u3","u4"],` +
+				`"reviewer_states":[{"reviewer_id":"u3","state":"PENDING"},{"reviewer_id":"u4","state":"PENDING"}]}}`,
+		},
+
+		{
+			what: "opened draft is created without reviewers",
+
+			fixture:      "merge_request.open.draft.json",
+			event:        "Merge Request Hook",
+			token:        gitlabToken,
+			callCreate:   true,
+			expectedPR:   draftPR,
+			repoPR:       storedDraftPR,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"applied","action":"opened","pr":{"pull_request_id":"gl-92341",` +
+				`"pull_request_name":"Draft: Add search by author","author_id":"u1","status":"DRAFT",` +
+				`"assigned_reviewers":[],"reviewer_states":[]}}`,
+		},
+
+		{
+			what: "draft is marked ready",
+
+			fixture:      "merge_request.update.ready.json",
+			event:        "Merge Request Hook",
+			token:        gitlabToken,
+			callUpdate:   true,
+			repoPR:       openPR,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"applied","action":"ready","pr":{"pull_request_id":"gl-92341",` +
+				`"pull_request_name":"Add search by author","author_id":"u1","status":"OPEN",` +
+				`"assigned_reviewers":["u3","u4"],` +
+				`"reviewer_states":[{"reviewer_id":"u3","state":"PENDING"},{"reviewer_id":"u4","state":"PENDING"}]}}`,
+		},
+
+		{
+			what: "closed mr is closed",
+
+			fixture:      "merge_request.close.json",
+			event:        "Merge Request Hook",
+			token:        gitlabToken,
+			deliveryId:   "0c9d8e7f-1a2b-4c3d-8e9f-a0b1c2d3e4f5",
+			callClaim:    true,
+			claimed:      true,
+			callUpdate:   true,
+			repoPR:       closedPR,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"applied","action":"closed","pr":{"pull_request_id":"gl-92341",` +
+				`"pull_request_name":"Add search by author","author_id":"u1","status":"CLOSED",` +
+				`"assigned_reviewers":["u3","u4"],` +
+				`"reviewer_states":[{"reviewer_id":"u3","state":"PENDING"},{"reviewer_id":"u4","state":"PENDING"}]}}`,
+		},
+
+		{
+			what: "replayed close is skipped",
+
+			fixture:      "merge_request.close.json",
+			event:        "Merge Request Hook",
+			token:        gitlabToken,
+			deliveryId:   "0c9d8e7f-1a2b-4c3d-8e9f-a0b1c2d3e4f5",
+			callClaim:    true,
+			claimed:      false,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"ignored","action":"closed","reason":"duplicate delivery"}`,
+		},
+
+		{
+			what: "reopen of merged mr is released for resend",
+
+			fixture:      "merge_request.reopen.json",
+			event:        "Merge Request Hook",
+			token:        gitlabToken,
+			deliveryId:   "5e4d3c2b-1a09-4f8e-b7d6-c5b4a3928170",
+			callClaim:    true,
+			claimed:      true,
+			callRelease:  true,
+			callUpdate:   true,
+			repoError:    prErrors.ErrInvalidTransition,
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"error":{"code":"INVALID_TRANSITION","message":"pr status transition is not allowed"}}`,
+		},
+
+		{
+			what: "merge of unknown mr",
+
+			fixture:      "merge_request.merge.json",
+			event:        "Merge Request Hook",
+			token:        gitlabToken,
+			callUpdate:   true,
+			repoError:    prErrors.ErrNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+			mockDeliveryRepo := integrationMocks.NewMockDeliveryRepo(ctrl)
+			mockHostLinkRepo := integrationMocks.NewMockHostLinkRepo(ctrl)
+
+			if tc.callClaim {
+				mockDeliveryRepo.EXPECT().Claim(
+					gomock.Any(),
+					integrationEntity.SourceGitlab,
+					tc.deliveryId,
+				).Return(tc.claimed, nil)
+			}
+
+			if tc.callRelease {
+				mockDeliveryRepo.EXPECT().Release(
+					gomock.Any(),
+					integrationEntity.SourceGitlab,
+					tc.deliveryId,
+				).Return(nil)
+			}
+
+			if tc.callCreate {
+				mockPullRequestRepo.EXPECT().Create(
+					gomock.Any(),
+					prEntity.Matcher(tc.expectedPR),
+					"",
+					gomock.Any(),
+				).Return(tc.repoPR, tc.repoError)
+			}
+
+			// link is saved after creation, no case here creates existing pr
+			if tc.callCreate && tc.repoError == nil {
+				mockHostLinkRepo.EXPECT().Save(gomock.Any(), integrationEntity.HostLink{
+					PullRequestId: prId,
+					Source:        integrationEntity.SourceGitlab,
+					Repository:    "1",
+					Number:        17,
+				}).Return(nil)
+			}
+
+			if tc.callUpdate {
+				mockPullRequestRepo.EXPECT().UpdateStatus(
+					gomock.Any(),
+					prId,
+					gomock.Any(),
+				).Return(tc.repoPR, tc.repoError)
+			}
+
+			pullRequestService := pullrequestservice.CreatePullRequestService(
+				mockPullRequestRepo,
+				reviewerpicker.CreateRandomPicker(),
+				nil,
+				&prConfig,
+			)
+
+			gitlabService := ingestservice.CreateIngestService(
+				pullRequestService,
+				mockDeliveryRepo,
+				mockHostLinkRepo,
+				integrationEntity.SourceGitlab,
+				integrationsConfig.Gitlab.Users,
+			)
+
+			handlers := integrationhandlers.CreateIntegrationHandlers(nil, gitlabService, &integrationsConfig, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", handlers.GitlabWebhook)
+
+			req := httptest.NewRequest("POST", "/", bytes.NewReader(fixture(t, "gitlab", tc.fixture)))
+			req.Header.Set("X-Gitlab-Event", tc.event)
+			req.Header.Set("X-Gitlab-Token", tc.token)
+
+			if tc.deliveryId != "" {
+				req.Header.Set("Idempotency-Key", tc.deliveryId)
+			}
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
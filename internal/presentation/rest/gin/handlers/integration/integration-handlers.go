@@ -0,0 +1,130 @@
+package integrationhandlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/docs"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	integrationErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/errors"
+	integrationInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/interfaces"
+	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
+	request_id "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/request-id"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// hostings limit webhook payloads by 25 MB
+	maxPayloadBytes = 25 << 20
+	maxPRNameRunes  = 128
+)
+
+type IntegrationHandlers struct {
+	githubService integrationInterfaces.IngestService
+	gitlabService integrationInterfaces.IngestService
+	cfg           *config.IntegrationsConfig
+	logger        zerolog.Logger
+}
+
+func CreateIntegrationHandlers(
+	githubService integrationInterfaces.IngestService,
+	gitlabService integrationInterfaces.IngestService,
+	cfg *config.IntegrationsConfig,
+	log zerolog.Logger,
+) *IntegrationHandlers {
+	return &IntegrationHandlers{
+		githubService: githubService,
+		gitlabService: gitlabService,
+		cfg:           cfg,
+		logger:        log,
+	}
+}
+
+func readPayload(ctx *gin.Context) ([]byte, error) {
+	return io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxPayloadBytes))
+}
+
+func truncate(s string, maxRunes int) string {
+	runes := []rune(s)
+
+	if len(runes) <= maxRunes {
+		return s
+	}
+
+	return string(runes[:maxRunes])
+}
+
+func (h *IntegrationHandlers) respondIngestError(ctx *gin.Context, log zerolog.Logger, err error) {
+	switch {
+	case errors.Is(err, integrationErrors.ErrUnknownUser):
+		log.Warn().Err(err).Msg("unknown user")
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, docs.NewErrorResponse(
+			"UNKNOWN_USER",
+			err.Error(),
+		))
+
+	case errors.Is(err, prErrors.ErrNotFound), errors.Is(err, prErrors.ErrTeamOrUserNotFound):
+		log.Warn().Err(err).Msg("resource not found")
+		ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+			"NOT_FOUND",
+			"resource not found",
+		))
+
+	case errors.Is(err, prErrors.ErrInvalidTransition):
+		log.Warn().Msg("invalid status transition")
+		ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
+			"INVALID_TRANSITION",
+			"pr status transition is not allowed",
+		))
+
+	case errors.Is(err, prErrors.ErrNoReviewerCapacity):
+		log.Warn().Msg("no reviewer capacity")
+		ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
+			"NO_CAPACITY",
+			"not enough members with review capacity",
+		))
+
+	default:
+		log.Error().Err(err).Msg("failed to ingest event")
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+			"INTERNAL_SERVER_ERROR",
+			fmt.Sprintf("failed to ingest event: %s", err.Error()),
+		))
+	}
+}
+
+func (h *IntegrationHandlers) localLogger(ctx *gin.Context, opName string) zerolog.Logger {
+	log := h.logger.With().
+		Str("op", opName).
+		Str("requestId", ctx.GetString(request_id.REQUEST_ID_PARAM)).
+		Logger()
+
+	return log
+}
+
+// endpoints are authorized by signatures of hostings instead of admin token,
+// endpoint of hosting is registered only when its secret is configured
+func InitIntegrationHandlers(
+	r *gin.RouterGroup,
+	log zerolog.Logger,
+	githubService integrationInterfaces.IngestService,
+	gitlabService integrationInterfaces.IngestService,
+	cfg *config.IntegrationsConfig,
+) {
+	handlers := CreateIntegrationHandlers(githubService, gitlabService, cfg, log)
+
+	group := r.Group("integrations")
+
+	{
+		if cfg.Github.Secret != "" {
+			group.POST("github/webhook", handlers.GithubWebhook)
+		}
+
+		if cfg.Gitlab.Secret != "" {
+			group.POST("gitlab/webhook", handlers.GitlabWebhook)
+		}
+	}
+}
@@ -1,15 +1,22 @@
 package memberhandlers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/SmokingElk/avito-2025-autumn-intership/docs"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
 	memberErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/errors"
 	memberInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/interfaces"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
 	pullRequestInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+	pageparams "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/page-params"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/auth"
 	request_id "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/request-id"
 	"github.com/gin-gonic/gin"
@@ -41,7 +48,7 @@ func CreateMemberHandlers(
 // @Accept json
 // @Produce json
 // @Param input body docs.SetIsActiveRequest true "Данные для обновления"
-// @Success 200 {object} docs.SetIsActiveResponse "Обновленный пользователь"
+// @Success 200 {object} docs.SetIsActiveResponse "Обновленный пользователь и результат переназначения его открытых ревью"
 // @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
 // @Failure 404 {object} docs.ErrorResponse "Пользователь не найден"
 // @Router /users/setIsActive [post]
@@ -59,7 +66,12 @@ func (h *MemberHandlers) SetIsActive(ctx *gin.Context) {
 		return
 	}
 
-	member, err := h.memberService.SetIsActive(ctx.Request.Context(), request.UserId, request.IsActive)
+	member, report, err := h.memberService.SetIsActive(
+		ctx.Request.Context(),
+		request.UserId,
+		request.IsActive,
+		request.ReassignReviews,
+	)
 
 	if err != nil {
 		switch {
@@ -80,19 +92,493 @@ func (h *MemberHandlers) SetIsActive(ctx *gin.Context) {
 		return
 	}
 
-	resp := docs.ToSetIsActiveResponse(member)
+	resp := docs.ToSetIsActiveResponse(member, report)
 	ctx.JSON(http.StatusOK, resp)
 
 	log.Info().Msg("successfully updated member activity")
 }
 
+// Add godoc
+// @Summary Установить лимит открытых ревью для пользователя
+// @Tags Users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.SetMaxOpenReviewsRequest true "Данные для обновления (null снимает лимит)"
+// @Success 200 {object} docs.SetMaxOpenReviewsResponse "Обновленный пользователь"
+// @Failure 400 {object} docs.ErrorResponse "Некорректный лимит"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Пользователь не найден"
+// @Router /users/setMaxOpenReviews [post]
+func (h *MemberHandlers) SetMaxOpenReviews(ctx *gin.Context) {
+	log := h.localLogger(ctx, "SetMaxOpenReviews")
+
+	var request docs.SetMaxOpenReviewsRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	member, err := h.memberService.SetMaxOpenReviews(ctx.Request.Context(), request.UserId, request.MaxOpenReviews)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, memberErrors.ErrInvalidMaxOpenReviews):
+			log.Warn().Msg("invalid max open reviews")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				"max_open_reviews must not be negative",
+			))
+
+		case errors.Is(err, memberErrors.ErrMemberNotFound):
+			log.Warn().Msg("user not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to set max open reviews")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to set max open reviews: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.ToSetMaxOpenReviewsResponse(member)
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Msg("successfully updated member max open reviews")
+}
+
+// Add godoc
+// @Summary Добавить период недоступности пользователя (отпуск, больничный, дежурство)
+// @Tags Users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.AddUnavailabilityRequest true "Период недоступности"
+// @Success 201 {object} docs.UnavailabilityResponse "Созданный период"
+// @Failure 400 {object} docs.ErrorResponse "Некорректный период"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Пользователь не найден"
+// @Router /users/addUnavailability [post]
+func (h *MemberHandlers) AddUnavailability(ctx *gin.Context) {
+	log := h.localLogger(ctx, "AddUnavailability")
+
+	var request docs.AddUnavailabilityRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	unavailability, err := h.memberService.AddUnavailability(
+		ctx.Request.Context(),
+		request.UserId,
+		memberEntity.UnavailabilityKind(request.Kind),
+		request.StartsAt,
+		request.EndsAt,
+	)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, memberErrors.ErrInvalidUnavailability):
+			log.Warn().Msg("invalid unavailability")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				"kind must be one of VACATION, SICK_LEAVE, ON_CALL and starts_at must be before ends_at",
+			))
+
+		case errors.Is(err, memberErrors.ErrMemberNotFound):
+			log.Warn().Msg("user not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to add unavailability")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to add unavailability: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, docs.ToUnavailabilityResponse(unavailability))
+
+	log.Info().Msg("successfully added unavailability")
+}
+
+// Add godoc
+// @Summary Получить периоды недоступности пользователя
+// @Tags Users
+// @Security BearerAuth
+// @Param user_id query string true "Идентификатор пользователя"
+// @Produce json
+// @Success 200 {object} docs.GetUnavailabilityResponse "Периоды недоступности, упорядоченные по началу"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Пользователь не найден"
+// @Router /users/getUnavailability [get]
+func (h *MemberHandlers) GetUnavailability(ctx *gin.Context) {
+	log := h.localLogger(ctx, "GetUnavailability")
+
+	userId := ctx.Query("user_id")
+
+	if userId == "" {
+		log.Warn().Msg("invalid user_id param")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid user_id param",
+		))
+		return
+	}
+
+	unavailability, err := h.memberService.GetUnavailability(ctx.Request.Context(), userId)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, memberErrors.ErrMemberNotFound):
+			log.Warn().Msg("user not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to get unavailability")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to get unavailability: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.GetUnavailabilityResponse{
+		UserId:         userId,
+		Unavailability: make([]docs.UnavailabilityResponse, 0, len(unavailability)),
+	}
+
+	for _, u := range unavailability {
+		resp.Unavailability = append(resp.Unavailability, docs.ToUnavailabilityResponse(u))
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Msg("successfully got unavailability")
+}
+
+// Add godoc
+// @Summary Изменить период недоступности пользователя
+// @Tags Users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.UpdateUnavailabilityRequest true "Новые данные периода"
+// @Success 200 {object} docs.UnavailabilityResponse "Обновленный период"
+// @Failure 400 {object} docs.ErrorResponse "Некорректный период"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Период не найден"
+// @Router /users/updateUnavailability [post]
+func (h *MemberHandlers) UpdateUnavailability(ctx *gin.Context) {
+	log := h.localLogger(ctx, "UpdateUnavailability")
+
+	var request docs.UpdateUnavailabilityRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	unavailability, err := h.memberService.UpdateUnavailability(
+		ctx.Request.Context(),
+		request.Id,
+		memberEntity.UnavailabilityKind(request.Kind),
+		request.StartsAt,
+		request.EndsAt,
+	)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, memberErrors.ErrInvalidUnavailability):
+			log.Warn().Msg("invalid unavailability")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				"kind must be one of VACATION, SICK_LEAVE, ON_CALL and starts_at must be before ends_at",
+			))
+
+		case errors.Is(err, memberErrors.ErrUnavailabilityNotFound):
+			log.Warn().Msg("unavailability not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to update unavailability")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to update unavailability: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, docs.ToUnavailabilityResponse(unavailability))
+
+	log.Info().Msg("successfully updated unavailability")
+}
+
+// Add godoc
+// @Summary Удалить период недоступности пользователя
+// @Tags Users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.DeleteUnavailabilityRequest true "Идентификатор периода"
+// @Success 200 {object} docs.DeleteUnavailabilityResponse "Период удален"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Период не найден"
+// @Router /users/deleteUnavailability [post]
+func (h *MemberHandlers) DeleteUnavailability(ctx *gin.Context) {
+	log := h.localLogger(ctx, "DeleteUnavailability")
+
+	var request docs.DeleteUnavailabilityRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	if err := h.memberService.DeleteUnavailability(ctx.Request.Context(), request.Id); err != nil {
+		switch {
+		case errors.Is(err, memberErrors.ErrUnavailabilityNotFound):
+			log.Warn().Msg("unavailability not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to delete unavailability")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to delete unavailability: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.DeleteUnavailabilityResponse{
+		Result: "ok",
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Msg("successfully deleted unavailability")
+}
+
+// Add godoc
+// @Summary Получить навыки пользователя
+// @Tags Users
+// @Security BearerAuth
+// @Param user_id query string true "Идентификатор пользователя"
+// @Produce json
+// @Success 200 {object} docs.MemberSkillsResponse "Навыки пользователя"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Пользователь не найден"
+// @Router /users/getSkills [get]
+func (h *MemberHandlers) GetSkills(ctx *gin.Context) {
+	log := h.localLogger(ctx, "GetSkills")
+
+	userId := ctx.Query("user_id")
+
+	if userId == "" {
+		log.Warn().Msg("invalid user_id param")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid user_id param",
+		))
+		return
+	}
+
+	skills, err := h.memberService.GetSkills(ctx.Request.Context(), userId)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, memberErrors.ErrMemberNotFound):
+			log.Warn().Msg("user not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to get skills")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to get skills: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.MemberSkillsResponse{
+		UserId: userId,
+		Skills: skills,
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Msg("successfully got skills")
+}
+
+// Add godoc
+// @Summary Заменить навыки пользователя, ревьюверы с навыками под метки PR назначаются в первую очередь
+// @Tags Users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.MemberSkillsRequest true "Новый набор навыков"
+// @Success 200 {object} docs.MemberSkillsResponse "Навыки пользователя после изменения"
+// @Failure 400 {object} docs.ErrorResponse "Некорректные навыки"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Пользователь не найден"
+// @Router /users/setSkills [post]
+func (h *MemberHandlers) SetSkills(ctx *gin.Context) {
+	h.changeSkills(ctx, "SetSkills", "set", h.memberService.SetSkills)
+}
+
+// Add godoc
+// @Summary Добавить навыки пользователю
+// @Tags Users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.MemberSkillsRequest true "Добавляемые навыки"
+// @Success 200 {object} docs.MemberSkillsResponse "Навыки пользователя после изменения"
+// @Failure 400 {object} docs.ErrorResponse "Некорректные навыки"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Пользователь не найден"
+// @Router /users/addSkills [post]
+func (h *MemberHandlers) AddSkills(ctx *gin.Context) {
+	h.changeSkills(ctx, "AddSkills", "add", h.memberService.AddSkills)
+}
+
+// Add godoc
+// @Summary Удалить навыки пользователя
+// @Tags Users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.MemberSkillsRequest true "Удаляемые навыки"
+// @Success 200 {object} docs.MemberSkillsResponse "Навыки пользователя после изменения"
+// @Failure 400 {object} docs.ErrorResponse "Некорректные навыки"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Пользователь не найден"
+// @Router /users/deleteSkills [post]
+func (h *MemberHandlers) DeleteSkills(ctx *gin.Context) {
+	h.changeSkills(ctx, "DeleteSkills", "delete", h.memberService.DeleteSkills)
+}
+
+func (h *MemberHandlers) changeSkills(
+	ctx *gin.Context,
+	opName string,
+	action string,
+	change func(ctx context.Context, userId string, skills []string) ([]string, error),
+) {
+	log := h.localLogger(ctx, opName)
+
+	var request docs.MemberSkillsRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	skills, err := change(ctx.Request.Context(), request.UserId, request.Skills)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, memberErrors.ErrInvalidSkills):
+			log.Warn().Msg("invalid skills")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				err.Error(),
+			))
+
+		case errors.Is(err, memberErrors.ErrMemberNotFound):
+			log.Warn().Msg("user not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to change skills")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to %s skills: %s", action, err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.MemberSkillsResponse{
+		UserId: request.UserId,
+		Skills: skills,
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Strs("skills", skills).Msg("successfully changed skills")
+}
+
 // Add godoc
 // @Summary Получить PR'ы, где пользователь установлен ревьювером
 // @Tags Users
 // @Security BearerAuth
 // @Param user_id query string true "Идентификатор пользователя"
+// @Param status query string false "Статус PR: OPEN (по умолчанию), DRAFT, MERGED, CLOSED или ALL для всех статусов"
+// @Param order query string false "Порядок по времени создания: asc (по умолчанию, сначала старые) или desc"
+// @Param limit query int false "Размер страницы, по умолчанию максимальный"
+// @Param cursor query string false "Курсор следующей страницы из предыдущего ответа"
 // @Produce json
-// @Success 200 {object} docs.GetReviewResponse "Список PR'ов пользователя"
+// @Success 200 {object} docs.GetReviewResponse "Страница PR'ов пользователя в порядке создания"
+// @Failure 400 {object} docs.ErrorResponse "Неверные параметры запроса или курсор"
 // @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
 // @Router /users/getReview [get]
 func (h *MemberHandlers) GetReview(ctx *gin.Context) {
@@ -109,14 +595,41 @@ func (h *MemberHandlers) GetReview(ctx *gin.Context) {
 		return
 	}
 
-	prs, err := h.pullRequestService.GetByReviewer(ctx.Request.Context(), userId)
+	after, limit, err := pageparams.Parse(ctx)
 
 	if err != nil {
-		log.Error().Err(err).Msg("failed to get pr's by user id")
-		ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
-			"INTERNAL_SERVER_ERROR",
-			fmt.Sprintf("failed to get pr's by user id: %s", err.Error()),
+		log.Warn().Err(err).Msg("invalid page params")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			err.Error(),
 		))
+		return
+	}
+
+	prs, next, err := h.pullRequestService.GetByReviewer(ctx.Request.Context(), prEntity.ReviewQueueQuery{
+		ReviewerId: userId,
+		Status:     prEntity.PRStatus(ctx.Query("status")),
+		Order:      pagination.Order(ctx.Query("order")),
+		After:      after,
+		Limit:      limit,
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, prErrors.ErrInvalidListQuery), errors.Is(err, pagination.ErrInvalidCursor):
+			log.Warn().Err(err).Msg("invalid page params")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				err.Error(),
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to get pr's by user id")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to get pr's by user id: %s", err.Error()),
+			))
+		}
 
 		return
 	}
@@ -126,8 +639,14 @@ func (h *MemberHandlers) GetReview(ctx *gin.Context) {
 		PullRequests: make([]docs.GetReviewPRResponse, 0, len(prs)),
 	}
 
+	now := time.Now()
+
 	for _, pr := range prs {
-		resp.PullRequests = append(resp.PullRequests, docs.ToReviewPRResponse(pr))
+		resp.PullRequests = append(resp.PullRequests, docs.ToReviewPRResponse(pr, userId, now))
+	}
+
+	if next != nil {
+		resp.NextCursor = next.Encode()
 	}
 
 	ctx.JSON(http.StatusOK, resp)
@@ -157,6 +676,15 @@ func InitMemberHandlers(
 
 	{
 		group.POST("setIsActive", auth.WithAuth(cfg), handlers.SetIsActive)
+		group.POST("setMaxOpenReviews", auth.WithAuth(cfg), handlers.SetMaxOpenReviews)
 		group.GET("getReview", auth.WithAuth(cfg), handlers.GetReview)
+		group.POST("addUnavailability", auth.WithAuth(cfg), handlers.AddUnavailability)
+		group.GET("getUnavailability", auth.WithAuth(cfg), handlers.GetUnavailability)
+		group.POST("updateUnavailability", auth.WithAuth(cfg), handlers.UpdateUnavailability)
+		group.POST("deleteUnavailability", auth.WithAuth(cfg), handlers.DeleteUnavailability)
+		group.GET("getSkills", auth.WithAuth(cfg), handlers.GetSkills)
+		group.POST("setSkills", auth.WithAuth(cfg), handlers.SetSkills)
+		group.POST("addSkills", auth.WithAuth(cfg), handlers.AddSkills)
+		group.POST("deleteSkills", auth.WithAuth(cfg), handlers.DeleteSkills)
 	}
 }
@@ -7,13 +7,16 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	memberservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/member"
 	pullrequestservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/pull-request"
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
 	memberErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/errors"
 	memberMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/mocks"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
 	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
 	prMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/mocks"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/logger"
@@ -38,6 +41,7 @@ func TestSetIsActive(t *testing.T) {
 		body             string
 		expectedActivity memberEntity.MemberActivity
 		expectedMember   memberEntity.Member
+		report           prEntity.ReassignReport
 		repoError        error
 		expectedCode     int
 		expectedBody     string
@@ -101,6 +105,39 @@ func TestSetIsActive(t *testing.T) {
 			expectedBody: `{"user_id":"u1","username":"Bob","team_name":"team1","is_active":false}`,
 		},
 
+		{
+			what: "successfully set inactive with reassignment",
+
+			userId: "u1",
+			body: `{
+				"is_active": false,
+				"user_id": "u1",
+				"reassign_reviews": true
+			}`,
+			expectedActivity: memberEntity.MemberInactive,
+			expectedMember: memberEntity.Member{
+				Id:       "u1",
+				Username: "Bob",
+				Activity: memberEntity.MemberInactive,
+				TeamName: "team1",
+			},
+			report: prEntity.ReassignReport{
+				Reassigned: []prEntity.Reassignment{
+					{
+						PullRequestId: "pr1",
+						OldReviewerId: "u1",
+						NewReviewerId: "u2",
+					},
+				},
+				NotReassigned: []string{"pr2"},
+			},
+			repoError:    nil,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"user_id":"u1","username":"Bob","team_name":"team1","is_active":false,` +
+				`"reassigned":[{"pull_request_id":"pr1","old_reviewer_id":"u1","new_reviewer_id":"u2"}],` +
+				`"not_reassigned":["pr2"]}`,
+		},
+
 		{
 			what: "successfully set active",
 
@@ -120,6 +157,27 @@ func TestSetIsActive(t *testing.T) {
 			expectedCode: http.StatusOK,
 			expectedBody: `{"user_id":"u1","username":"Bob","team_name":"team1","is_active":true}`,
 		},
+
+		{
+			what: "successfully set active member of several teams",
+
+			userId: "u1",
+			body: `{
+				"is_active": true,
+  				"user_id": "u1"
+			}`,
+			expectedActivity: memberEntity.MemberActive,
+			expectedMember: memberEntity.Member{
+				Id:       "u1",
+				Username: "Bob",
+				Activity: memberEntity.MemberActive,
+				TeamName: "team1",
+				Teams:    []string{"team1", "team2"},
+			},
+			repoError:    nil,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"user_id":"u1","username":"Bob","team_name":"team1","teams":["team1","team2"],"is_active":true}`,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -133,12 +191,13 @@ func TestSetIsActive(t *testing.T) {
 				gomock.Any(),
 				tc.userId,
 				tc.expectedActivity,
-			).Return(tc.expectedMember, tc.repoError).MaxTimes(1)
+				gomock.Any(),
+			).Return(tc.expectedMember, tc.report, tc.repoError).MaxTimes(1)
 
 			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
 
-			memberService := memberservice.CreateMemberService(mockMemberRepo)
-			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, &config)
+			memberService := memberservice.CreateMemberService(mockMemberRepo, reviewerpicker.CreateRandomPicker(), &config)
+			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
 
 			handlers := memberhandlers.CreateMemberHandlers(memberService, pullRequestService, log)
 
@@ -159,6 +218,156 @@ func TestSetIsActive(t *testing.T) {
 	}
 }
 
+func TestSetMaxOpenReviews(t *testing.T) {
+	log := logger.NewTest()
+
+	config := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	limit := 3
+
+	type testCase struct {
+		what string
+
+		userId         string
+		body           string
+		maxOpenReviews *int
+		expectedMember memberEntity.Member
+		repoError      error
+		expectedCode   int
+		expectedBody   string
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid body",
+
+			body:         "{",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid body"}}`,
+		},
+
+		{
+			what: "negative limit",
+
+			body: `{
+				"max_open_reviews": -1,
+				"user_id": "u1"
+			}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"max_open_reviews must not be negative"}}`,
+		},
+
+		{
+			what: "user not found",
+
+			userId: "u99",
+			body: `{
+				"max_open_reviews": 3,
+				"user_id": "u99"
+			}`,
+			maxOpenReviews: &limit,
+			repoError:      memberErrors.ErrMemberNotFound,
+			expectedCode:   http.StatusNotFound,
+			expectedBody:   `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
+		{
+			what: "failed to set max open reviews",
+
+			userId: "u1",
+			body: `{
+				"max_open_reviews": 3,
+				"user_id": "u1"
+			}`,
+			maxOpenReviews: &limit,
+			repoError:      errors.New("db is down"),
+			expectedCode:   http.StatusInternalServerError,
+			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to set max open reviews: ` +
+				`failed to set max open reviews in repo: db is down"}}`,
+		},
+
+		{
+			what: "successfully set limit",
+
+			userId: "u1",
+			body: `{
+				"max_open_reviews": 3,
+				"user_id": "u1"
+			}`,
+			maxOpenReviews: &limit,
+			expectedMember: memberEntity.Member{
+				Id:             "u1",
+				Username:       "Bob",
+				Activity:       memberEntity.MemberActive,
+				TeamName:       "team1",
+				MaxOpenReviews: &limit,
+			},
+			repoError:    nil,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"user_id":"u1","username":"Bob","team_name":"team1","is_active":true,"max_open_reviews":3}`,
+		},
+
+		{
+			what: "successfully remove limit",
+
+			userId: "u1",
+			body: `{
+				"max_open_reviews": null,
+				"user_id": "u1"
+			}`,
+			maxOpenReviews: nil,
+			expectedMember: memberEntity.Member{
+				Id:       "u1",
+				Username: "Bob",
+				Activity: memberEntity.MemberActive,
+				TeamName: "team1",
+			},
+			repoError:    nil,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"user_id":"u1","username":"Bob","team_name":"team1","is_active":true,"max_open_reviews":null}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMemberRepo := memberMocks.NewMockMemberRepo(ctrl)
+
+			mockMemberRepo.EXPECT().SetMaxOpenReviews(
+				gomock.Any(),
+				tc.userId,
+				tc.maxOpenReviews,
+			).Return(tc.expectedMember, tc.repoError).MaxTimes(1)
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			memberService := memberservice.CreateMemberService(mockMemberRepo, reviewerpicker.CreateRandomPicker(), &config)
+			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
+
+			handlers := memberhandlers.CreateMemberHandlers(memberService, pullRequestService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", handlers.SetMaxOpenReviews)
+
+			body := bytes.NewBufferString(tc.body)
+			req := httptest.NewRequest("POST", "/", body)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
 func TestGetReview(t *testing.T) {
 	log := logger.NewTest()
 
@@ -167,58 +376,127 @@ func TestGetReview(t *testing.T) {
 		TargetReviewersCount: 2,
 	}
 
+	reviewerQuery := func(status prEntity.PRStatus, order pagination.Order, after *pagination.Cursor, limit int) prEntity.PRListQuery {
+		return prEntity.PRListQuery{
+			Filter: prEntity.PRFilter{ReviewerId: "u1", Status: status},
+			SortBy: prEntity.PRSortCreatedAt,
+			Order:  order,
+			After:  after,
+			Limit:  limit,
+		}
+	}
+
+	// age is counted from the time of request, so PRs are created relative to now
+	createdAt := time.Now().Add(-2 * time.Hour).UTC()
+	assignedAt := createdAt.Add(time.Hour)
+
+	repoPRs := []prEntity.PullRequest{
+		{
+			Id:        "pr1",
+			Name:      "pull request 1",
+			AuthorId:  "u2",
+			Status:    prEntity.PROpen,
+			CreatedAt: createdAt,
+			Reviewers: []string{"u1"},
+			Reviews: []prEntity.Review{
+				{
+					ReviewerId: "u1",
+					Verdict:    prEntity.VerdictPending,
+					AssignedAt: assignedAt,
+				},
+			},
+		},
+		{
+			Id:        "pr2",
+			Name:      "pull request 2",
+			AuthorId:  "u3",
+			Status:    prEntity.PRMerged,
+			CreatedAt: createdAt.Add(time.Hour),
+		},
+	}
+
+	cursor := pagination.Cursor{Value: "2025-11-01T12:00:00Z", Id: "pr1"}
+
 	type testCase struct {
 		what string
 
-		userId       string
-		expectedPRs  []prEntity.PullRequest
-		repoError    error
-		expectedCode int
-		expectedBody string
+		url           string
+		callRepo      bool
+		expectedQuery prEntity.PRListQuery
+		expectedPRs   []prEntity.PullRequest
+		repoError     error
+		expectedCode  int
+		expectedBody  string
 	}
 
 	testCases := []testCase{
 		{
 			what: "invalid user_id param",
 
-			userId:       "",
+			url:          "/?user_id=",
 			expectedCode: http.StatusBadRequest,
 			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid user_id param"}}`,
 		},
 
+		{
+			what: "invalid cursor param",
+
+			url:          "/?user_id=u1&cursor=not-a-cursor",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid cursor param"}}`,
+		},
+
+		{
+			what: "limit exceeds max page size",
+
+			url:          "/?user_id=u1&limit=11",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid pr list query: limit must be between 1 and 10"}}`,
+		},
+
+		{
+			what: "invalid status param",
+
+			url:          "/?user_id=u1&status=REVIEWED",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid pr list query: unknown status REVIEWED"}}`,
+		},
+
 		{
 			what: "failed to get PRs by user id",
 
-			userId:       "u1",
-			repoError:    errors.New("db is down"),
-			expectedCode: http.StatusInternalServerError,
+			url:           "/?user_id=u1",
+			callRepo:      true,
+			expectedQuery: reviewerQuery(prEntity.PROpen, pagination.OrderAsc, nil, 11),
+			repoError:     errors.New("db is down"),
+			expectedCode:  http.StatusInternalServerError,
 			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to get pr's by user id: ` +
-				`failed to get pull requests from repo: db is down"}}`,
+				`failed to list pull requests in repo: db is down"}}`,
 		},
 
 		{
-			what: "successfully get PRs",
+			what: "successfully get open PRs page with next cursor",
 
-			userId: "u1",
-			expectedPRs: []prEntity.PullRequest{
-				{
-					Id:       "pr1",
-					Name:     "pull request 1",
-					AuthorId: "u2",
-					Status:   prEntity.PROpen,
-				},
-				{
-					Id:       "pr2",
-					Name:     "pull request 2",
-					AuthorId: "u3",
-					Status:   prEntity.PRMerged,
-				},
-			},
-			repoError:    nil,
-			expectedCode: http.StatusOK,
+			url:           "/?user_id=u1&limit=1",
+			callRepo:      true,
+			expectedQuery: reviewerQuery(prEntity.PROpen, pagination.OrderAsc, nil, 2),
+			expectedPRs:   repoPRs,
+			expectedCode:  http.StatusOK,
 			expectedBody: `{"user_id":"u1","pull_requests":[{"pull_request_id":"pr1","pull_request_name":"pull request 1",` +
-				`"author_id":"u2","status":"OPEN"},{"pull_request_id":"pr2","pull_request_name":"pull request 2",` +
-				`"author_id":"u3","status":"MERGED"}]}`,
+				`"author_id":"u2","status":"OPEN","age_seconds":7200,"assigned_at":"` + assignedAt.Format(time.RFC3339Nano) + `"}],` +
+				`"next_cursor":"` + repoPRs[0].Cursor(prEntity.PRSortCreatedAt).Encode() + `"}`,
+		},
+
+		{
+			what: "successfully get last page of all PRs newest first",
+
+			url:           "/?user_id=u1&status=ALL&order=desc&cursor=" + cursor.Encode(),
+			callRepo:      true,
+			expectedQuery: reviewerQuery("", pagination.OrderDesc, &cursor, 11),
+			expectedPRs:   repoPRs[1:],
+			expectedCode:  http.StatusOK,
+			expectedBody: `{"user_id":"u1","pull_requests":[{"pull_request_id":"pr2","pull_request_name":"pull request 2",` +
+				`"author_id":"u3","status":"MERGED","age_seconds":3600}]}`,
 		},
 	}
 
@@ -231,14 +509,15 @@ func TestGetReview(t *testing.T) {
 
 			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
 
-			mockPullRequestRepo.EXPECT().GetByReviewer(
-				gomock.Any(),
-				tc.userId,
-				config.OutLimit,
-			).Return(tc.expectedPRs, tc.repoError).MaxTimes(1)
+			if tc.callRepo {
+				mockPullRequestRepo.EXPECT().List(
+					gomock.Any(),
+					tc.expectedQuery,
+				).Return(tc.expectedPRs, tc.repoError)
+			}
 
-			memberService := memberservice.CreateMemberService(mockMemberRepo)
-			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, &config)
+			memberService := memberservice.CreateMemberService(mockMemberRepo, reviewerpicker.CreateRandomPicker(), &config)
+			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
 
 			handlers := memberhandlers.CreateMemberHandlers(memberService, pullRequestService, log)
 
@@ -246,7 +525,337 @@ func TestGetReview(t *testing.T) {
 			router := gin.New()
 			router.GET("/", handlers.GetReview)
 
-			req := httptest.NewRequest("GET", fmt.Sprintf("/?user_id=%s", tc.userId), nil)
+			req := httptest.NewRequest("GET", tc.url, nil)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestAddUnavailability(t *testing.T) {
+	log := logger.NewTest()
+
+	config := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	startsAt := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		what string
+
+		body         string
+		callRepo     bool
+		repoError    error
+		expectedCode int
+		expectedBody string
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid body",
+
+			body:         "{",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid body"}}`,
+		},
+
+		{
+			what: "unknown kind",
+
+			body: `{
+				"user_id": "u1",
+				"kind": "DAY_OFF",
+				"starts_at": "2025-12-01T00:00:00Z",
+				"ends_at": "2025-12-15T00:00:00Z"
+			}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"kind must be one of VACATION, SICK_LEAVE, ON_CALL ` +
+				`and starts_at must be before ends_at"}}`,
+		},
+
+		{
+			what: "range ends before start",
+
+			body: `{
+				"user_id": "u1",
+				"kind": "VACATION",
+				"starts_at": "2025-12-15T00:00:00Z",
+				"ends_at": "2025-12-01T00:00:00Z"
+			}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"kind must be one of VACATION, SICK_LEAVE, ON_CALL ` +
+				`and starts_at must be before ends_at"}}`,
+		},
+
+		{
+			what: "user not found",
+
+			body: `{
+				"user_id": "u1",
+				"kind": "VACATION",
+				"starts_at": "2025-12-01T00:00:00Z",
+				"ends_at": "2025-12-15T00:00:00Z"
+			}`,
+			callRepo:     true,
+			repoError:    memberErrors.ErrMemberNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
+		{
+			what: "successfully added",
+
+			body: `{
+				"user_id": "u1",
+				"kind": "VACATION",
+				"starts_at": "2025-12-01T00:00:00Z",
+				"ends_at": "2025-12-15T00:00:00Z"
+			}`,
+			callRepo:     true,
+			expectedCode: http.StatusCreated,
+			expectedBody: `{"unavailability_id":"un1","user_id":"u1","kind":"VACATION",` +
+				`"starts_at":"2025-12-01T00:00:00Z","ends_at":"2025-12-15T00:00:00Z"}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMemberRepo := memberMocks.NewMockMemberRepo(ctrl)
+
+			if tc.callRepo {
+				mockMemberRepo.EXPECT().AddUnavailability(
+					gomock.Any(),
+					gomock.Any(),
+				).DoAndReturn(func(
+					_ any,
+					unavailability memberEntity.Unavailability,
+				) (memberEntity.Unavailability, error) {
+					assert.Equal(t, "u1", unavailability.MemberId)
+					assert.Equal(t, memberEntity.UnavailabilityVacation, unavailability.Kind)
+					assert.True(t, startsAt.Equal(unavailability.StartsAt))
+					assert.True(t, endsAt.Equal(unavailability.EndsAt))
+
+					if tc.repoError != nil {
+						return memberEntity.Unavailability{}, tc.repoError
+					}
+
+					// id is generated by service, replace it to get stable response
+					unavailability.Id = "un1"
+					return unavailability, nil
+				})
+			}
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			memberService := memberservice.CreateMemberService(mockMemberRepo, reviewerpicker.CreateRandomPicker(), &config)
+			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
+
+			handlers := memberhandlers.CreateMemberHandlers(memberService, pullRequestService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", handlers.AddUnavailability)
+
+			body := bytes.NewBufferString(tc.body)
+			req := httptest.NewRequest("POST", "/", body)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestDeleteUnavailability(t *testing.T) {
+	log := logger.NewTest()
+
+	config := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	type testCase struct {
+		what string
+
+		body         string
+		repoError    error
+		expectedCode int
+		expectedBody string
+	}
+
+	testCases := []testCase{
+		{
+			what: "not found",
+
+			body:         `{"unavailability_id": "un1"}`,
+			repoError:    memberErrors.ErrUnavailabilityNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
+		{
+			what: "failed to delete",
+
+			body:         `{"unavailability_id": "un1"}`,
+			repoError:    errors.New("db is down"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to delete unavailability: ` +
+				`failed to delete unavailability in repo: db is down"}}`,
+		},
+
+		{
+			what: "successfully deleted",
+
+			body:         `{"unavailability_id": "un1"}`,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"ok"}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMemberRepo := memberMocks.NewMockMemberRepo(ctrl)
+
+			mockMemberRepo.EXPECT().DeleteUnavailability(gomock.Any(), "un1").Return(tc.repoError)
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			memberService := memberservice.CreateMemberService(mockMemberRepo, reviewerpicker.CreateRandomPicker(), &config)
+			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
+
+			handlers := memberhandlers.CreateMemberHandlers(memberService, pullRequestService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", handlers.DeleteUnavailability)
+
+			body := bytes.NewBufferString(tc.body)
+			req := httptest.NewRequest("POST", "/", body)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestSetSkills(t *testing.T) {
+	log := logger.NewTest()
+
+	config := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	type testCase struct {
+		what string
+
+		body           string
+		callRepo       bool
+		expectedSkills []string
+		repoError      error
+		expectedCode   int
+		expectedBody   string
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid body",
+
+			body:         "{",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid body"}}`,
+		},
+
+		{
+			what: "invalid skills",
+
+			body:         `{"user_id": "u1", "skills": ["postgres", ""]}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST",` +
+				`"message":"skills must be non-empty and at most 64 characters long"}}`,
+		},
+
+		{
+			what: "user not found",
+
+			body:           `{"user_id": "u1", "skills": ["postgres"]}`,
+			callRepo:       true,
+			expectedSkills: []string{"postgres"},
+			repoError:      memberErrors.ErrMemberNotFound,
+			expectedCode:   http.StatusNotFound,
+			expectedBody:   `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
+		{
+			what: "failed to set skills",
+
+			body:           `{"user_id": "u1", "skills": ["postgres"]}`,
+			callRepo:       true,
+			expectedSkills: []string{"postgres"},
+			repoError:      errors.New("db is down"),
+			expectedCode:   http.StatusInternalServerError,
+			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to set skills: ` +
+				`failed to set skills in repo: db is down"}}`,
+		},
+
+		{
+			what: "successfully set skills",
+
+			body:           `{"user_id": "u1", "skills": ["Security", "postgres"]}`,
+			callRepo:       true,
+			expectedSkills: []string{"postgres", "security"},
+			expectedCode:   http.StatusOK,
+			expectedBody:   `{"user_id":"u1","skills":["postgres","security"]}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMemberRepo := memberMocks.NewMockMemberRepo(ctrl)
+
+			if tc.callRepo {
+				mockMemberRepo.EXPECT().
+					SetSkills(gomock.Any(), "u1", tc.expectedSkills).
+					Return(tc.expectedSkills, tc.repoError)
+			}
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			memberService := memberservice.CreateMemberService(mockMemberRepo, reviewerpicker.CreateRandomPicker(), &config)
+			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
+
+			handlers := memberhandlers.CreateMemberHandlers(memberService, pullRequestService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", handlers.SetSkills)
+
+			body := bytes.NewBufferString(tc.body)
+			req := httptest.NewRequest("POST", "/", body)
 
 			recorder := httptest.NewRecorder()
 
@@ -0,0 +1,39 @@
+package pageparams
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
+	"github.com/gin-gonic/gin"
+)
+
+// parses limit and cursor query params shared by paginated handlers.
+// missing limit is 0, so services apply the max page size, missing cursor is nil
+func Parse(ctx *gin.Context) (*pagination.Cursor, int, error) {
+	limit := 0
+
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+
+		if err != nil || parsed < 1 {
+			return nil, 0, errors.New("invalid limit param")
+		}
+
+		limit = parsed
+	}
+
+	token := ctx.Query("cursor")
+
+	if token == "" {
+		return nil, limit, nil
+	}
+
+	cursor, err := pagination.DecodeCursor(token)
+
+	if err != nil {
+		return nil, 0, errors.New("invalid cursor param")
+	}
+
+	return &cursor, limit, nil
+}
@@ -0,0 +1,339 @@
+package poolhandlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/docs"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	poolErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/interfaces"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/auth"
+	request_id "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/request-id"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+type PoolHandlers struct {
+	poolService interfaces.PoolService
+	logger      zerolog.Logger
+}
+
+func CreatePoolHandlers(poolService interfaces.PoolService, log zerolog.Logger) *PoolHandlers {
+	return &PoolHandlers{
+		poolService: poolService,
+		logger:      log,
+	}
+}
+
+// Add godoc
+// @Summary Создать пул ревьюеров или заменить его участников
+// @Description Участники пула могут состоять в разных командах.
+// @Tags Pools
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.AddPoolRequest true "Имя пула и идентификаторы участников"
+// @Success 200 {object} docs.PoolResponse "Пул сохранен"
+// @Failure 400 {object} docs.ErrorResponse "Некорректное имя пула"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Пользователь не найден"
+// @Router /pools/add [post]
+func (h *PoolHandlers) Add(ctx *gin.Context) {
+	log := h.localLogger(ctx, "Add")
+
+	var request docs.AddPoolRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	pool, err := h.poolService.Upsert(ctx.Request.Context(), request.Name, request.MemberIds)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, poolErrors.ErrInvalidPoolName):
+			log.Warn().Msg("invalid pool name")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				err.Error(),
+			))
+
+		case errors.Is(err, poolErrors.ErrMemberNotFound):
+			log.Warn().Msg("member of pool not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to upsert pool")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to upsert pool: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, docs.PoolResponse{Pool: docs.ToPoolResponseObject(pool)})
+
+	log.Info().Str("pool", pool.Name).Msg("successfully upserted pool")
+}
+
+// Add godoc
+// @Summary Получить пул ревьюеров с участниками и командами
+// @Tags Pools
+// @Security BearerAuth
+// @Produce json
+// @Param pool_name query string true "Уникальное имя пула"
+// @Success 200 {object} docs.PoolResponse "Объект пула"
+// @Failure 400 {object} docs.ErrorResponse "Не указано имя пула"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Пул не найден"
+// @Router /pools/get [get]
+func (h *PoolHandlers) Get(ctx *gin.Context) {
+	log := h.localLogger(ctx, "Get")
+
+	poolName := ctx.Query("pool_name")
+
+	if poolName == "" {
+		log.Warn().Msg("invalid pool_name param")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid pool_name param",
+		))
+		return
+	}
+
+	pool, err := h.poolService.GetByName(ctx.Request.Context(), poolName)
+
+	if err != nil {
+		h.abortWithPoolError(ctx, log, err, "get pool")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, docs.PoolResponse{Pool: docs.ToPoolResponseObject(pool)})
+
+	log.Info().Msg("successfully got pool")
+}
+
+// Add godoc
+// @Summary Получить все пулы ревьюеров
+// @Tags Pools
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} docs.ListPoolsResponse "Пулы в порядке имен"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Router /pools/list [get]
+func (h *PoolHandlers) List(ctx *gin.Context) {
+	log := h.localLogger(ctx, "List")
+
+	pools, err := h.poolService.List(ctx.Request.Context())
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list pools")
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+			"INTERNAL_SERVER_ERROR",
+			fmt.Sprintf("failed to list pools: %s", err.Error()),
+		))
+		return
+	}
+
+	resp := docs.ListPoolsResponse{
+		Pools: make([]docs.PoolResponseObject, 0, len(pools)),
+	}
+
+	for _, pool := range pools {
+		resp.Pools = append(resp.Pools, docs.ToPoolResponseObject(pool))
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Msg("successfully listed pools")
+}
+
+// Add godoc
+// @Summary Удалить пул ревьюеров
+// @Description Команды перестают получать ревьюеров из пула, уже назначенные ревьюеры сохраняются.
+// @Tags Pools
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.DeletePoolRequest true "Имя пула"
+// @Success 200 {object} docs.DeletePoolResponse "Пул удален"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Пул не найден"
+// @Router /pools/delete [post]
+func (h *PoolHandlers) Delete(ctx *gin.Context) {
+	log := h.localLogger(ctx, "Delete")
+
+	var request docs.DeletePoolRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	if err := h.poolService.Delete(ctx.Request.Context(), request.Name); err != nil {
+		h.abortWithPoolError(ctx, log, err, "delete pool")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, docs.DeletePoolResponse{Result: "ok"})
+
+	log.Info().Msg("successfully deleted pool")
+}
+
+// Add godoc
+// @Summary Подключить пул ревьюеров к команде
+// @Description На каждый PR команды из пула назначается reviewers_count ревьюеров сверх ревьюеров команды.
+// @Description Повторный вызов изменяет число ревьюеров.
+// @Tags Pools
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.AttachTeamRequest true "Пул, команда и число ревьюеров из пула"
+// @Success 200 {object} docs.PoolResponse "Пул с подключенными командами"
+// @Failure 400 {object} docs.ErrorResponse "Число ревьюеров не положительное"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Пул или команда не найдены"
+// @Router /pools/attachTeam [post]
+func (h *PoolHandlers) AttachTeam(ctx *gin.Context) {
+	log := h.localLogger(ctx, "AttachTeam")
+
+	var request docs.AttachTeamRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	pool, err := h.poolService.AttachTeam(
+		ctx.Request.Context(),
+		request.PoolName,
+		request.TeamName,
+		request.ReviewersCount,
+	)
+
+	if err != nil {
+		h.abortWithPoolError(ctx, log, err, "attach team to pool")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, docs.PoolResponse{Pool: docs.ToPoolResponseObject(pool)})
+
+	log.Info().Str("team", request.TeamName).Msg("successfully attached team to pool")
+}
+
+// Add godoc
+// @Summary Отключить пул ревьюеров от команды
+// @Description Уже назначенные из пула ревьюеры сохраняются.
+// @Tags Pools
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.DetachTeamRequest true "Пул и команда"
+// @Success 200 {object} docs.PoolResponse "Пул с подключенными командами"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Пул не найден или команда к нему не подключена"
+// @Router /pools/detachTeam [post]
+func (h *PoolHandlers) DetachTeam(ctx *gin.Context) {
+	log := h.localLogger(ctx, "DetachTeam")
+
+	var request docs.DetachTeamRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	pool, err := h.poolService.DetachTeam(ctx.Request.Context(), request.PoolName, request.TeamName)
+
+	if err != nil {
+		h.abortWithPoolError(ctx, log, err, "detach team from pool")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, docs.PoolResponse{Pool: docs.ToPoolResponseObject(pool)})
+
+	log.Info().Str("team", request.TeamName).Msg("successfully detached team from pool")
+}
+
+// action is used in messages of unexpected errors
+func (h *PoolHandlers) abortWithPoolError(ctx *gin.Context, log zerolog.Logger, err error, action string) {
+	switch {
+	case errors.Is(err, poolErrors.ErrInvalidReviewersCount):
+		log.Warn().Msg("invalid reviewers count")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			err.Error(),
+		))
+
+	case errors.Is(err, poolErrors.ErrPoolNotFound),
+		errors.Is(err, poolErrors.ErrTeamNotAttached),
+		errors.Is(err, teamErrors.ErrTeamNotFound):
+
+		log.Warn().Err(err).Msg("resource not found")
+		ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+			"NOT_FOUND",
+			"resource not found",
+		))
+
+	default:
+		log.Error().Err(err).Msgf("failed to %s", action)
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+			"INTERNAL_SERVER_ERROR",
+			fmt.Sprintf("failed to %s: %s", action, err.Error()),
+		))
+	}
+}
+
+func (h *PoolHandlers) localLogger(ctx *gin.Context, opName string) zerolog.Logger {
+	log := h.logger.With().
+		Str("op", opName).
+		Str("requestId", ctx.GetString(request_id.REQUEST_ID_PARAM)).
+		Logger()
+
+	return log
+}
+
+func InitPoolHandlers(
+	r *gin.RouterGroup,
+	log zerolog.Logger,
+	poolService interfaces.PoolService,
+	cfg *config.RestConfig,
+) {
+	handlers := CreatePoolHandlers(poolService, log)
+
+	group := r.Group("pools")
+
+	{
+		group.POST("add", auth.WithAuth(cfg), handlers.Add)
+		group.GET("get", auth.WithAuth(cfg), handlers.Get)
+		group.GET("list", auth.WithAuth(cfg), handlers.List)
+		group.POST("delete", auth.WithAuth(cfg), handlers.Delete)
+		group.POST("attachTeam", auth.WithAuth(cfg), handlers.AttachTeam)
+		group.POST("detachTeam", auth.WithAuth(cfg), handlers.DetachTeam)
+	}
+}
@@ -0,0 +1,217 @@
+package poolhandlers_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	poolservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/pool"
+	poolEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/entity"
+	poolErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/errors"
+	poolMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/mocks"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/logger"
+	poolhandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/pool"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdd(t *testing.T) {
+	log := logger.NewTest()
+
+	type testCase struct {
+		what string
+
+		body         string
+		callRepo     bool
+		repoError    error
+		expectedCode int
+		expectedBody string
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid body",
+
+			body:         `{"pool_name": 1}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid body"}}`,
+		},
+
+		{
+			what: "empty pool name",
+
+			body:         `{"pool_name": "", "members": ["u1"]}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST",` +
+				`"message":"pool name must be non-empty and at most 64 characters long"}}`,
+		},
+
+		{
+			what: "unknown member",
+
+			body:         `{"pool_name": "platform", "members": ["u404"]}`,
+			callRepo:     true,
+			repoError:    poolErrors.ErrMemberNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
+		{
+			what: "failed to upsert pool",
+
+			body:         `{"pool_name": "platform", "members": ["u1"]}`,
+			callRepo:     true,
+			repoError:    errors.New("db is down"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to upsert pool: ` +
+				`failed to upsert pool to repo: db is down"}}`,
+		},
+
+		{
+			what: "successfully upserted",
+
+			body:         `{"pool_name": "platform", "members": ["u2", "u1"]}`,
+			callRepo:     true,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"pool":{"pool_name":"platform","members":["u1","u2"],"teams":[]}}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPoolRepo := poolMocks.NewMockPoolRepo(ctrl)
+
+			if tc.callRepo {
+				mockPoolRepo.EXPECT().Upsert(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ any, pool poolEntity.Pool) (poolEntity.Pool, error) {
+						return pool, tc.repoError
+					},
+				)
+			}
+
+			poolService := poolservice.CreatePoolService(mockPoolRepo)
+
+			handlers := poolhandlers.CreatePoolHandlers(poolService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", handlers.Add)
+
+			body := bytes.NewBufferString(tc.body)
+			req := httptest.NewRequest("POST", "/", body)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestAttachTeam(t *testing.T) {
+	log := logger.NewTest()
+
+	type testCase struct {
+		what string
+
+		body         string
+		callRepo     bool
+		repoError    error
+		expectedCode int
+		expectedBody string
+	}
+
+	testCases := []testCase{
+		{
+			what: "non-positive reviewers count",
+
+			body:         `{"pool_name": "platform", "team_name": "backend", "reviewers_count": 0}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"reviewers count must be positive"}}`,
+		},
+
+		{
+			what: "team not found",
+
+			body:         `{"pool_name": "platform", "team_name": "backend", "reviewers_count": 1}`,
+			callRepo:     true,
+			repoError:    teamErrors.ErrTeamNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
+		{
+			what: "failed to attach team",
+
+			body:         `{"pool_name": "platform", "team_name": "backend", "reviewers_count": 1}`,
+			callRepo:     true,
+			repoError:    errors.New("db is down"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to attach team to pool: ` +
+				`failed to attach team to pool in repo: db is down"}}`,
+		},
+
+		{
+			what: "successfully attached",
+
+			body:         `{"pool_name": "platform", "team_name": "backend", "reviewers_count": 1}`,
+			callRepo:     true,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"pool":{"pool_name":"platform","members":["u1"],` +
+				`"teams":[{"team_name":"backend","reviewers_count":1}]}}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPoolRepo := poolMocks.NewMockPoolRepo(ctrl)
+
+			if tc.callRepo {
+				mockPoolRepo.
+					EXPECT().
+					AttachTeam(gomock.Any(), "platform", "backend", 1).
+					Return(poolEntity.Pool{
+						Name:      "platform",
+						MemberIds: []string{"u1"},
+						Teams: []poolEntity.TeamQuota{
+							{
+								TeamName:       "backend",
+								ReviewersCount: 1,
+							},
+						},
+					}, tc.repoError)
+			}
+
+			poolService := poolservice.CreatePoolService(mockPoolRepo)
+
+			handlers := poolhandlers.CreatePoolHandlers(poolService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", handlers.AttachTeam)
+
+			body := bytes.NewBufferString(tc.body)
+			req := httptest.NewRequest("POST", "/", body)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
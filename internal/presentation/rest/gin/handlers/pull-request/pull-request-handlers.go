@@ -1,14 +1,19 @@
 package pullrequesthandlers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/SmokingElk/avito-2025-autumn-intership/docs"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
 	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+	pageparams "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/page-params"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/auth"
 	request_id "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/request-id"
 	"github.com/gin-gonic/gin"
@@ -28,16 +33,144 @@ func CreatePullRequestHandlers(pullRequestService interfaces.PullRequestService,
 }
 
 // Add godoc
-// @Summary Создать PR и автоматически назначить до 2 ревьюверов из команды авторы
+// @Summary Получить PR по идентификатору
+// @Tags PullRequests
+// @Security BearerAuth
+// @Param pull_request_id query string true "Идентификатор PR"
+// @Produce json
+// @Success 200 {object} docs.GetPRResponse "PR"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "PR не найден"
+// @Router /pullRequest/get [get]
+func (h *PullRequestHandlers) Get(ctx *gin.Context) {
+	log := h.localLogger(ctx, "Get")
+
+	prId := ctx.Query("pull_request_id")
+
+	if prId == "" {
+		log.Warn().Msg("missing pull_request_id param")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"missing pull_request_id param",
+		))
+		return
+	}
+
+	pr, err := h.pullRequestService.GetById(ctx.Request.Context(), prId)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, prErrors.ErrNotFound):
+			log.Warn().Msg("pr not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to get pr")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to get pr: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.GetPRResponse{
+		Pr: docs.ToPRDetailsResponseObject(pr),
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Msg("successfully got pr")
+}
+
+// Add godoc
+// @Summary Получить список PR с фильтрами и курсорной пагинацией
+// @Tags PullRequests
+// @Security BearerAuth
+// @Param author_id query string false "Автор PR"
+// @Param team_name query string false "Команда автора PR"
+// @Param status query string false "Статус PR: DRAFT, OPEN, MERGED или CLOSED"
+// @Param reviewer_id query string false "Назначенный ревьювер"
+// @Param created_from query string false "Создан не раньше (RFC3339)"
+// @Param created_to query string false "Создан раньше (RFC3339)"
+// @Param sort_by query string false "Поле сортировки: created_at (по умолчанию) или name"
+// @Param order query string false "Порядок сортировки: asc (по умолчанию) или desc"
+// @Param limit query int false "Размер страницы, по умолчанию максимальный"
+// @Param cursor query string false "Курсор следующей страницы из предыдущего ответа"
+// @Produce json
+// @Success 200 {object} docs.ListPRResponse "Страница PR"
+// @Failure 400 {object} docs.ErrorResponse "Неверные параметры запроса или курсор"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Router /pullRequest/list [get]
+func (h *PullRequestHandlers) List(ctx *gin.Context) {
+	log := h.localLogger(ctx, "List")
+
+	query, err := parseListQuery(ctx)
+
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid query params")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			err.Error(),
+		))
+		return
+	}
+
+	prs, next, err := h.pullRequestService.List(ctx.Request.Context(), query)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, prErrors.ErrInvalidListQuery), errors.Is(err, pagination.ErrInvalidCursor):
+			log.Warn().Err(err).Msg("invalid list query")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				err.Error(),
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to list prs")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to list prs: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.ListPRResponse{
+		PullRequests: make([]docs.PRDetailsResponseObject, 0, len(prs)),
+	}
+
+	for _, pr := range prs {
+		resp.PullRequests = append(resp.PullRequests, docs.ToPRDetailsResponseObject(pr))
+	}
+
+	if next != nil {
+		resp.NextCursor = next.Encode()
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Int("count", len(prs)).Msg("successfully listed prs")
+}
+
+// Add godoc
+// @Summary Создать PR и автоматически назначить до 2 ревьюверов из команды автора, владельцы измененных файлов по CODEOWNERS и участники с навыками под метки PR назначаются в первую очередь (черновик создается без ревьюверов)
 // @Tags PullRequests
 // @Security BearerAuth
 // @Accept json
 // @Produce json
 // @Param input body docs.CreatePRRequest true "Данные для создания"
 // @Success 201 {object} docs.CreatePRResponse "PR создан"
+// @Failure 400 {object} docs.ErrorResponse "Некорректные метки"
 // @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
-// @Failure 404 {object} docs.ErrorResponse "Автор/команда не найдены"
-// @Failure 409 {object} docs.ErrorResponse "PR уже существует"
+// @Failure 404 {object} docs.ErrorResponse "Автор/команда не найдены или автор не состоит в указанной команде"
+// @Failure 409 {object} docs.ErrorResponse "PR уже существует, команда в архиве или не хватает ревьюверов со свободным лимитом"
 // @Router /pullRequest/create [post]
 func (h *PullRequestHandlers) Create(ctx *gin.Context) {
 	log := h.localLogger(ctx, "Create")
@@ -53,10 +186,26 @@ func (h *PullRequestHandlers) Create(ctx *gin.Context) {
 		return
 	}
 
-	pr, err := h.pullRequestService.Create(ctx.Request.Context(), request.Id, request.Name, request.AuthorId)
+	pr, err := h.pullRequestService.Create(
+		ctx.Request.Context(),
+		request.Id,
+		request.Name,
+		request.AuthorId,
+		request.TeamName,
+		request.Draft,
+		request.ChangedFiles,
+		request.Labels,
+	)
 
 	if err != nil {
 		switch {
+		case errors.Is(err, prErrors.ErrInvalidLabels):
+			log.Warn().Msg("invalid labels")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				err.Error(),
+			))
+
 		case errors.Is(err, prErrors.ErrTeamOrUserNotFound):
 			log.Warn().Msg("team or user not found")
 			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
@@ -71,6 +220,20 @@ func (h *PullRequestHandlers) Create(ctx *gin.Context) {
 				"PR id already exists",
 			))
 
+		case errors.Is(err, prErrors.ErrTeamArchived):
+			log.Warn().Msg("team is archived")
+			ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
+				"TEAM_ARCHIVED",
+				"team is archived",
+			))
+
+		case errors.Is(err, prErrors.ErrNoReviewerCapacity):
+			log.Warn().Msg("no reviewer capacity")
+			ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
+				"NO_CAPACITY",
+				"not enough members with review capacity",
+			))
+
 		default:
 			log.Error().Err(err).Msg("failed to create pr")
 			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
@@ -83,13 +246,7 @@ func (h *PullRequestHandlers) Create(ctx *gin.Context) {
 	}
 
 	resp := docs.CreatePRResponse{
-		Pr: docs.PRResponseObject{
-			Id:                pr.Id,
-			Name:              pr.Name,
-			AuthorId:          pr.AuthorId,
-			Status:            string(pr.Status),
-			AssignedReviewers: pr.Reviewers,
-		},
+		Pr: docs.ToPRResponseObject(pr),
 	}
 
 	ctx.JSON(http.StatusCreated, resp)
@@ -103,10 +260,11 @@ func (h *PullRequestHandlers) Create(ctx *gin.Context) {
 // @Security BearerAuth
 // @Accept json
 // @Produce json
-// @Param input body docs.MergePRRequest true "Идентификатор PR"
+// @Param input body docs.MergePRRequest true "Идентификатор PR и пользователь, который мерджит"
 // @Success 200 {object} docs.MergePRResponse "PR в состоянии MERGED"
 // @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
-// @Failure 404 {object} docs.ErrorResponse "PR не найден"
+// @Failure 404 {object} docs.ErrorResponse "PR или его команда не найдены"
+// @Failure 409 {object} docs.MergePolicyErrorResponse "PR не в состоянии OPEN или не выполнены условия политики мерджа"
 // @Router /pullRequest/merge [post]
 func (h *PullRequestHandlers) Merge(ctx *gin.Context) {
 	log := h.localLogger(ctx, "Merge")
@@ -122,17 +280,30 @@ func (h *PullRequestHandlers) Merge(ctx *gin.Context) {
 		return
 	}
 
-	mergedPr, err := h.pullRequestService.Merge(ctx.Request.Context(), request.Id)
+	mergedPr, err := h.pullRequestService.Merge(ctx.Request.Context(), request.Id, request.MergedBy)
 
 	if err != nil {
+		var policyErr *prErrors.MergePolicyError
+
 		switch {
-		case errors.Is(err, prErrors.ErrNotFound):
-			log.Warn().Msg("pr not found")
+		case errors.Is(err, prErrors.ErrNotFound), errors.Is(err, prErrors.ErrTeamOrUserNotFound):
+			log.Warn().Msg("pr or its team not found")
 			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
 				"NOT_FOUND",
 				"resource not found",
 			))
 
+		case errors.Is(err, prErrors.ErrInvalidTransition):
+			log.Warn().Msg("invalid status transition")
+			ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
+				"INVALID_TRANSITION",
+				"pr status transition is not allowed",
+			))
+
+		case errors.As(err, &policyErr):
+			log.Warn().Err(err).Msg("merge policy is violated")
+			ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewMergePolicyErrorResponse(policyErr.Unmet))
+
 		default:
 			log.Error().Err(err).Msg("failed to merge pr")
 			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
@@ -151,6 +322,7 @@ func (h *PullRequestHandlers) Merge(ctx *gin.Context) {
 			AuthorId:          mergedPr.AuthorId,
 			Status:            string(mergedPr.Status),
 			AssignedReviewers: mergedPr.Reviewers,
+			ReviewerStates:    docs.ToReviewerStatesResponse(mergedPr),
 			MergedAt:          mergedPr.MergedAt,
 		},
 	}
@@ -160,6 +332,208 @@ func (h *PullRequestHandlers) Merge(ctx *gin.Context) {
 	log.Info().Msg("successfully merged pr")
 }
 
+// Add godoc
+// @Summary Закрыть PR без мерджа (идемпотентная операция)
+// @Tags PullRequests
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.ChangePRStatusRequest true "Идентификатор PR"
+// @Success 200 {object} docs.ChangePRStatusResponse "PR в состоянии CLOSED"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "PR или его команда не найдены"
+// @Failure 409 {object} docs.ErrorResponse "PR уже смерджен"
+// @Router /pullRequest/close [post]
+func (h *PullRequestHandlers) Close(ctx *gin.Context) {
+	h.changeStatus(ctx, "Close", h.pullRequestService.Close)
+}
+
+// Add godoc
+// @Summary Переоткрыть закрытый PR, недостающие ревьюверы назначаются заново
+// @Tags PullRequests
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.ChangePRStatusRequest true "Идентификатор PR"
+// @Success 200 {object} docs.ChangePRStatusResponse "PR в состоянии OPEN"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "PR или его команда не найдены"
+// @Failure 409 {object} docs.ErrorResponse "PR не в состоянии CLOSED или не хватает ревьюверов со свободным лимитом"
+// @Router /pullRequest/reopen [post]
+func (h *PullRequestHandlers) Reopen(ctx *gin.Context) {
+	h.changeStatus(ctx, "Reopen", h.pullRequestService.Reopen)
+}
+
+// Add godoc
+// @Summary Перевести черновик PR в состояние OPEN и назначить ревьюверов
+// @Tags PullRequests
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.ChangePRStatusRequest true "Идентификатор PR"
+// @Success 200 {object} docs.ChangePRStatusResponse "PR в состоянии OPEN"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "PR или его команда не найдены"
+// @Failure 409 {object} docs.ErrorResponse "PR не в состоянии DRAFT или не хватает ревьюверов со свободным лимитом"
+// @Router /pullRequest/ready [post]
+func (h *PullRequestHandlers) Ready(ctx *gin.Context) {
+	h.changeStatus(ctx, "Ready", h.pullRequestService.Ready)
+}
+
+func (h *PullRequestHandlers) changeStatus(
+	ctx *gin.Context,
+	opName string,
+	change func(ctx context.Context, prId string) (prEntity.PullRequest, error),
+) {
+	log := h.localLogger(ctx, opName)
+
+	var request docs.ChangePRStatusRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	pr, err := change(ctx.Request.Context(), request.Id)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, prErrors.ErrNotFound), errors.Is(err, prErrors.ErrTeamOrUserNotFound):
+			log.Warn().Msg("pr or its team not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		case errors.Is(err, prErrors.ErrInvalidTransition):
+			log.Warn().Msg("invalid status transition")
+			ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
+				"INVALID_TRANSITION",
+				"pr status transition is not allowed",
+			))
+
+		case errors.Is(err, prErrors.ErrNoReviewerCapacity):
+			log.Warn().Msg("no reviewer capacity")
+			ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
+				"NO_CAPACITY",
+				"not enough members with review capacity",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to change pr status")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to change pr status: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.ChangePRStatusResponse{
+		Pr: docs.ToPRResponseObject(pr),
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Str("status", string(pr.Status)).Msg("successfully changed pr status")
+}
+
+// Add godoc
+// @Summary Оставить вердикт ревьювера по PR (повторный вердикт заменяет предыдущий)
+// @Tags PullRequests
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.ReviewPRRequest true "PR, ревьювер и вердикт"
+// @Success 200 {object} docs.ReviewPRResponse "Вердикт сохранен"
+// @Failure 400 {object} docs.ErrorResponse "Неизвестный вердикт"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "PR не найден"
+// @Failure 409 {object} docs.ErrorResponse "Пользователь не назначен ревьювером или PR смерджен/закрыт"
+// @Router /pullRequest/review [post]
+func (h *PullRequestHandlers) Review(ctx *gin.Context) {
+	log := h.localLogger(ctx, "Review")
+
+	var request docs.ReviewPRRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	pr, err := h.pullRequestService.Review(
+		ctx.Request.Context(),
+		request.Id,
+		request.ReviewerId,
+		prEntity.ReviewVerdict(request.Verdict),
+	)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, prErrors.ErrInvalidVerdict):
+			log.Warn().Msg("invalid verdict")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				"invalid verdict",
+			))
+
+		case errors.Is(err, prErrors.ErrNotFound):
+			log.Warn().Msg("pr not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		case errors.Is(err, prErrors.ErrNotAssigned):
+			log.Warn().Msg("reviewer is not assigned")
+			ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
+				"NOT_ASSIGNED",
+				"reviewer is not assigned to this PR",
+			))
+
+		case errors.Is(err, prErrors.ErrAlreadyMerged):
+			log.Warn().Msg("pr already merged")
+			ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
+				"PR_MERGED",
+				"cannot review merged PR",
+			))
+
+		case errors.Is(err, prErrors.ErrAlreadyClosed):
+			log.Warn().Msg("pr already closed")
+			ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
+				"PR_CLOSED",
+				"cannot review closed PR",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to review pr")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to review pr: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.ReviewPRResponse{
+		Pr: docs.ToPRResponseObject(pr),
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Msg("successfully reviewed pr")
+}
+
 // Add godoc
 // @Summary Переназначить конкретного ревьювера на другого из его команды
 // @Tags PullRequests
@@ -171,7 +545,7 @@ func (h *PullRequestHandlers) Merge(ctx *gin.Context) {
 // @Failure 400 {object} docs.ErrorResponse "Не достаточно активных членов для переназначения"
 // @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
 // @Failure 404 {object} docs.ErrorResponse "PR или пользователь найден"
-// @Failure 409 {object} docs.ErrorResponse "Нарушение доменных правил переназначения"
+// @Failure 409 {object} docs.ErrorResponse "Нарушение доменных правил переназначения (PR смерджен или закрыт)"
 // @Router /pullRequest/reassign [post]
 func (h *PullRequestHandlers) Reassign(ctx *gin.Context) {
 	log := h.localLogger(ctx, "Reassign")
@@ -219,6 +593,13 @@ func (h *PullRequestHandlers) Reassign(ctx *gin.Context) {
 				"cannot reassign on merged PR",
 			))
 
+		case errors.Is(err, prErrors.ErrAlreadyClosed):
+			log.Warn().Msg("pr already closed")
+			ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
+				"PR_CLOSED",
+				"cannot reassign on closed PR",
+			))
+
 		default:
 			log.Error().Err(err).Msg("failed to reassign")
 			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
@@ -231,13 +612,7 @@ func (h *PullRequestHandlers) Reassign(ctx *gin.Context) {
 	}
 
 	resp := docs.ReassignResponse{
-		Pr: docs.PRResponseObject{
-			Id:                pr.Id,
-			Name:              pr.Name,
-			AuthorId:          pr.AuthorId,
-			Status:            string(pr.Status),
-			AssignedReviewers: pr.Reviewers,
-		},
+		Pr:         docs.ToPRResponseObject(pr),
 		ReplacedBy: new,
 	}
 
@@ -246,6 +621,48 @@ func (h *PullRequestHandlers) Reassign(ctx *gin.Context) {
 	log.Info().Msg("successfully reassigned")
 }
 
+func parseListQuery(ctx *gin.Context) (prEntity.PRListQuery, error) {
+	query := prEntity.PRListQuery{
+		Filter: prEntity.PRFilter{
+			AuthorId:   ctx.Query("author_id"),
+			TeamName:   ctx.Query("team_name"),
+			Status:     prEntity.PRStatus(ctx.Query("status")),
+			ReviewerId: ctx.Query("reviewer_id"),
+		},
+		SortBy: prEntity.PRSortField(ctx.Query("sort_by")),
+		Order:  pagination.Order(ctx.Query("order")),
+	}
+
+	after, limit, err := pageparams.Parse(ctx)
+
+	if err != nil {
+		return query, err
+	}
+
+	query.After = after
+	query.Limit = limit
+
+	for param, target := range map[string]**time.Time{
+		"created_from": &query.Filter.CreatedFrom,
+		"created_to":   &query.Filter.CreatedTo,
+	} {
+		value := ctx.Query(param)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := time.Parse(time.RFC3339, value)
+
+		if err != nil {
+			return query, fmt.Errorf("invalid %s param", param)
+		}
+
+		*target = &parsed
+	}
+
+	return query, nil
+}
+
 func (h *PullRequestHandlers) localLogger(ctx *gin.Context, opName string) zerolog.Logger {
 	log := h.logger.With().
 		Str("op", opName).
@@ -266,8 +683,14 @@ func InitPullRequestHandlers(
 	group := r.Group("pullRequest")
 
 	{
+		group.GET("get", auth.WithAuth(cfg), h.Get)
+		group.GET("list", auth.WithAuth(cfg), h.List)
 		group.POST("create", auth.WithAuth(cfg), h.Create)
 		group.POST("merge", auth.WithAuth(cfg), h.Merge)
 		group.POST("reassign", auth.WithAuth(cfg), h.Reassign)
+		group.POST("close", auth.WithAuth(cfg), h.Close)
+		group.POST("reopen", auth.WithAuth(cfg), h.Reopen)
+		group.POST("ready", auth.WithAuth(cfg), h.Ready)
+		group.POST("review", auth.WithAuth(cfg), h.Review)
 	}
 }
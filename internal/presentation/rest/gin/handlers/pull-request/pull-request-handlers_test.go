@@ -10,7 +10,9 @@ import (
 	"time"
 
 	pullrequestservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/pull-request"
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
 	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
 	prErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/errors"
 	prMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/mocks"
@@ -34,6 +36,7 @@ func TestCreate(t *testing.T) {
 
 		body                    string
 		expectedPR              prEntity.PullRequest
+		expectedTeamName        string
 		expectedPRWithReviewers prEntity.PullRequest
 		repoError               error
 		expectedCode            int
@@ -72,6 +75,27 @@ func TestCreate(t *testing.T) {
 			expectedBody: `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
 		},
 
+		{
+			what: "author is not member of team",
+
+			body: `{
+				"author_id": "u1",
+  				"pull_request_id": "pr1",
+  				"pull_request_name": "pull request 1",
+				"team_name": "frontend"
+  			}`,
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			expectedTeamName: "frontend",
+			repoError:        prErrors.ErrTeamOrUserNotFound,
+			expectedCode:     http.StatusNotFound,
+			expectedBody:     `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
 		{
 			what: "pr exists",
 
@@ -91,6 +115,44 @@ func TestCreate(t *testing.T) {
 			expectedBody: `{"error":{"code":"PR_EXISTS","message":"PR id already exists"}}`,
 		},
 
+		{
+			what: "no reviewer capacity",
+
+			body: `{
+				"author_id": "u1",
+  				"pull_request_id": "pr1",
+  				"pull_request_name": "pull request 1"
+  			}`,
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			repoError:    prErrors.ErrNoReviewerCapacity,
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"error":{"code":"NO_CAPACITY","message":"not enough members with review capacity"}}`,
+		},
+
+		{
+			what: "team is archived",
+
+			body: `{
+				"author_id": "u1",
+  				"pull_request_id": "pr1",
+  				"pull_request_name": "pull request 1"
+  			}`,
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+			},
+			repoError:    prErrors.ErrTeamArchived,
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"error":{"code":"TEAM_ARCHIVED","message":"team is archived"}}`,
+		},
+
 		{
 			what: "failed to create pr",
 
@@ -135,7 +197,52 @@ func TestCreate(t *testing.T) {
 			repoError:    nil,
 			expectedCode: http.StatusCreated,
 			expectedBody: `{"pr":{"pull_request_id":"pr1","pull_request_name":"pull request 1","author_id":"u1",` +
-				`"status":"OPEN","assigned_reviewers":["u2","u3"]}}`,
+				`"status":"OPEN","assigned_reviewers":["u2","u3"],` +
+				`"reviewer_states":[{"reviewer_id":"u2","state":"PENDING"},{"reviewer_id":"u3","state":"PENDING"}]}}`,
+		},
+
+		{
+			what: "invalid labels",
+
+			body: `{
+				"author_id": "u1",
+  				"pull_request_id": "pr1",
+  				"pull_request_name": "pull request 1",
+				"labels": [""]
+  			}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"labels must be non-empty and at most 64 characters long"}}`,
+		},
+
+		{
+			what: "successfully create pull request with labels",
+
+			body: `{
+				"author_id": "u1",
+  				"pull_request_id": "pr1",
+  				"pull_request_name": "pull request 1",
+				"labels": ["Security"]
+  			}`,
+			expectedPR: prEntity.PullRequest{
+				Id:       "pr1",
+				Name:     "pull request 1",
+				AuthorId: "u1",
+				Status:   prEntity.PROpen,
+				Labels:   []string{"security"},
+			},
+			expectedPRWithReviewers: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2"},
+				Labels:    []string{"security"},
+			},
+			repoError:    nil,
+			expectedCode: http.StatusCreated,
+			expectedBody: `{"pr":{"pull_request_id":"pr1","pull_request_name":"pull request 1","author_id":"u1",` +
+				`"status":"OPEN","assigned_reviewers":["u2"],` +
+				`"reviewer_states":[{"reviewer_id":"u2","state":"PENDING"}],"labels":["security"]}}`,
 		},
 	}
 
@@ -149,10 +256,11 @@ func TestCreate(t *testing.T) {
 			mockPullRequestRepo.EXPECT().Create(
 				gomock.Any(),
 				prEntity.Matcher(tc.expectedPR),
+				tc.expectedTeamName,
 				gomock.Any(),
 			).Return(tc.expectedPRWithReviewers, tc.repoError).MaxTimes(1)
 
-			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, &config)
+			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
 
 			handlers := pullrequesthandlers.CreatePullRequestHandlers(pullRequestService, log)
 
@@ -228,6 +336,32 @@ func TestMerge(t *testing.T) {
 				`failed to merge pr in repo: db is down"}}`,
 		},
 
+		{
+			what: "merge policy is violated",
+
+			body: `{
+				"pull_request_id": "pr1",
+				"merged_by": "u1"
+			}`,
+			prId: "pr1",
+			repoError: &prErrors.MergePolicyError{
+				Unmet: []prEntity.UnmetCondition{
+					{
+						Condition: prEntity.ConditionMinApprovals,
+						Message:   "1 approvals required, got 0",
+					},
+					{
+						Condition: prEntity.ConditionAuthorCannotMerge,
+						Message:   "pr must be merged by somebody other than author",
+					},
+				},
+			},
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"error":{"code":"MERGE_POLICY_VIOLATION","message":"merge policy conditions are not met",` +
+				`"unmet_conditions":[{"condition":"MIN_APPROVALS","message":"1 approvals required, got 0"},` +
+				`{"condition":"AUTHOR_CANNOT_MERGE","message":"pr must be merged by somebody other than author"}]}}`,
+		},
+
 		{
 			what: "successfully merged",
 
@@ -246,7 +380,9 @@ func TestMerge(t *testing.T) {
 			},
 			expectedCode: http.StatusOK,
 			expectedBody: `{"pr":{"pull_request_id":"pr1","pull_request_name":"pull request 1","author_id":"u1",` +
-				`"status":"MERGED","assigned_reviewers":["u2","u3"],"mergedAt":"1970-01-01T00:00:00Z"}}`,
+				`"status":"MERGED","assigned_reviewers":["u2","u3"],` +
+				`"reviewer_states":[{"reviewer_id":"u2","state":"PENDING"},{"reviewer_id":"u3","state":"PENDING"}],` +
+				`"mergedAt":"1970-01-01T00:00:00Z"}}`,
 		},
 	}
 
@@ -263,7 +399,7 @@ func TestMerge(t *testing.T) {
 				gomock.Any(),
 			).Return(tc.updatedPR, tc.repoError).MaxTimes(1)
 
-			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, &config)
+			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
 
 			handlers := pullrequesthandlers.CreatePullRequestHandlers(pullRequestService, log)
 
@@ -373,6 +509,20 @@ func TestReassign(t *testing.T) {
 			expectedBody:  `{"error":{"code":"PR_MERGED","message":"cannot reassign on merged PR"}}`,
 		},
 
+		{
+			what: "pr already closed",
+
+			body: `{
+				"old_reviewer_id": "u1",
+				"pull_request_id": "pr1"
+			}`,
+			prId:          "pr1",
+			oldReviewerId: "u1",
+			repoError:     prErrors.ErrAlreadyClosed,
+			expectedCode:  http.StatusConflict,
+			expectedBody:  `{"error":{"code":"PR_CLOSED","message":"cannot reassign on closed PR"}}`,
+		},
+
 		{
 			what: "failed to reassign",
 
@@ -408,7 +558,8 @@ func TestReassign(t *testing.T) {
 			},
 			expectedCode: http.StatusOK,
 			expectedBody: `{"pr":{"pull_request_id":"pr1","pull_request_name":"pull request 1","author_id":"u3",` +
-				`"status":"OPEN","assigned_reviewers":["u2","u4"]},"replaced_by":"u2"}`,
+				`"status":"OPEN","assigned_reviewers":["u2","u4"],` +
+				`"reviewer_states":[{"reviewer_id":"u2","state":"PENDING"},{"reviewer_id":"u4","state":"PENDING"}]},"replaced_by":"u2"}`,
 		},
 	}
 
@@ -426,7 +577,7 @@ func TestReassign(t *testing.T) {
 				gomock.Any(),
 			).Return(tc.updatedPR, tc.replacedBy, tc.repoError).MaxTimes(1)
 
-			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, &config)
+			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
 
 			handlers := pullrequesthandlers.CreatePullRequestHandlers(pullRequestService, log)
 
@@ -446,3 +597,573 @@ func TestReassign(t *testing.T) {
 		})
 	}
 }
+
+func TestChangeStatus(t *testing.T) {
+	log := logger.NewTest()
+
+	config := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	type testCase struct {
+		what string
+
+		operation    string
+		body         string
+		prId         string
+		updatedPR    prEntity.PullRequest
+		repoError    error
+		expectedCode int
+		expectedBody string
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid body",
+
+			operation: "close",
+			body: `{
+				"pull_request_id": "pr1"
+			`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid body"}}`,
+		},
+
+		{
+			what: "pr not found",
+
+			operation: "reopen",
+			body: `{
+				"pull_request_id": "pr1"
+			}`,
+			prId:         "pr1",
+			repoError:    prErrors.ErrNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
+		{
+			what: "invalid transition",
+
+			operation: "ready",
+			body: `{
+				"pull_request_id": "pr1"
+			}`,
+			prId:         "pr1",
+			repoError:    prErrors.ErrInvalidTransition,
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"error":{"code":"INVALID_TRANSITION","message":"pr status transition is not allowed"}}`,
+		},
+
+		{
+			what: "no reviewer capacity",
+
+			operation: "ready",
+			body: `{
+				"pull_request_id": "pr1"
+			}`,
+			prId:         "pr1",
+			repoError:    prErrors.ErrNoReviewerCapacity,
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"error":{"code":"NO_CAPACITY","message":"not enough members with review capacity"}}`,
+		},
+
+		{
+			what: "failed to close pr",
+
+			operation: "close",
+			body: `{
+				"pull_request_id": "pr1"
+			}`,
+			prId:         "pr1",
+			repoError:    errors.New("db is down"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to change pr status: ` +
+				`failed to close pr in repo: db is down"}}`,
+		},
+
+		{
+			what: "successfully closed",
+
+			operation: "close",
+			body: `{
+				"pull_request_id": "pr1"
+			}`,
+			prId: "pr1",
+			updatedPR: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PRClosed,
+				Reviewers: []string{"u2", "u3"},
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"pr":{"pull_request_id":"pr1","pull_request_name":"pull request 1","author_id":"u1",` +
+				`"status":"CLOSED","assigned_reviewers":["u2","u3"],` +
+				`"reviewer_states":[{"reviewer_id":"u2","state":"PENDING"},{"reviewer_id":"u3","state":"PENDING"}]}}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			mockPullRequestRepo.EXPECT().UpdateStatus(
+				gomock.Any(),
+				tc.prId,
+				gomock.Any(),
+			).Return(tc.updatedPR, tc.repoError).MaxTimes(1)
+
+			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
+
+			handlers := pullrequesthandlers.CreatePullRequestHandlers(pullRequestService, log)
+
+			operations := map[string]gin.HandlerFunc{
+				"close":  handlers.Close,
+				"reopen": handlers.Reopen,
+				"ready":  handlers.Ready,
+			}
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", operations[tc.operation])
+
+			body := bytes.NewBufferString(tc.body)
+			req := httptest.NewRequest("POST", "/", body)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestReview(t *testing.T) {
+	log := logger.NewTest()
+
+	config := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	type testCase struct {
+		what string
+
+		body           string
+		expectRepoCall bool
+		reviewedPR     prEntity.PullRequest
+		repoError      error
+		expectedCode   int
+		expectedBody   string
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid body",
+
+			body: `{
+				"pull_request_id": "pr1"
+			`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid body"}}`,
+		},
+
+		{
+			what: "invalid verdict",
+
+			body: `{
+				"pull_request_id": "pr1",
+				"reviewer_id": "u2",
+				"verdict": "LGTM"
+			}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid verdict"}}`,
+		},
+
+		{
+			what: "pr not found",
+
+			body: `{
+				"pull_request_id": "pr1",
+				"reviewer_id": "u2",
+				"verdict": "APPROVED"
+			}`,
+			expectRepoCall: true,
+			repoError:      prErrors.ErrNotFound,
+			expectedCode:   http.StatusNotFound,
+			expectedBody:   `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
+		{
+			what: "reviewer is not assigned",
+
+			body: `{
+				"pull_request_id": "pr1",
+				"reviewer_id": "u5",
+				"verdict": "APPROVED"
+			}`,
+			expectRepoCall: true,
+			repoError:      prErrors.ErrNotAssigned,
+			expectedCode:   http.StatusConflict,
+			expectedBody:   `{"error":{"code":"NOT_ASSIGNED","message":"reviewer is not assigned to this PR"}}`,
+		},
+
+		{
+			what: "pr already merged",
+
+			body: `{
+				"pull_request_id": "pr1",
+				"reviewer_id": "u2",
+				"verdict": "APPROVED"
+			}`,
+			expectRepoCall: true,
+			repoError:      prErrors.ErrAlreadyMerged,
+			expectedCode:   http.StatusConflict,
+			expectedBody:   `{"error":{"code":"PR_MERGED","message":"cannot review merged PR"}}`,
+		},
+
+		{
+			what: "failed to review pr",
+
+			body: `{
+				"pull_request_id": "pr1",
+				"reviewer_id": "u2",
+				"verdict": "APPROVED"
+			}`,
+			expectRepoCall: true,
+			repoError:      errors.New("db is down"),
+			expectedCode:   http.StatusInternalServerError,
+			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to review pr: ` +
+				`failed to add review in repo: db is down"}}`,
+		},
+
+		{
+			what: "successfully reviewed",
+
+			body: `{
+				"pull_request_id": "pr1",
+				"reviewer_id": "u2",
+				"verdict": "APPROVED"
+			}`,
+			expectRepoCall: true,
+			reviewedPR: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PROpen,
+				Reviewers: []string{"u2", "u3"},
+				Reviews: []prEntity.Review{
+					{
+						ReviewerId: "u2",
+						Verdict:    prEntity.VerdictApproved,
+						ReviewedAt: time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"pr":{"pull_request_id":"pr1","pull_request_name":"pull request 1","author_id":"u1",` +
+				`"status":"OPEN","assigned_reviewers":["u2","u3"],"reviewer_states":[` +
+				`{"reviewer_id":"u2","state":"APPROVED","reviewed_at":"1970-01-01T00:00:00Z"},` +
+				`{"reviewer_id":"u3","state":"PENDING"}]}}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			if tc.expectRepoCall {
+				mockPullRequestRepo.EXPECT().AddReview(
+					gomock.Any(),
+					"pr1",
+					gomock.Any(),
+				).Return(tc.reviewedPR, tc.repoError)
+			}
+
+			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
+
+			handlers := pullrequesthandlers.CreatePullRequestHandlers(pullRequestService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", handlers.Review)
+
+			body := bytes.NewBufferString(tc.body)
+			req := httptest.NewRequest("POST", "/", body)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	log := logger.NewTest()
+
+	config := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	createdAt := time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		what string
+
+		url          string
+		callRepo     bool
+		repoPR       prEntity.PullRequest
+		repoError    error
+		expectedCode int
+		expectedBody string
+	}
+
+	testCases := []testCase{
+		{
+			what:         "missing pull_request_id",
+			url:          "/",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"missing pull_request_id param"}}`,
+		},
+
+		{
+			what:         "pr not found",
+			url:          "/?pull_request_id=pr1",
+			callRepo:     true,
+			repoError:    prErrors.ErrNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
+		{
+			what:         "failed to get pr",
+			url:          "/?pull_request_id=pr1",
+			callRepo:     true,
+			repoError:    errors.New("db is down"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to get pr: ` +
+				`failed to get pull request from repo: db is down"}}`,
+		},
+
+		{
+			what:     "successfully get merged pr",
+			url:      "/?pull_request_id=pr1",
+			callRepo: true,
+			repoPR: prEntity.PullRequest{
+				Id:        "pr1",
+				Name:      "pull request 1",
+				AuthorId:  "u1",
+				Status:    prEntity.PRMerged,
+				Reviewers: []string{"u2"},
+				CreatedAt: createdAt,
+				MergedAt:  createdAt.Add(time.Hour),
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"pr":{"pull_request_id":"pr1","pull_request_name":"pull request 1","author_id":"u1",` +
+				`"status":"MERGED","assigned_reviewers":["u2"],` +
+				`"reviewer_states":[{"reviewer_id":"u2","state":"PENDING"}],` +
+				`"createdAt":"2025-11-01T12:00:00Z","mergedAt":"2025-11-01T13:00:00Z"}}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			if tc.callRepo {
+				mockPullRequestRepo.EXPECT().GetById(gomock.Any(), "pr1").Return(tc.repoPR, tc.repoError)
+			}
+
+			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
+
+			handlers := pullrequesthandlers.CreatePullRequestHandlers(pullRequestService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/", handlers.Get)
+
+			req := httptest.NewRequest("GET", tc.url, nil)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	log := logger.NewTest()
+
+	config := config.PullRequestConfig{
+		OutLimit:             10,
+		TargetReviewersCount: 2,
+	}
+
+	createdAt := time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	repoPRs := []prEntity.PullRequest{
+		{
+			Id:        "pr1",
+			Name:      "pull request 1",
+			AuthorId:  "u1",
+			Status:    prEntity.PROpen,
+			Reviewers: []string{},
+			CreatedAt: createdAt,
+		},
+		{
+			Id:        "pr2",
+			Name:      "pull request 2",
+			AuthorId:  "u1",
+			Status:    prEntity.PROpen,
+			Reviewers: []string{},
+			CreatedAt: createdAt.Add(time.Hour),
+		},
+	}
+
+	nextCursor := pagination.Cursor{Value: "2025-11-01T12:00:00Z", Id: "pr1"}.Encode()
+
+	type testCase struct {
+		what string
+
+		url           string
+		callRepo      bool
+		expectedQuery prEntity.PRListQuery
+		repoPRs       []prEntity.PullRequest
+		repoError     error
+		expectedCode  int
+		expectedBody  string
+	}
+
+	testCases := []testCase{
+		{
+			what:         "invalid limit",
+			url:          "/?limit=abc",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid limit param"}}`,
+		},
+
+		{
+			what:         "invalid created_from",
+			url:          "/?created_from=yesterday",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid created_from param"}}`,
+		},
+
+		{
+			what:         "invalid cursor",
+			url:          "/?cursor=not-a-cursor",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid cursor param"}}`,
+		},
+
+		{
+			what:         "invalid status",
+			url:          "/?status=REVIEWED",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid pr list query: unknown status REVIEWED"}}`,
+		},
+
+		{
+			what:     "failed to list prs",
+			url:      "/",
+			callRepo: true,
+			expectedQuery: prEntity.PRListQuery{
+				SortBy: prEntity.PRSortCreatedAt,
+				Order:  pagination.OrderAsc,
+				Limit:  11,
+			},
+			repoError:    errors.New("db is down"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to list prs: ` +
+				`failed to list pull requests in repo: db is down"}}`,
+		},
+
+		{
+			what:     "successfully list prs with next page",
+			url:      "/?author_id=u1&team_name=backend&status=OPEN&limit=1",
+			callRepo: true,
+			expectedQuery: prEntity.PRListQuery{
+				Filter: prEntity.PRFilter{
+					AuthorId: "u1",
+					TeamName: "backend",
+					Status:   prEntity.PROpen,
+				},
+				SortBy: prEntity.PRSortCreatedAt,
+				Order:  pagination.OrderAsc,
+				Limit:  2,
+			},
+			repoPRs:      repoPRs,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"pull_requests":[{"pull_request_id":"pr1","pull_request_name":"pull request 1",` +
+				`"author_id":"u1","status":"OPEN","assigned_reviewers":[],"reviewer_states":[],` +
+				`"createdAt":"2025-11-01T12:00:00Z"}],"next_cursor":"` + nextCursor + `"}`,
+		},
+
+		{
+			what:     "successfully list last page",
+			url:      "/?sort_by=name&order=desc&cursor=" + nextCursor,
+			callRepo: true,
+			expectedQuery: prEntity.PRListQuery{
+				SortBy: prEntity.PRSortName,
+				Order:  pagination.OrderDesc,
+				After:  &pagination.Cursor{Value: "2025-11-01T12:00:00Z", Id: "pr1"},
+				Limit:  11,
+			},
+			repoPRs:      repoPRs[1:],
+			expectedCode: http.StatusOK,
+			expectedBody: `{"pull_requests":[{"pull_request_id":"pr2","pull_request_name":"pull request 2",` +
+				`"author_id":"u1","status":"OPEN","assigned_reviewers":[],"reviewer_states":[],` +
+				`"createdAt":"2025-11-01T13:00:00Z"}]}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPullRequestRepo := prMocks.NewMockPullRequestRepo(ctrl)
+
+			if tc.callRepo {
+				mockPullRequestRepo.EXPECT().List(gomock.Any(), tc.expectedQuery).Return(tc.repoPRs, tc.repoError)
+			}
+
+			pullRequestService := pullrequestservice.CreatePullRequestService(mockPullRequestRepo, reviewerpicker.CreateRandomPicker(), nil, &config)
+
+			handlers := pullrequesthandlers.CreatePullRequestHandlers(pullRequestService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/", handlers.List)
+
+			req := httptest.NewRequest("GET", tc.url, nil)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package reviewstreamhandlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/docs"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/entity"
+	reviewStreamInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/interfaces"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/auth"
+	request_id "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/request-id"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+const lastEventIdHeader = "Last-Event-ID"
+
+type ReviewStreamHandlers struct {
+	reviewStreamService reviewStreamInterfaces.ReviewStreamService
+	logger              zerolog.Logger
+}
+
+func CreateReviewStreamHandlers(
+	reviewStreamService reviewStreamInterfaces.ReviewStreamService,
+	log zerolog.Logger,
+) *ReviewStreamHandlers {
+	return &ReviewStreamHandlers{
+		reviewStreamService: reviewStreamService,
+		logger:              log,
+	}
+}
+
+// sseSink writes events to response in text/event-stream format
+type sseSink struct {
+	ctx *gin.Context
+}
+
+func (s sseSink) Send(event entity.ReviewEvent) error {
+	data, err := json.Marshal(docs.ToReviewEventResponse(event))
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(s.ctx.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.Seq, event.Type, data); err != nil {
+		return err
+	}
+
+	s.ctx.Writer.Flush()
+
+	return nil
+}
+
+func (s sseSink) KeepAlive() error {
+	if _, err := fmt.Fprint(s.ctx.Writer, ": keep-alive\n\n"); err != nil {
+		return err
+	}
+
+	s.ctx.Writer.Flush()
+
+	return nil
+}
+
+// Add godoc
+// @Summary Подписаться на изменения очереди ревью пользователя
+// @Description Открывает поток Server-Sent Events. Событие `assigned` отправляется при назначении пользователя ревьювером,
+// @Description `unassigned` - при снятии с PR, `merged` - при мердже PR, где пользователь ревьювер.
+// @Description Поле `id` события можно передать в заголовке Last-Event-ID при переподключении, чтобы получить пропущенные события.
+// @Tags Users
+// @Security BearerAuth
+// @Produce text/event-stream
+// @Param user_id query string true "Идентификатор пользователя"
+// @Param Last-Event-ID header string false "Идентификатор последнего полученного события"
+// @Success 200 {object} docs.ReviewEventResponse "Поток событий, в data передается объект"
+// @Failure 400 {object} docs.ErrorResponse "Не указан пользователь или неверный Last-Event-ID"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Router /users/reviewStream [get]
+func (h *ReviewStreamHandlers) ReviewStream(ctx *gin.Context) {
+	log := h.localLogger(ctx, "ReviewStream")
+
+	userId := ctx.Query("user_id")
+
+	if userId == "" {
+		log.Warn().Msg("user id is missing")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"user_id param is required",
+		))
+		return
+	}
+
+	var lastEventId *int64
+
+	if header := ctx.GetHeader(lastEventIdHeader); header != "" {
+		parsed, err := strconv.ParseInt(header, 10, 64)
+
+		if err != nil || parsed < 0 {
+			log.Warn().Msg("invalid last event id")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				"invalid Last-Event-ID header",
+			))
+			return
+		}
+
+		lastEventId = &parsed
+	}
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	// disables response buffering in nginx
+	ctx.Header("X-Accel-Buffering", "no")
+
+	ctx.Status(http.StatusOK)
+	ctx.Writer.Flush()
+
+	log.Info().Str("userId", userId).Msg("review stream opened")
+
+	// headers are sent already, so failure only closes the stream and client reconnects
+	if err := h.reviewStreamService.Stream(ctx.Request.Context(), userId, lastEventId, sseSink{ctx: ctx}); err != nil {
+		log.Error().Err(err).Msg("review stream failed")
+		return
+	}
+
+	log.Info().Str("userId", userId).Msg("review stream closed")
+}
+
+func (h *ReviewStreamHandlers) localLogger(ctx *gin.Context, opName string) zerolog.Logger {
+	log := h.logger.With().
+		Str("op", opName).
+		Str("requestId", ctx.GetString(request_id.REQUEST_ID_PARAM)).
+		Logger()
+
+	return log
+}
+
+func InitReviewStreamHandlers(
+	r *gin.RouterGroup,
+	log zerolog.Logger,
+	reviewStreamService reviewStreamInterfaces.ReviewStreamService,
+	cfg *config.RestConfig,
+) {
+	handlers := CreateReviewStreamHandlers(reviewStreamService, log)
+
+	group := r.Group("users")
+
+	{
+		group.GET("reviewStream", auth.WithAuth(cfg), handlers.ReviewStream)
+	}
+}
@@ -0,0 +1,166 @@
+package reviewstreamhandlers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	reviewstreamservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/review-stream"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/entity"
+	reviewStreamMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/mocks"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/logger"
+	reviewstreamhandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/review-stream"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewStream(t *testing.T) {
+	log := logger.NewTest()
+
+	config := config.ReviewStreamConfig{
+		KeepAliveInterval: time.Minute,
+		BatchSize:         10,
+	}
+
+	createdAt := time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	events := []entity.ReviewEvent{
+		{
+			Seq:             8,
+			ReviewerId:      "u1",
+			Type:            entity.ReviewUnassigned,
+			PullRequestId:   "pr1",
+			PullRequestName: "Add search",
+			AuthorId:        "u2",
+			CreatedAt:       createdAt,
+		},
+		{
+			Seq:             9,
+			ReviewerId:      "u1",
+			Type:            entity.ReviewAssigned,
+			PullRequestId:   "pr2",
+			PullRequestName: "Fix login",
+			AuthorId:        "u3",
+			CreatedAt:       createdAt,
+		},
+	}
+
+	type testCase struct {
+		what string
+
+		url           string
+		lastEventId   string
+		openStream    bool
+		lastSeq       int64
+		expectedAfter int64
+		repoEvents    []entity.ReviewEvent
+		expectedCode  int
+		expectedBody  string
+	}
+
+	testCases := []testCase{
+		{
+			what: "missing user id",
+
+			url:          "/",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"user_id param is required"}}`,
+		},
+
+		{
+			what: "invalid last event id",
+
+			url:          "/?user_id=u1",
+			lastEventId:  "abc",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid Last-Event-ID header"}}`,
+		},
+
+		{
+			what: "missed events are replayed after reconnect",
+
+			url:           "/?user_id=u1",
+			lastEventId:   "7",
+			openStream:    true,
+			expectedAfter: 7,
+			repoEvents:    events,
+			expectedCode:  http.StatusOK,
+			expectedBody: "id: 8\nevent: unassigned\n" +
+				`data: {"user_id":"u1","pull_request_id":"pr1","pull_request_name":"Add search",` +
+				`"author_id":"u2","at":"2025-11-01T12:00:00Z"}` + "\n\n" +
+				"id: 9\nevent: assigned\n" +
+				`data: {"user_id":"u1","pull_request_id":"pr2","pull_request_name":"Fix login",` +
+				`"author_id":"u3","at":"2025-11-01T12:00:00Z"}` + "\n\n",
+		},
+
+		{
+			what: "new stream starts after the latest event",
+
+			url:           "/?user_id=u1",
+			openStream:    true,
+			lastSeq:       9,
+			expectedAfter: 9,
+			expectedCode:  http.StatusOK,
+			expectedBody:  "",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			mockRepo := reviewStreamMocks.NewMockReviewStreamRepo(ctrl)
+			mockBroker := reviewStreamMocks.NewMockReviewStreamBroker(ctrl)
+
+			if tc.openStream {
+				mockBroker.EXPECT().Subscribe("u1").Return(make(<-chan struct{}), func() {})
+
+				if tc.lastEventId == "" {
+					mockRepo.EXPECT().GetLastSeq(gomock.Any()).Return(tc.lastSeq, nil)
+				}
+
+				// client disconnects after the first read
+				mockRepo.EXPECT().GetAfter(gomock.Any(), "u1", tc.expectedAfter, 10).DoAndReturn(
+					func(_ context.Context, _ string, _ int64, _ int) ([]entity.ReviewEvent, error) {
+						cancel()
+						return tc.repoEvents, nil
+					},
+				)
+			}
+
+			reviewStreamService := reviewstreamservice.CreateReviewStreamService(mockRepo, mockBroker, &config)
+
+			handlers := reviewstreamhandlers.CreateReviewStreamHandlers(reviewStreamService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/", handlers.ReviewStream)
+
+			req := httptest.NewRequest("GET", tc.url, nil).WithContext(ctx)
+
+			if tc.lastEventId != "" {
+				req.Header.Set("Last-Event-ID", tc.lastEventId)
+			}
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+
+			if tc.openStream {
+				assert.Equal(t, "text/event-stream", recorder.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package scimhandlers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/docs"
+	scimEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/entity"
+	scimErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/errors"
+)
+
+// Okta removes a member with value filter in path instead of value list
+var memberPathPattern = regexp.MustCompile(`^(?i:members)\[\s*(?i:value)\s+(?i:eq)\s+"([^"]*)"\s*\]$`)
+
+// Entra ID sends booleans as "True" and "False" strings
+func parseBool(value any) (bool, bool) {
+	switch v := value.(type) {
+	case bool:
+		return v, true
+
+	case string:
+		parsed, err := strconv.ParseBool(v)
+		return parsed, err == nil
+
+	default:
+		return false, false
+	}
+}
+
+// operation without path carries object with attributes
+func patchAttributes(op docs.ScimPatchOperation) (map[string]any, bool) {
+	if op.Path == "" {
+		attrs, ok := op.Value.(map[string]any)
+		return attrs, ok
+	}
+
+	return map[string]any{op.Path: op.Value}, true
+}
+
+// attributes, which are not stored, e.g. name or emails, are ignored,
+// so identity providers can send full profiles
+func parseUserPatch(ops []docs.ScimPatchOperation) (scimEntity.UserPatch, error) {
+	patch := scimEntity.UserPatch{}
+
+	for _, op := range ops {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			attrs, ok := patchAttributes(op)
+
+			if !ok {
+				return scimEntity.UserPatch{}, scimErrors.ErrInvalidPatch
+			}
+
+			for attr, value := range attrs {
+				switch strings.ToLower(attr) {
+				case "active":
+					active, ok := parseBool(value)
+
+					if !ok {
+						return scimEntity.UserPatch{}, scimErrors.ErrInvalidPatch
+					}
+
+					patch.Active = &active
+
+				case "username":
+					username, ok := value.(string)
+
+					if !ok {
+						return scimEntity.UserPatch{}, scimErrors.ErrInvalidPatch
+					}
+
+					patch.Username = &username
+				}
+			}
+
+		case "remove":
+			// stored attributes are required
+			if path := strings.ToLower(op.Path); path == "" || path == "active" || path == "username" {
+				return scimEntity.UserPatch{}, scimErrors.ErrInvalidPatch
+			}
+
+		default:
+			return scimEntity.UserPatch{}, scimErrors.ErrInvalidPatch
+		}
+	}
+
+	return patch, nil
+}
+
+// value is a list of {"value": "<user id>"} objects, a single object is accepted too
+func parseMemberIds(value any) ([]string, bool) {
+	items, ok := value.([]any)
+
+	if !ok {
+		items = []any{value}
+	}
+
+	ids := make([]string, 0, len(items))
+
+	for _, item := range items {
+		member, ok := item.(map[string]any)
+
+		if !ok {
+			return nil, false
+		}
+
+		id, ok := member["value"].(string)
+
+		if !ok || id == "" {
+			return nil, false
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, true
+}
+
+func parseGroupPatch(ops []docs.ScimPatchOperation) ([]scimEntity.GroupOperation, error) {
+	res := make([]scimEntity.GroupOperation, 0, len(ops))
+
+	for _, op := range ops {
+		name := strings.ToLower(op.Op)
+
+		switch name {
+		case "add", "replace":
+			attrs, ok := patchAttributes(op)
+
+			if !ok {
+				return nil, scimErrors.ErrInvalidPatch
+			}
+
+			// map has no order, so group is renamed before its members are changed
+			for attr, value := range attrs {
+				if strings.ToLower(attr) != "displayname" {
+					continue
+				}
+
+				displayName, ok := value.(string)
+
+				if !ok {
+					return nil, scimErrors.ErrInvalidPatch
+				}
+
+				res = append(res, scimEntity.GroupOperation{
+					Kind:        scimEntity.GroupRename,
+					DisplayName: displayName,
+				})
+			}
+
+			for attr, value := range attrs {
+				if strings.ToLower(attr) != "members" {
+					continue
+				}
+
+				ids, ok := parseMemberIds(value)
+
+				if !ok {
+					return nil, scimErrors.ErrInvalidPatch
+				}
+
+				kind := scimEntity.GroupAddMembers
+				if name == "replace" {
+					kind = scimEntity.GroupReplaceMembers
+				}
+
+				res = append(res, scimEntity.GroupOperation{
+					Kind:      kind,
+					MemberIds: ids,
+				})
+			}
+
+		case "remove":
+			if match := memberPathPattern.FindStringSubmatch(op.Path); match != nil {
+				res = append(res, scimEntity.GroupOperation{
+					Kind:      scimEntity.GroupRemoveMembers,
+					MemberIds: []string{match[1]},
+				})
+				continue
+			}
+
+			switch strings.ToLower(op.Path) {
+			case "members":
+				// path without value removes all members
+				if op.Value == nil {
+					res = append(res, scimEntity.GroupOperation{
+						Kind:      scimEntity.GroupReplaceMembers,
+						MemberIds: []string{},
+					})
+					continue
+				}
+
+				ids, ok := parseMemberIds(op.Value)
+
+				if !ok {
+					return nil, scimErrors.ErrInvalidPatch
+				}
+
+				res = append(res, scimEntity.GroupOperation{
+					Kind:      scimEntity.GroupRemoveMembers,
+					MemberIds: ids,
+				})
+
+			case "", "displayname":
+				return nil, scimErrors.ErrInvalidPatch
+			}
+
+		default:
+			return nil, scimErrors.ErrInvalidPatch
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,596 @@
+package scimhandlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/docs"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	memberErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/errors"
+	scimEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/entity"
+	scimErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/interfaces"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/auth"
+	request_id "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/request-id"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+const scimContentType = "application/scim+json"
+
+type ScimHandlers struct {
+	scimService interfaces.ScimService
+	logger      zerolog.Logger
+}
+
+func CreateScimHandlers(scimService interfaces.ScimService, log zerolog.Logger) *ScimHandlers {
+	return &ScimHandlers{
+		scimService: scimService,
+		logger:      log,
+	}
+}
+
+// json render keeps content type, which is already set
+func respond(ctx *gin.Context, status int, body any) {
+	ctx.Header("Content-Type", scimContentType)
+	ctx.JSON(status, body)
+}
+
+func abort(ctx *gin.Context, status int, scimType, detail string) {
+	ctx.Header("Content-Type", scimContentType)
+	ctx.AbortWithStatusJSON(status, docs.NewScimErrorResponse(status, scimType, detail))
+}
+
+// SCIM clients expect errors in SCIM format instead of the one of other endpoints
+func (h *ScimHandlers) respondError(ctx *gin.Context, log zerolog.Logger, err error, action string) {
+	switch {
+	case errors.Is(err, memberErrors.ErrMemberNotFound):
+		log.Warn().Msg("user not found")
+		abort(ctx, http.StatusNotFound, "", "user not found")
+
+	case errors.Is(err, teamErrors.ErrTeamNotFound):
+		log.Warn().Msg("group not found")
+		abort(ctx, http.StatusNotFound, "", "group not found")
+
+	case errors.Is(err, scimErrors.ErrUserExists),
+		errors.Is(err, teamErrors.ErrTeamExists):
+		log.Warn().Err(err).Msg("resource conflicts with existing one")
+		abort(ctx, http.StatusConflict, "uniqueness", err.Error())
+
+	case errors.Is(err, teamErrors.ErrTeamHasOpenPRs):
+		log.Warn().Msg("group has open pull requests")
+		abort(ctx, http.StatusConflict, "", "group has open pull requests")
+
+	case errors.Is(err, scimErrors.ErrInvalidFilter):
+		log.Warn().Msg("invalid filter")
+		abort(ctx, http.StatusBadRequest, "invalidFilter", err.Error())
+
+	case errors.Is(err, scimErrors.ErrInvalidPatch):
+		log.Warn().Msg("invalid patch")
+		abort(ctx, http.StatusBadRequest, "invalidSyntax", err.Error())
+
+	case errors.Is(err, scimErrors.ErrInvalidUserName),
+		errors.Is(err, scimErrors.ErrUnknownMembers),
+		errors.Is(err, teamErrors.ErrInvalidTeamName):
+		log.Warn().Err(err).Msg("invalid value")
+		abort(ctx, http.StatusBadRequest, "invalidValue", err.Error())
+
+	default:
+		log.Error().Err(err).Msgf("failed to %s", action)
+		abort(ctx, http.StatusInternalServerError, "", fmt.Sprintf("failed to %s: %s", action, err.Error()))
+	}
+}
+
+func parsePage(ctx *gin.Context) (scimEntity.Page, bool) {
+	page := scimEntity.Page{}
+
+	for param, target := range map[string]*int{"startIndex": &page.StartIndex, "count": &page.Count} {
+		value := ctx.Query(param)
+
+		if value == "" {
+			continue
+		}
+
+		parsed, err := strconv.Atoi(value)
+
+		if err != nil {
+			return scimEntity.Page{}, false
+		}
+
+		*target = parsed
+	}
+
+	return page, true
+}
+
+// Add godoc
+// @Summary SCIM: создать пользователя
+// @Description externalId становится id пользователя, без него id генерируется. Пользователь создается без команды.
+// @Tags SCIM
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.ScimUser true "Пользователь SCIM"
+// @Success 201 {object} docs.ScimUser "Пользователь создан"
+// @Failure 400 {object} docs.ScimErrorResponse "Некорректный пользователь"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 409 {object} docs.ScimErrorResponse "Пользователь с таким id уже существует"
+// @Router /scim/v2/Users [post]
+func (h *ScimHandlers) CreateUser(ctx *gin.Context) {
+	log := h.localLogger(ctx, "CreateUser")
+
+	var request docs.ScimUser
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		abort(ctx, http.StatusBadRequest, "invalidSyntax", "invalid body")
+		return
+	}
+
+	active := request.Active == nil || *request.Active
+
+	member, err := h.scimService.CreateUser(ctx.Request.Context(), request.ExternalId, request.UserName, active)
+
+	if err != nil {
+		h.respondError(ctx, log, err, "create user")
+		return
+	}
+
+	respond(ctx, http.StatusCreated, docs.ToScimUser(member))
+
+	log.Info().Str("userId", member.Id).Msg("successfully created user")
+}
+
+// Add godoc
+// @Summary SCIM: список пользователей
+// @Tags SCIM
+// @Security BearerAuth
+// @Produce json
+// @Param filter query string false "Фильтр вида userName eq \"value\""
+// @Param startIndex query int false "Номер первого пользователя, начиная с 1"
+// @Param count query int false "Размер страницы, не больше 100"
+// @Success 200 {object} docs.ScimUserListResponse "Страница пользователей"
+// @Failure 400 {object} docs.ScimErrorResponse "Неподдерживаемый фильтр или неверная страница"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Router /scim/v2/Users [get]
+func (h *ScimHandlers) ListUsers(ctx *gin.Context) {
+	log := h.localLogger(ctx, "ListUsers")
+
+	page, ok := parsePage(ctx)
+
+	if !ok {
+		log.Warn().Msg("invalid page params")
+		abort(ctx, http.StatusBadRequest, "invalidValue", "startIndex and count must be integers")
+		return
+	}
+
+	list, err := h.scimService.ListUsers(ctx.Request.Context(), ctx.Query("filter"), page)
+
+	if err != nil {
+		h.respondError(ctx, log, err, "list users")
+		return
+	}
+
+	resp := docs.ScimUserListResponse{
+		Schemas:      []string{docs.ScimListSchema},
+		TotalResults: list.Total,
+		StartIndex:   list.Page.StartIndex,
+		ItemsPerPage: len(list.Users),
+		Resources:    make([]docs.ScimUser, 0, len(list.Users)),
+	}
+
+	for _, member := range list.Users {
+		resp.Resources = append(resp.Resources, docs.ToScimUser(member))
+	}
+
+	respond(ctx, http.StatusOK, resp)
+
+	log.Info().Int("count", len(list.Users)).Msg("successfully listed users")
+}
+
+// Add godoc
+// @Summary SCIM: получить пользователя
+// @Tags SCIM
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Id пользователя"
+// @Success 200 {object} docs.ScimUser "Пользователь"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ScimErrorResponse "Пользователь не найден"
+// @Router /scim/v2/Users/{id} [get]
+func (h *ScimHandlers) GetUser(ctx *gin.Context) {
+	log := h.localLogger(ctx, "GetUser")
+
+	member, err := h.scimService.GetUser(ctx.Request.Context(), ctx.Param("id"))
+
+	if err != nil {
+		h.respondError(ctx, log, err, "get user")
+		return
+	}
+
+	respond(ctx, http.StatusOK, docs.ToScimUser(member))
+
+	log.Info().Msg("successfully got user")
+}
+
+// Add godoc
+// @Summary SCIM: заменить пользователя
+// @Description Пропущенный active считается true. Деактивация выполняется так же, как /users/setIsActive.
+// @Tags SCIM
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Id пользователя"
+// @Param input body docs.ScimUser true "Пользователь SCIM"
+// @Success 200 {object} docs.ScimUser "Пользователь после изменения"
+// @Failure 400 {object} docs.ScimErrorResponse "Некорректный пользователь"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ScimErrorResponse "Пользователь не найден"
+// @Router /scim/v2/Users/{id} [put]
+func (h *ScimHandlers) ReplaceUser(ctx *gin.Context) {
+	log := h.localLogger(ctx, "ReplaceUser")
+
+	var request docs.ScimUser
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		abort(ctx, http.StatusBadRequest, "invalidSyntax", "invalid body")
+		return
+	}
+
+	active := request.Active == nil || *request.Active
+
+	patch := scimEntity.UserPatch{
+		Username: &request.UserName,
+		Active:   &active,
+	}
+
+	member, err := h.scimService.UpdateUser(ctx.Request.Context(), ctx.Param("id"), patch)
+
+	if err != nil {
+		h.respondError(ctx, log, err, "replace user")
+		return
+	}
+
+	respond(ctx, http.StatusOK, docs.ToScimUser(member))
+
+	log.Info().Msg("successfully replaced user")
+}
+
+// Add godoc
+// @Summary SCIM: изменить пользователя
+// @Description Поддерживаются атрибуты active и userName, остальные атрибуты игнорируются.
+// @Description Деактивация выполняется так же, как /users/setIsActive.
+// @Tags SCIM
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Id пользователя"
+// @Param input body docs.ScimPatchRequest true "Операции PATCH"
+// @Success 200 {object} docs.ScimUser "Пользователь после изменения"
+// @Failure 400 {object} docs.ScimErrorResponse "Неподдерживаемая операция"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ScimErrorResponse "Пользователь не найден"
+// @Router /scim/v2/Users/{id} [patch]
+func (h *ScimHandlers) PatchUser(ctx *gin.Context) {
+	log := h.localLogger(ctx, "PatchUser")
+
+	var request docs.ScimPatchRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		abort(ctx, http.StatusBadRequest, "invalidSyntax", "invalid body")
+		return
+	}
+
+	patch, err := parseUserPatch(request.Operations)
+
+	if err != nil {
+		h.respondError(ctx, log, err, "patch user")
+		return
+	}
+
+	member, err := h.scimService.UpdateUser(ctx.Request.Context(), ctx.Param("id"), patch)
+
+	if err != nil {
+		h.respondError(ctx, log, err, "patch user")
+		return
+	}
+
+	respond(ctx, http.StatusOK, docs.ToScimUser(member))
+
+	log.Info().Msg("successfully patched user")
+}
+
+// Add godoc
+// @Summary SCIM: удалить пользователя
+// @Description Пользователь деактивируется, а не удаляется, так как на него ссылаются PR.
+// @Tags SCIM
+// @Security BearerAuth
+// @Param id path string true "Id пользователя"
+// @Success 204 "Пользователь деактивирован"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ScimErrorResponse "Пользователь не найден"
+// @Router /scim/v2/Users/{id} [delete]
+func (h *ScimHandlers) DeleteUser(ctx *gin.Context) {
+	log := h.localLogger(ctx, "DeleteUser")
+
+	if err := h.scimService.DeleteUser(ctx.Request.Context(), ctx.Param("id")); err != nil {
+		h.respondError(ctx, log, err, "delete user")
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+
+	log.Info().Msg("successfully deleted user")
+}
+
+// Add godoc
+// @Summary SCIM: создать группу
+// @Description Группа соответствует команде, участники должны быть созданы заранее.
+// @Tags SCIM
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.ScimGroup true "Группа SCIM"
+// @Success 201 {object} docs.ScimGroup "Группа создана"
+// @Failure 400 {object} docs.ScimErrorResponse "Некорректное имя или неизвестные участники"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 409 {object} docs.ScimErrorResponse "Группа уже существует"
+// @Router /scim/v2/Groups [post]
+func (h *ScimHandlers) CreateGroup(ctx *gin.Context) {
+	log := h.localLogger(ctx, "CreateGroup")
+
+	var request docs.ScimGroup
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		abort(ctx, http.StatusBadRequest, "invalidSyntax", "invalid body")
+		return
+	}
+
+	team, err := h.scimService.CreateGroup(ctx.Request.Context(), request.DisplayName, memberIds(request.Members))
+
+	if err != nil {
+		h.respondError(ctx, log, err, "create group")
+		return
+	}
+
+	respond(ctx, http.StatusCreated, docs.ToScimGroup(team))
+
+	log.Info().Str("groupId", team.Id).Msg("successfully created group")
+}
+
+// Add godoc
+// @Summary SCIM: список групп
+// @Tags SCIM
+// @Security BearerAuth
+// @Produce json
+// @Param filter query string false "Фильтр вида displayName eq \"value\""
+// @Param startIndex query int false "Номер первой группы, начиная с 1"
+// @Param count query int false "Размер страницы, не больше 100"
+// @Success 200 {object} docs.ScimGroupListResponse "Страница групп"
+// @Failure 400 {object} docs.ScimErrorResponse "Неподдерживаемый фильтр или неверная страница"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Router /scim/v2/Groups [get]
+func (h *ScimHandlers) ListGroups(ctx *gin.Context) {
+	log := h.localLogger(ctx, "ListGroups")
+
+	page, ok := parsePage(ctx)
+
+	if !ok {
+		log.Warn().Msg("invalid page params")
+		abort(ctx, http.StatusBadRequest, "invalidValue", "startIndex and count must be integers")
+		return
+	}
+
+	list, err := h.scimService.ListGroups(ctx.Request.Context(), ctx.Query("filter"), page)
+
+	if err != nil {
+		h.respondError(ctx, log, err, "list groups")
+		return
+	}
+
+	resp := docs.ScimGroupListResponse{
+		Schemas:      []string{docs.ScimListSchema},
+		TotalResults: list.Total,
+		StartIndex:   list.Page.StartIndex,
+		ItemsPerPage: len(list.Groups),
+		Resources:    make([]docs.ScimGroup, 0, len(list.Groups)),
+	}
+
+	for _, team := range list.Groups {
+		resp.Resources = append(resp.Resources, docs.ToScimGroup(team))
+	}
+
+	respond(ctx, http.StatusOK, resp)
+
+	log.Info().Int("count", len(list.Groups)).Msg("successfully listed groups")
+}
+
+// Add godoc
+// @Summary SCIM: получить группу
+// @Tags SCIM
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Id группы"
+// @Success 200 {object} docs.ScimGroup "Группа"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ScimErrorResponse "Группа не найдена"
+// @Router /scim/v2/Groups/{id} [get]
+func (h *ScimHandlers) GetGroup(ctx *gin.Context) {
+	log := h.localLogger(ctx, "GetGroup")
+
+	team, err := h.scimService.GetGroup(ctx.Request.Context(), ctx.Param("id"))
+
+	if err != nil {
+		h.respondError(ctx, log, err, "get group")
+		return
+	}
+
+	respond(ctx, http.StatusOK, docs.ToScimGroup(team))
+
+	log.Info().Msg("successfully got group")
+}
+
+// Add godoc
+// @Summary SCIM: заменить группу
+// @Description Группа переименовывается и ее состав заменяется так же, как в /team/add.
+// @Tags SCIM
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Id группы"
+// @Param input body docs.ScimGroup true "Группа SCIM"
+// @Success 200 {object} docs.ScimGroup "Группа после изменения"
+// @Failure 400 {object} docs.ScimErrorResponse "Некорректное имя или неизвестные участники"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ScimErrorResponse "Группа не найдена"
+// @Failure 409 {object} docs.ScimErrorResponse "Группа с таким именем уже существует"
+// @Router /scim/v2/Groups/{id} [put]
+func (h *ScimHandlers) ReplaceGroup(ctx *gin.Context) {
+	log := h.localLogger(ctx, "ReplaceGroup")
+
+	var request docs.ScimGroup
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		abort(ctx, http.StatusBadRequest, "invalidSyntax", "invalid body")
+		return
+	}
+
+	ops := []scimEntity.GroupOperation{
+		{
+			Kind:        scimEntity.GroupRename,
+			DisplayName: request.DisplayName,
+		},
+		{
+			Kind:      scimEntity.GroupReplaceMembers,
+			MemberIds: memberIds(request.Members),
+		},
+	}
+
+	team, err := h.scimService.UpdateGroup(ctx.Request.Context(), ctx.Param("id"), ops)
+
+	if err != nil {
+		h.respondError(ctx, log, err, "replace group")
+		return
+	}
+
+	respond(ctx, http.StatusOK, docs.ToScimGroup(team))
+
+	log.Info().Msg("successfully replaced group")
+}
+
+// Add godoc
+// @Summary SCIM: изменить группу
+// @Description Поддерживаются изменение displayName и добавление, удаление и замена members.
+// @Description Удаление участника снимает его с ревью OPEN PR команды, как /team/members/remove.
+// @Tags SCIM
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Id группы"
+// @Param input body docs.ScimPatchRequest true "Операции PATCH"
+// @Success 200 {object} docs.ScimGroup "Группа после изменения"
+// @Failure 400 {object} docs.ScimErrorResponse "Неподдерживаемая операция или неизвестные участники"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ScimErrorResponse "Группа не найдена"
+// @Failure 409 {object} docs.ScimErrorResponse "Группа с таким именем уже существует"
+// @Router /scim/v2/Groups/{id} [patch]
+func (h *ScimHandlers) PatchGroup(ctx *gin.Context) {
+	log := h.localLogger(ctx, "PatchGroup")
+
+	var request docs.ScimPatchRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		abort(ctx, http.StatusBadRequest, "invalidSyntax", "invalid body")
+		return
+	}
+
+	ops, err := parseGroupPatch(request.Operations)
+
+	if err != nil {
+		h.respondError(ctx, log, err, "patch group")
+		return
+	}
+
+	team, err := h.scimService.UpdateGroup(ctx.Request.Context(), ctx.Param("id"), ops)
+
+	if err != nil {
+		h.respondError(ctx, log, err, "patch group")
+		return
+	}
+
+	respond(ctx, http.StatusOK, docs.ToScimGroup(team))
+
+	log.Info().Int("operations", len(ops)).Msg("successfully patched group")
+}
+
+// Add godoc
+// @Summary SCIM: удалить группу
+// @Description Команда с OPEN или DRAFT PR не удаляется, завершенные PR сохраняются без команды.
+// @Tags SCIM
+// @Security BearerAuth
+// @Param id path string true "Id группы"
+// @Success 204 "Группа удалена"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ScimErrorResponse "Группа не найдена"
+// @Failure 409 {object} docs.ScimErrorResponse "У команды есть незавершенные PR"
+// @Router /scim/v2/Groups/{id} [delete]
+func (h *ScimHandlers) DeleteGroup(ctx *gin.Context) {
+	log := h.localLogger(ctx, "DeleteGroup")
+
+	if err := h.scimService.DeleteGroup(ctx.Request.Context(), ctx.Param("id")); err != nil {
+		h.respondError(ctx, log, err, "delete group")
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+
+	log.Info().Msg("successfully deleted group")
+}
+
+func memberIds(members []docs.ScimGroupMember) []string {
+	ids := make([]string, 0, len(members))
+
+	for _, member := range members {
+		ids = append(ids, member.Value)
+	}
+
+	return ids
+}
+
+func (h *ScimHandlers) localLogger(ctx *gin.Context, opName string) zerolog.Logger {
+	log := h.logger.With().
+		Str("op", opName).
+		Str("requestId", ctx.GetString(request_id.REQUEST_ID_PARAM)).
+		Logger()
+
+	return log
+}
+
+func InitScimHandlers(r *gin.RouterGroup, log zerolog.Logger, scimService interfaces.ScimService, cfg *config.RestConfig) {
+	h := CreateScimHandlers(scimService, log)
+
+	group := r.Group("scim/v2", auth.WithAuth(cfg))
+
+	{
+		group.POST("Users", h.CreateUser)
+		group.GET("Users", h.ListUsers)
+		group.GET("Users/:id", h.GetUser)
+		group.PUT("Users/:id", h.ReplaceUser)
+		group.PATCH("Users/:id", h.PatchUser)
+		group.DELETE("Users/:id", h.DeleteUser)
+		group.POST("Groups", h.CreateGroup)
+		group.GET("Groups", h.ListGroups)
+		group.GET("Groups/:id", h.GetGroup)
+		group.PUT("Groups/:id", h.ReplaceGroup)
+		group.PATCH("Groups/:id", h.PatchGroup)
+		group.DELETE("Groups/:id", h.DeleteGroup)
+	}
+}
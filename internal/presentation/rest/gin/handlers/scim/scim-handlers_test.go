@@ -0,0 +1,808 @@
+package scimhandlers_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	memberservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/member"
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
+	scimservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/scim"
+	teamservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/team"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	memberErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/errors"
+	memberMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/mocks"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
+	scimErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/errors"
+	scimMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/mocks"
+	teamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
+	teamMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/mocks"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/logger"
+	scimhandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/scim"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	oktaUserId  = "00u1a2b3c4d5e6f7g8h9"
+	oktaUser2Id = "00u2b3c4d5e6f7g8h9i0"
+	entraUserId = "5a1c7e2d-3b4f-4e6a-8c9d-0e1f2a3b4c5d"
+)
+
+// fixtures are requests recorded from identity providers
+func fixture(t *testing.T, provider, name string) []byte {
+	payload, err := os.ReadFile(filepath.Join("testdata", provider, name))
+
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %s", name, err.Error())
+	}
+
+	return payload
+}
+
+func requestBody(t *testing.T, provider, name, body string) *bytes.Buffer {
+	if name == "" {
+		return bytes.NewBufferString(body)
+	}
+
+	return bytes.NewBuffer(fixture(t, provider, name))
+}
+
+type repos struct {
+	scimRepo   *scimMocks.MockScimRepo
+	memberRepo *memberMocks.MockMemberRepo
+	teamRepo   *teamMocks.MockTeamRepo
+}
+
+func createHandlers(ctrl *gomock.Controller) (*scimhandlers.ScimHandlers, repos) {
+	r := repos{
+		scimRepo:   scimMocks.NewMockScimRepo(ctrl),
+		memberRepo: memberMocks.NewMockMemberRepo(ctrl),
+		teamRepo:   teamMocks.NewMockTeamRepo(ctrl),
+	}
+
+	cfg := config.PullRequestConfig{}
+
+	memberService := memberservice.CreateMemberService(r.memberRepo, reviewerpicker.CreateRandomPicker(), &cfg)
+	teamService := teamservice.CreateTeamService(r.teamRepo, reviewerpicker.CreateRandomPicker(), nil, &cfg)
+	scimService := scimservice.CreateScimService(r.scimRepo, memberService, teamService)
+
+	return scimhandlers.CreateScimHandlers(scimService, logger.NewTest()), r
+}
+
+func TestCreateUser(t *testing.T) {
+	type testCase struct {
+		what string
+
+		provider       string
+		fixture        string
+		body           string
+		expectedMember memberEntity.Member
+		repoError      error
+		expectedCode   int
+		expectedBody   string
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid body",
+
+			body:         "{",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"400",` +
+				`"scimType":"invalidSyntax","detail":"invalid body"}`,
+		},
+
+		{
+			what: "empty userName",
+
+			body:         `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":""}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"400",` +
+				`"scimType":"invalidValue","detail":"userName must be non-empty"}`,
+		},
+
+		{
+			what: "user created by okta",
+
+			provider:       "okta",
+			fixture:        "create-user.json",
+			expectedMember: memberEntity.NewMember(oktaUserId, "bob@example.com", memberEntity.MemberActive),
+			expectedCode:   http.StatusCreated,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"id":"` + oktaUserId + `",` +
+				`"userName":"bob@example.com","active":true,"meta":{"resourceType":"User"}}`,
+		},
+
+		{
+			what: "user created by entra id",
+
+			provider:       "entra",
+			fixture:        "create-user.json",
+			expectedMember: memberEntity.NewMember(entraUserId, "alice@contoso.com", memberEntity.MemberActive),
+			expectedCode:   http.StatusCreated,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"id":"` + entraUserId + `",` +
+				`"userName":"alice@contoso.com","active":true,"meta":{"resourceType":"User"}}`,
+		},
+
+		{
+			what: "user exists",
+
+			provider:       "entra",
+			fixture:        "create-user.json",
+			expectedMember: memberEntity.NewMember(entraUserId, "alice@contoso.com", memberEntity.MemberActive),
+			repoError:      scimErrors.ErrUserExists,
+			expectedCode:   http.StatusConflict,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"409",` +
+				`"scimType":"uniqueness","detail":"user with this id already exists"}`,
+		},
+
+		{
+			what: "failed to create user",
+
+			provider:       "okta",
+			fixture:        "create-user.json",
+			expectedMember: memberEntity.NewMember(oktaUserId, "bob@example.com", memberEntity.MemberActive),
+			repoError:      errors.New("db is down"),
+			expectedCode:   http.StatusInternalServerError,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"500",` +
+				`"detail":"failed to create user: failed to create user in repo: db is down"}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handlers, r := createHandlers(ctrl)
+
+			r.scimRepo.EXPECT().CreateUser(
+				gomock.Any(),
+				tc.expectedMember,
+			).Return(tc.expectedMember, tc.repoError).MaxTimes(1)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/Users", handlers.CreateUser)
+
+			req := httptest.NewRequest("POST", "/Users", requestBody(t, tc.provider, tc.fixture, tc.body))
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, "application/scim+json", recorder.Header().Get("Content-Type"))
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	type testCase struct {
+		what string
+
+		query            string
+		expectedUsername string
+		expectedOffset   int
+		expectedLimit    int
+		storedUsers      []memberEntity.Member
+		total            int
+		expectedCode     int
+		expectedBody     string
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid count",
+
+			query:        "?count=many",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"400",` +
+				`"scimType":"invalidValue","detail":"startIndex and count must be integers"}`,
+		},
+
+		{
+			what: "unsupported filter",
+
+			query:        "?filter=emails%20co%20%22example.com%22",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"400",` +
+				`"scimType":"invalidFilter","detail":"only filters like userName eq \"value\" for users ` +
+				`and displayName eq \"value\" for groups are supported"}`,
+		},
+
+		{
+			what: "user found by userName",
+
+			query:            "?filter=userName%20eq%20%22bob%40example.com%22",
+			expectedUsername: "bob@example.com",
+			expectedOffset:   0,
+			expectedLimit:    100,
+			storedUsers: []memberEntity.Member{
+				memberEntity.NewMember(oktaUserId, "bob@example.com", memberEntity.MemberActive),
+			},
+			total:        1,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:ListResponse"],"totalResults":1,` +
+				`"startIndex":1,"itemsPerPage":1,"Resources":[{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],` +
+				`"id":"` + oktaUserId + `","userName":"bob@example.com","active":true,"meta":{"resourceType":"User"}}]}`,
+		},
+
+		{
+			what: "no user found by userName",
+
+			query:            "?filter=userName%20eq%20%22carol%40example.com%22",
+			expectedUsername: "carol@example.com",
+			expectedOffset:   0,
+			expectedLimit:    100,
+			storedUsers:      []memberEntity.Member{},
+			expectedCode:     http.StatusOK,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:ListResponse"],"totalResults":0,` +
+				`"startIndex":1,"itemsPerPage":0,"Resources":[]}`,
+		},
+
+		{
+			what: "page of users",
+
+			query:          "?startIndex=3&count=1",
+			expectedOffset: 2,
+			expectedLimit:  1,
+			storedUsers: []memberEntity.Member{
+				memberEntity.NewMember(entraUserId, "alice@contoso.com", memberEntity.MemberInactive),
+			},
+			total:        5,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:ListResponse"],"totalResults":5,` +
+				`"startIndex":3,"itemsPerPage":1,"Resources":[{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],` +
+				`"id":"` + entraUserId + `","userName":"alice@contoso.com","active":false,"meta":{"resourceType":"User"}}]}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handlers, r := createHandlers(ctrl)
+
+			r.scimRepo.EXPECT().ListUsers(
+				gomock.Any(),
+				tc.expectedUsername,
+				tc.expectedOffset,
+				tc.expectedLimit,
+			).Return(tc.storedUsers, tc.total, nil).MaxTimes(1)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/Users", handlers.ListUsers)
+
+			req := httptest.NewRequest("GET", "/Users"+tc.query, nil)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestPatchUser(t *testing.T) {
+	type testCase struct {
+		what string
+
+		userId           string
+		provider         string
+		fixture          string
+		body             string
+		expectedActivity memberEntity.MemberActivity
+		activityError    error
+		storedMember     memberEntity.Member
+		expectedCode     int
+		expectedBody     string
+	}
+
+	testCases := []testCase{
+		{
+			what: "removal of required attribute",
+
+			userId:       oktaUserId,
+			body:         `{"Operations":[{"op":"remove","path":"active"}]}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"400",` +
+				`"scimType":"invalidSyntax","detail":"unsupported patch operation"}`,
+		},
+
+		{
+			what: "user deactivated by okta",
+
+			userId:           oktaUserId,
+			provider:         "okta",
+			fixture:          "deactivate-user.json",
+			expectedActivity: memberEntity.MemberInactive,
+			storedMember:     memberEntity.NewMember(oktaUserId, "bob@example.com", memberEntity.MemberInactive),
+			expectedCode:     http.StatusOK,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"id":"` + oktaUserId + `",` +
+				`"userName":"bob@example.com","active":false,"meta":{"resourceType":"User"}}`,
+		},
+
+		{
+			what: "user deactivated by entra id",
+
+			userId:           entraUserId,
+			provider:         "entra",
+			fixture:          "deactivate-user.json",
+			expectedActivity: memberEntity.MemberInactive,
+			storedMember:     memberEntity.NewMember(entraUserId, "alice@contoso.com", memberEntity.MemberInactive),
+			expectedCode:     http.StatusOK,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"id":"` + entraUserId + `",` +
+				`"userName":"alice@contoso.com","active":false,"meta":{"resourceType":"User"}}`,
+		},
+
+		{
+			what: "user reactivated",
+
+			userId:           entraUserId,
+			body:             `{"Operations":[{"op":"Replace","path":"active","value":"True"}]}`,
+			expectedActivity: memberEntity.MemberActive,
+			storedMember:     memberEntity.NewMember(entraUserId, "alice@contoso.com", memberEntity.MemberActive),
+			expectedCode:     http.StatusOK,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"id":"` + entraUserId + `",` +
+				`"userName":"alice@contoso.com","active":true,"meta":{"resourceType":"User"}}`,
+		},
+
+		{
+			what: "user not found",
+
+			userId:           "u9",
+			provider:         "okta",
+			fixture:          "deactivate-user.json",
+			expectedActivity: memberEntity.MemberInactive,
+			activityError:    memberErrors.ErrMemberNotFound,
+			expectedCode:     http.StatusNotFound,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"404",` +
+				`"detail":"user not found"}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handlers, r := createHandlers(ctrl)
+
+			r.memberRepo.EXPECT().SetActivity(
+				gomock.Any(),
+				tc.userId,
+				tc.expectedActivity,
+				gomock.Any(),
+			).Return(tc.storedMember, prEntity.ReassignReport{}, tc.activityError).MaxTimes(1)
+
+			r.scimRepo.EXPECT().GetUser(
+				gomock.Any(),
+				tc.userId,
+			).Return(tc.storedMember, nil).MaxTimes(1)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.PATCH("/Users/:id", handlers.PatchUser)
+
+			req := httptest.NewRequest("PATCH", "/Users/"+tc.userId, requestBody(t, tc.provider, tc.fixture, tc.body))
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	type testCase struct {
+		what string
+
+		userId        string
+		activityError error
+		expectedCode  int
+		expectedBody  string
+	}
+
+	testCases := []testCase{
+		{
+			what: "user not found",
+
+			userId:        "u9",
+			activityError: memberErrors.ErrMemberNotFound,
+			expectedCode:  http.StatusNotFound,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"404",` +
+				`"detail":"user not found"}`,
+		},
+
+		{
+			what: "user deactivated instead of deletion",
+
+			userId:       oktaUserId,
+			expectedCode: http.StatusNoContent,
+			expectedBody: "",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handlers, r := createHandlers(ctrl)
+
+			r.memberRepo.EXPECT().SetActivity(
+				gomock.Any(),
+				tc.userId,
+				memberEntity.MemberInactive,
+				gomock.Any(),
+			).Return(memberEntity.Member{}, prEntity.ReassignReport{}, tc.activityError).Times(1)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.DELETE("/Users/:id", handlers.DeleteUser)
+
+			req := httptest.NewRequest("DELETE", "/Users/"+tc.userId, nil)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestCreateGroup(t *testing.T) {
+	type testCase struct {
+		what string
+
+		provider     string
+		fixture      string
+		body         string
+		storedUsers  []memberEntity.Member
+		expectedTeam *teamEntity.Team
+		createError  error
+		storedTeam   teamEntity.Team
+		expectedCode int
+		expectedBody string
+	}
+
+	bob := memberEntity.NewMember(oktaUserId, "bob@example.com", memberEntity.MemberActive)
+
+	testCases := []testCase{
+		{
+			what: "invalid displayName",
+
+			body:         `{"displayName":"","members":[]}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"400",` +
+				`"scimType":"invalidValue","detail":"team name must be non-empty and at most 64 characters long"}`,
+		},
+
+		{
+			what: "group exists",
+
+			provider: "entra",
+			fixture:  "create-group.json",
+			expectedTeam: &teamEntity.Team{
+				Name:    "backend",
+				Members: []memberEntity.Member{},
+			},
+			createError:  teamErrors.ErrTeamExists,
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"409",` +
+				`"scimType":"uniqueness","detail":"team already exists"}`,
+		},
+
+		{
+			what: "unknown member",
+
+			body:         `{"displayName":"backend","members":[{"value":"u9"}]}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"400",` +
+				`"scimType":"invalidValue","detail":"some members of group not found"}`,
+		},
+
+		{
+			what: "group created by entra id",
+
+			provider: "entra",
+			fixture:  "create-group.json",
+			expectedTeam: &teamEntity.Team{
+				Name:    "backend",
+				Members: []memberEntity.Member{},
+			},
+			storedTeam: teamEntity.Team{
+				Id:      "g1",
+				Name:    "backend",
+				Members: []memberEntity.Member{},
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:Group"],"id":"g1",` +
+				`"displayName":"backend","members":[],"meta":{"resourceType":"Group"}}`,
+		},
+
+		{
+			what: "group created with members",
+
+			body:        `{"displayName":"backend","members":[{"value":"` + oktaUserId + `"}]}`,
+			storedUsers: []memberEntity.Member{bob},
+			expectedTeam: &teamEntity.Team{
+				Name:    "backend",
+				Members: []memberEntity.Member{bob},
+			},
+			storedTeam: teamEntity.Team{
+				Id:      "g1",
+				Name:    "backend",
+				Members: []memberEntity.Member{bob},
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:Group"],"id":"g1",` +
+				`"displayName":"backend","members":[{"value":"` + oktaUserId + `","display":"bob@example.com"}],` +
+				`"meta":{"resourceType":"Group"}}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handlers, r := createHandlers(ctrl)
+
+			for _, user := range tc.storedUsers {
+				r.scimRepo.EXPECT().GetUser(gomock.Any(), user.Id).Return(user, nil)
+			}
+
+			r.scimRepo.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(memberEntity.Member{}, memberErrors.ErrMemberNotFound).AnyTimes()
+
+			if tc.expectedTeam != nil {
+				r.teamRepo.EXPECT().Create(gomock.Any(), teamEntity.Matcher(*tc.expectedTeam)).Return(tc.createError)
+			}
+
+			if tc.storedTeam.Id != "" {
+				r.teamRepo.EXPECT().GetByName(gomock.Any(), tc.storedTeam.Name).Return(tc.storedTeam, nil)
+			}
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/Groups", handlers.CreateGroup)
+
+			req := httptest.NewRequest("POST", "/Groups", requestBody(t, tc.provider, tc.fixture, tc.body))
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestPatchGroup(t *testing.T) {
+	type testCase struct {
+		what string
+
+		provider  string
+		fixture   string
+		body      string
+		groupName string
+		groupErr  error
+		// group before patch
+		storedTeam  *teamEntity.Team
+		storedUsers []memberEntity.Member
+		// group passed to single replace with all operations applied
+		replaceTeam  *teamEntity.Team
+		replacedTeam teamEntity.Team
+		replaceError error
+		expectedCode int
+		expectedBody string
+	}
+
+	bob := memberEntity.NewMember(oktaUserId, "bob@example.com", memberEntity.MemberActive)
+	carol := memberEntity.NewMember(oktaUser2Id, "carol@example.com", memberEntity.MemberActive)
+	alice := memberEntity.NewMember(entraUserId, "alice@contoso.com", memberEntity.MemberActive)
+
+	testCases := []testCase{
+		{
+			what: "removal of displayName",
+
+			body:         `{"Operations":[{"op":"remove","path":"displayName"}]}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"400",` +
+				`"scimType":"invalidSyntax","detail":"unsupported patch operation"}`,
+		},
+
+		{
+			what: "group not found",
+
+			provider:     "okta",
+			fixture:      "rename-group.json",
+			groupErr:     teamErrors.ErrTeamNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"404",` +
+				`"detail":"group not found"}`,
+		},
+
+		{
+			what: "group renamed by okta",
+
+			provider:   "okta",
+			fixture:    "rename-group.json",
+			groupName:  "backend",
+			storedTeam: &teamEntity.Team{Id: "g1", Name: "backend", Members: []memberEntity.Member{bob}},
+			replaceTeam: &teamEntity.Team{
+				Name:    "backend-core",
+				Members: []memberEntity.Member{bob},
+			},
+			replacedTeam: teamEntity.Team{Id: "g1", Name: "backend-core", Members: []memberEntity.Member{bob}},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:Group"],"id":"g1",` +
+				`"displayName":"backend-core","members":[{"value":"` + oktaUserId + `","display":"bob@example.com"}],` +
+				`"meta":{"resourceType":"Group"}}`,
+		},
+
+		{
+			what: "member removed by okta",
+
+			provider:   "okta",
+			fixture:    "remove-group-member.json",
+			groupName:  "backend",
+			storedTeam: &teamEntity.Team{Id: "g1", Name: "backend", Members: []memberEntity.Member{bob, carol}},
+			replaceTeam: &teamEntity.Team{
+				Name:    "backend",
+				Members: []memberEntity.Member{bob},
+			},
+			replacedTeam: teamEntity.Team{Id: "g1", Name: "backend", Members: []memberEntity.Member{bob}},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:Group"],"id":"g1",` +
+				`"displayName":"backend","members":[{"value":"` + oktaUserId + `","display":"bob@example.com"}],` +
+				`"meta":{"resourceType":"Group"}}`,
+		},
+
+		{
+			what: "removal of user, which is not in group",
+
+			provider:   "okta",
+			fixture:    "remove-group-member.json",
+			groupName:  "backend",
+			storedTeam: &teamEntity.Team{Id: "g1", Name: "backend", Members: []memberEntity.Member{bob}},
+			replaceTeam: &teamEntity.Team{
+				Name:    "backend",
+				Members: []memberEntity.Member{bob},
+			},
+			replacedTeam: teamEntity.Team{Id: "g1", Name: "backend", Members: []memberEntity.Member{bob}},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:Group"],"id":"g1",` +
+				`"displayName":"backend","members":[{"value":"` + oktaUserId + `","display":"bob@example.com"}],` +
+				`"meta":{"resourceType":"Group"}}`,
+		},
+
+		{
+			what: "member added by entra id",
+
+			provider:    "entra",
+			fixture:     "add-group-member.json",
+			groupName:   "backend",
+			storedTeam:  &teamEntity.Team{Id: "g1", Name: "backend", Members: []memberEntity.Member{}},
+			storedUsers: []memberEntity.Member{alice},
+			replaceTeam: &teamEntity.Team{
+				Name:    "backend",
+				Members: []memberEntity.Member{alice},
+			},
+			replacedTeam: teamEntity.Team{Id: "g1", Name: "backend", Members: []memberEntity.Member{alice}},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:Group"],"id":"g1",` +
+				`"displayName":"backend","members":[{"value":"` + entraUserId + `","display":"alice@contoso.com"}],` +
+				`"meta":{"resourceType":"Group"}}`,
+		},
+
+		{
+			what: "unknown member added",
+
+			provider:     "entra",
+			fixture:      "add-group-member.json",
+			groupName:    "backend",
+			storedTeam:   &teamEntity.Team{Id: "g1", Name: "backend", Members: []memberEntity.Member{}},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"400",` +
+				`"scimType":"invalidValue","detail":"some members of group not found"}`,
+		},
+
+		{
+			what: "members replaced with the same roster",
+
+			body:       `{"Operations":[{"op":"replace","path":"members","value":[{"value":"` + oktaUserId + `"}]}]}`,
+			groupName:  "backend",
+			storedTeam: &teamEntity.Team{Id: "g1", Name: "backend", Members: []memberEntity.Member{bob}},
+			replaceTeam: &teamEntity.Team{
+				Name:    "backend",
+				Members: []memberEntity.Member{bob},
+			},
+			replacedTeam: teamEntity.Team{Id: "g1", Name: "backend", Members: []memberEntity.Member{bob}},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:Group"],"id":"g1",` +
+				`"displayName":"backend","members":[{"value":"` + oktaUserId + `","display":"bob@example.com"}],` +
+				`"meta":{"resourceType":"Group"}}`,
+		},
+
+		{
+			what: "failed rename rejects the whole patch",
+
+			body: `{"Operations":[{"op":"add","path":"members","value":[{"value":"` + oktaUser2Id + `"}]},` +
+				`{"op":"replace","path":"displayName","value":"frontend"}]}`,
+			groupName:   "backend",
+			storedTeam:  &teamEntity.Team{Id: "g1", Name: "backend", Members: []memberEntity.Member{bob}},
+			storedUsers: []memberEntity.Member{carol},
+			replaceTeam: &teamEntity.Team{
+				Name:    "frontend",
+				Members: []memberEntity.Member{bob, carol},
+			},
+			replaceError: teamErrors.ErrTeamExists,
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"409",` +
+				`"scimType":"uniqueness","detail":"team already exists"}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handlers, r := createHandlers(ctrl)
+
+			r.scimRepo.EXPECT().GetGroupName(gomock.Any(), "g1").Return(tc.groupName, tc.groupErr).MaxTimes(1)
+
+			for _, user := range tc.storedUsers {
+				r.scimRepo.EXPECT().GetUser(gomock.Any(), user.Id).Return(user, nil)
+			}
+
+			r.scimRepo.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(memberEntity.Member{}, memberErrors.ErrMemberNotFound).AnyTimes()
+
+			if tc.storedTeam != nil {
+				r.teamRepo.EXPECT().GetByName(gomock.Any(), tc.groupName).Return(*tc.storedTeam, nil)
+			}
+
+			if tc.replaceTeam != nil {
+				r.teamRepo.EXPECT().Replace(
+					gomock.Any(),
+					tc.groupName,
+					teamEntity.Matcher(*tc.replaceTeam),
+				).Return(tc.replacedTeam, tc.replaceError)
+			}
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.PATCH("/Groups/:id", handlers.PatchGroup)
+
+			req := httptest.NewRequest("PATCH", "/Groups/g1", requestBody(t, tc.provider, tc.fixture, tc.body))
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
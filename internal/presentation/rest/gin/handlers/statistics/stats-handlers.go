@@ -1,12 +1,15 @@
 package statshandlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
-	"strconv"
 
 	"github.com/SmokingElk/avito-2025-autumn-intership/docs"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/statistics/interfaces"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
+	pageparams "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/page-params"
 	"github.com/gin-gonic/gin"
 )
 
@@ -23,41 +26,52 @@ func CreateStatsHandlers(statsService interfaces.StatsService) *StatsHandlers {
 // Add godoc
 // @Summary Получить статистику назначений пользователей ревьюверами
 // @Tags Stats
-// @Param limit query int true "Количество записей в результате"
-// @Param offset query int true "Отступ в статистике"
+// @Param limit query int false "Размер страницы, по умолчанию максимальный"
+// @Param cursor query string false "Курсор следующей страницы из предыдущего ответа"
+// @Param team_name query string false "Только участники команды и назначения на PR этой команды"
 // @Produce json
-// @Success 200 {object} docs.AssignmentsStats "Статистика по назначениям"
+// @Success 200 {object} docs.AssignmentsStats "Статистика по назначениям, упорядоченная по убыванию числа назначений"
+// @Failure 400 {object} docs.ErrorResponse "Неверные параметры запроса или курсор"
+// @Failure 404 {object} docs.ErrorResponse "Команда не найдена"
 // @Router /stats/assignmentsPerMember [get]
 func (h *StatsHandlers) GetAssignmentsPerMember(ctx *gin.Context) {
-	limitStr := ctx.Query("limit")
-	limit, err := strconv.Atoi(limitStr)
+	after, limit, err := pageparams.Parse(ctx)
 
-	if limitStr == "" || err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
-			"BAD_REQUEST",
-			"invalid limit param",
-		))
-		return
-	}
-
-	offsetStr := ctx.Query("offset")
-	offset, err := strconv.Atoi(offsetStr)
-
-	if offsetStr == "" || err != nil {
+	if err != nil {
 		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
 			"BAD_REQUEST",
-			"invalid offset param",
+			err.Error(),
 		))
 		return
 	}
 
-	stats, err := h.statsService.GetAssignmentsPerMember(ctx.Request.Context(), limit, offset)
+	stats, next, err := h.statsService.GetAssignmentsPerMember(
+		ctx.Request.Context(),
+		ctx.Query("team_name"),
+		after,
+		limit,
+	)
 
 	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
-			"INTERNAL_SERVER_ERROR",
-			fmt.Sprintf("failed to get assignmnets per member: %s", err.Error()),
-		))
+		switch {
+		case errors.Is(err, pagination.ErrInvalidLimit), errors.Is(err, pagination.ErrInvalidCursor):
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				err.Error(),
+			))
+
+		case errors.Is(err, teamErrors.ErrTeamNotFound):
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to get assignmnets per member: %s", err.Error()),
+			))
+		}
 
 		return
 	}
@@ -71,6 +85,10 @@ func (h *StatsHandlers) GetAssignmentsPerMember(ctx *gin.Context) {
 		resp.Results = append(resp.Results, docs.ToAssignmentsPerMemberResponse(assignmentsStats))
 	}
 
+	if next != nil {
+		resp.NextCursor = next.Encode()
+	}
+
 	ctx.JSON(http.StatusOK, resp)
 }
 
@@ -8,8 +8,11 @@ import (
 	"testing"
 
 	statsservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/statistics"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pagination"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/statistics/entity"
 	statsMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/statistics/mocks"
+	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
 	statshandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/statistics"
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
@@ -17,13 +20,31 @@ import (
 )
 
 func TestGetAssignmentsPerMember(t *testing.T) {
+	config := config.StatsConfig{
+		OutLimit: 10,
+	}
+
+	repoStats := []entity.AssignmentsPerMember{
+		{
+			MemberId:         "u1",
+			AssignmentsCount: 5,
+		},
+		{
+			MemberId:         "u2",
+			AssignmentsCount: 2,
+		},
+	}
+
+	cursor := pagination.Cursor{Value: "5", Id: "u1"}
+
 	type testCase struct {
 		what string
 
-		limitParam    string
-		offsetParam   string
+		url           string
+		callRepo      bool
+		teamName      string
+		after         *pagination.Cursor
 		limit         int
-		offset        int
 		repoError     error
 		expectedStats []entity.AssignmentsPerMember
 		expectedCode  int
@@ -34,28 +55,33 @@ func TestGetAssignmentsPerMember(t *testing.T) {
 		{
 			what: "invalid limit param",
 
-			limitParam:   "",
-			offsetParam:  "1",
+			url:          "/?limit=abc",
 			expectedCode: http.StatusBadRequest,
 			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid limit param"}}`,
 		},
 
 		{
-			what: "invalid offset param",
+			what: "invalid cursor param",
+
+			url:          "/?cursor=not-a-cursor",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid cursor param"}}`,
+		},
+
+		{
+			what: "limit exceeds max page size",
 
-			limitParam:   "1",
-			offsetParam:  "",
+			url:          "/?limit=11",
 			expectedCode: http.StatusBadRequest,
-			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid offset param"}}`,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid page size: limit must be between 1 and 10"}}`,
 		},
 
 		{
 			what: "failed to get assignmnets per member",
 
-			limitParam:   "2",
-			offsetParam:  "5",
-			limit:        2,
-			offset:       5,
+			url:          "/?limit=2",
+			callRepo:     true,
+			limit:        3,
 			repoError:    errors.New("db is down"),
 			expectedCode: http.StatusInternalServerError,
 			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to get assignmnets per member: ` +
@@ -63,26 +89,39 @@ func TestGetAssignmentsPerMember(t *testing.T) {
 		},
 
 		{
-			what: "failed to get assignmnets per member",
+			what: "team not found",
+
+			url:          "/?team_name=backend",
+			callRepo:     true,
+			teamName:     "backend",
+			limit:        11,
+			repoError:    teamErrors.ErrTeamNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
 
-			limitParam:  "2",
-			offsetParam: "5",
-			limit:       2,
-			offset:      5,
-			repoError:   nil,
-			expectedStats: []entity.AssignmentsPerMember{
-				{
-					MemberId:         "u1",
-					AssignmentsCount: 5,
-				},
-				{
-					MemberId:         "u2",
-					AssignmentsCount: 2,
-				},
-			},
-			expectedCode: http.StatusOK,
-			expectedBody: `{"count":2,"results":[{"member_id":"u1","assignments_count":5},` +
-				`{"member_id":"u2","assignments_count":2}]}`,
+		{
+			what: "successfully get page with next cursor",
+
+			url:           "/?limit=1",
+			callRepo:      true,
+			limit:         2,
+			expectedStats: repoStats,
+			expectedCode:  http.StatusOK,
+			expectedBody: `{"count":1,"results":[{"member_id":"u1","assignments_count":5}],` +
+				`"next_cursor":"` + cursor.Encode() + `"}`,
+		},
+
+		{
+			what: "successfully get last page",
+
+			url:           "/?cursor=" + cursor.Encode(),
+			callRepo:      true,
+			after:         &cursor,
+			limit:         11,
+			expectedStats: repoStats[1:],
+			expectedCode:  http.StatusOK,
+			expectedBody:  `{"count":1,"results":[{"member_id":"u2","assignments_count":2}]}`,
 		},
 	}
 
@@ -93,13 +132,16 @@ func TestGetAssignmentsPerMember(t *testing.T) {
 
 			statsRepo := statsMocks.NewMockStatsRepo(ctrl)
 
-			statsRepo.EXPECT().GetAssignmentsPerMember(
-				gomock.Any(),
-				tc.limit,
-				tc.offset,
-			).Return(tc.expectedStats, tc.repoError).MaxTimes(1)
+			if tc.callRepo {
+				statsRepo.EXPECT().GetAssignmentsPerMember(
+					gomock.Any(),
+					tc.teamName,
+					tc.after,
+					tc.limit,
+				).Return(tc.expectedStats, tc.repoError)
+			}
 
-			statsService := statsservice.CreateStatsService(statsRepo)
+			statsService := statsservice.CreateStatsService(statsRepo, &config)
 
 			handlers := statshandlers.CreateStatsHandlers(statsService)
 
@@ -107,7 +149,7 @@ func TestGetAssignmentsPerMember(t *testing.T) {
 			router := gin.New()
 			router.GET("/", handlers.GetAssignmentsPerMember)
 
-			req := httptest.NewRequest("GET", fmt.Sprintf("/?limit=%s&offset=%s", tc.limitParam, tc.offsetParam), nil)
+			req := httptest.NewRequest("GET", tc.url, nil)
 
 			recorder := httptest.NewRecorder()
 
@@ -4,10 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/SmokingElk/avito-2025-autumn-intership/docs"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	codeownersEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/entity"
+	codeownersErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/codeowners/errors"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	teamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
 	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/interfaces"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/auth"
@@ -30,17 +34,38 @@ func CreateTeamHandlers(teamService interfaces.TeamService, log zerolog.Logger)
 
 // Add godoc
 // @Summary Создать команду с участниками (создает/обновляет пользователей)
+// @Description Ответ содержит изменения состава: добавленных, удаленных участников, добавленных участников других команд
+// @Description и снятые назначения на OPEN PR. С dry_run=true изменения только вычисляются и не сохраняются,
+// @Description для совпадающего состава возвращается пустой diff.
 // @Tags Teams
 // @Accept json
 // @Produce json
 // @Param input body docs.AddTeamRequest true "Данные для создания/обновления"
+// @Param dry_run query bool false "Только вычислить изменения состава, ничего не сохраняя"
+// @Success 200 {object} docs.AddTeamResponse "Изменения, которые будут внесены (dry_run)"
 // @Success 201 {object} docs.AddTeamResponse "Команда создана"
-// @Failure 400 {object} docs.ErrorResponse "Команда уже существует"
-// @Failure 409 {object} docs.ErrorResponse "Пользователь является членом другой команды"
+// @Failure 400 {object} docs.ErrorResponse "Команда уже существует или неверный dry_run"
 // @Router /team/add [post]
 func (h *TeamHandlers) Add(ctx *gin.Context) {
 	log := h.localLogger(ctx, "Add")
 
+	dryRun := false
+
+	if dryRunStr := ctx.Query("dry_run"); dryRunStr != "" {
+		parsed, err := strconv.ParseBool(dryRunStr)
+
+		if err != nil {
+			log.Warn().Msg("invalid dry_run param")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				"invalid dry_run param",
+			))
+			return
+		}
+
+		dryRun = parsed
+	}
+
 	var request docs.AddTeamRequest
 
 	if err := ctx.BindJSON(&request); err != nil {
@@ -58,7 +83,7 @@ func (h *TeamHandlers) Add(ctx *gin.Context) {
 		membersEntities = append(membersEntities, member.ToTeamMemberEntity())
 	}
 
-	err := h.teamService.Upsert(ctx.Request.Context(), request.Name, membersEntities)
+	diff, err := h.teamService.Upsert(ctx.Request.Context(), request.Name, membersEntities, dryRun)
 
 	if err != nil {
 		switch {
@@ -69,13 +94,6 @@ func (h *TeamHandlers) Add(ctx *gin.Context) {
 				"team_name already exists",
 			))
 
-		case errors.Is(err, teamErrors.ErrMemberOfOtherTeam):
-			log.Warn().Msg("member of other team")
-			ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
-				"MEMBER_OF_OTHER_TEAM",
-				"User is member of other team",
-			))
-
 		default:
 			log.Error().Err(err).Msg("failed to create team")
 			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
@@ -88,12 +106,21 @@ func (h *TeamHandlers) Add(ctx *gin.Context) {
 	}
 
 	resp := docs.AddTeamResponse{
-		Team: docs.AddTeamResponseObject(request),
+		Team:   docs.AddTeamResponseObject(request),
+		Diff:   docs.ToTeamDiffResponse(diff),
+		DryRun: dryRun,
+	}
+
+	if dryRun {
+		ctx.JSON(http.StatusOK, resp)
+
+		log.Info().Int("added", len(diff.Added)).Int("removed", len(diff.Removed)).Msg("successfully planned team upsert")
+		return
 	}
 
 	ctx.JSON(http.StatusCreated, resp)
 
-	log.Info().Msg("successfully added team")
+	log.Info().Int("added", len(diff.Added)).Int("removed", len(diff.Removed)).Msg("successfully added team")
 }
 
 // Add godoc
@@ -142,30 +169,22 @@ func (h *TeamHandlers) Get(ctx *gin.Context) {
 		return
 	}
 
-	resp := docs.GetTeamResponse{
-		Name:    team.Name,
-		Members: make([]docs.TeamMember, 0, len(team.Members)),
-	}
-
-	for _, member := range team.Members {
-		resp.Members = append(resp.Members, docs.ToTeamMember(member))
-	}
-
-	ctx.JSON(http.StatusOK, resp)
+	ctx.JSON(http.StatusOK, docs.ToGetTeamResponse(team))
 
 	log.Info().Msg("successfully created team")
 }
 
 // Add godoc
-// @Summary Сделать всех участников в команде неактивными
+// @Summary Сделать всех участников в команде, кроме перечисленных, неактивными
 // @Tags Teams
 // @Security BearerAuth
 // @Accept json
 // @Produce json
-// @Param input body docs.DeactivateAllRequest true "Имя команды"
-// @Success 200 {object} docs.DeactivateAllResponse "Участникам установлен статус 'не активен'"
+// @Param input body docs.DeactivateAllRequest true "Имя команды и участники, которые остаются активными"
+// @Success 200 {object} docs.DeactivateAllResponse "Отчет: число деактивированных, переназначения и PR с нехваткой ревьюверов"
 // @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
 // @Failure 404 {object} docs.ErrorResponse "Команда не найдена"
+// @Failure 503 {object} docs.ErrorResponse "Превышен бюджет времени, изменения отменены"
 // @Router /team/deactivateAll [post]
 func (h *TeamHandlers) DeactivateAll(ctx *gin.Context) {
 	log := h.localLogger(ctx, "DeactivateAll")
@@ -181,7 +200,12 @@ func (h *TeamHandlers) DeactivateAll(ctx *gin.Context) {
 		return
 	}
 
-	err := h.teamService.DeactivateAll(ctx.Request.Context(), request.Name)
+	report, err := h.teamService.DeactivateAll(
+		ctx.Request.Context(),
+		request.Name,
+		request.KeepActive,
+		request.ReassignReviews,
+	)
 
 	if err != nil {
 		switch {
@@ -192,6 +216,13 @@ func (h *TeamHandlers) DeactivateAll(ctx *gin.Context) {
 				"resource not found",
 			))
 
+		case errors.Is(err, teamErrors.ErrDeactivationTimeout):
+			log.Warn().Msg("deactivation exceeded latency budget")
+			ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, docs.NewErrorResponse(
+				"TIMEOUT",
+				"deactivation exceeded latency budget, no changes were made",
+			))
+
 		default:
 			log.Error().Err(err).Msg("failed to deactivate members of team")
 			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
@@ -203,11 +234,572 @@ func (h *TeamHandlers) DeactivateAll(ctx *gin.Context) {
 		return
 	}
 
-	resp := docs.DeactivateAllResponse{
-		Result: "ok",
+	resp := docs.ToDeactivateAllResponse(report)
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Int("deactivated", report.Deactivated).Msg("successfully deactivated members of team")
+}
+
+// Add godoc
+// @Summary Загрузить правила CODEOWNERS команды (заменяют правила из конфига)
+// @Tags Teams
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.SetCodeownersRequest true "Имя команды и текст CODEOWNERS"
+// @Success 200 {object} docs.CodeownersResponse "Сохраненные правила"
+// @Failure 400 {object} docs.ErrorResponse "Неверный формат правил"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Команда не найдена"
+// @Router /team/codeowners [post]
+func (h *TeamHandlers) SetCodeowners(ctx *gin.Context) {
+	log := h.localLogger(ctx, "SetCodeowners")
+
+	var request docs.SetCodeownersRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	ruleset, err := h.teamService.SetCodeowners(ctx.Request.Context(), request.TeamName, request.Rules)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, codeownersErrors.ErrInvalidRuleset):
+			log.Warn().Err(err).Msg("invalid codeowners")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"INVALID_CODEOWNERS",
+				err.Error(),
+			))
+
+		case errors.Is(err, teamErrors.ErrTeamNotFound):
+			log.Warn().Msg("team not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to set codeowners")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to set codeowners: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.ToCodeownersResponse(request.TeamName, ruleset, codeownersEntity.SourceApi)
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Int("rules", len(ruleset.Rules)).Msg("successfully set codeowners")
+}
+
+// Add godoc
+// @Summary Получить правила CODEOWNERS, по которым выбираются ревьюверы команды
+// @Tags Teams
+// @Security BearerAuth
+// @Param team_name query string true "Уникальное имя команды"
+// @Produce json
+// @Success 200 {object} docs.CodeownersResponse "Правила и их источник: api, config или none"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Команда не найдена"
+// @Router /team/codeowners [get]
+func (h *TeamHandlers) GetCodeowners(ctx *gin.Context) {
+	log := h.localLogger(ctx, "GetCodeowners")
+
+	teamName := ctx.Query("team_name")
+
+	if teamName == "" {
+		log.Warn().Msg("invalid team_name param")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid team_name param",
+		))
+		return
+	}
+
+	ruleset, source, err := h.teamService.GetCodeowners(ctx.Request.Context(), teamName)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, teamErrors.ErrTeamNotFound):
+			log.Warn().Msg("team not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to get codeowners")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to get codeowners: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.ToCodeownersResponse(teamName, ruleset, source)
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Str("source", string(source)).Msg("successfully got codeowners")
+}
+
+// Add godoc
+// @Summary Переименовать команду
+// @Tags Teams
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.RenameTeamRequest true "Текущее и новое имя команды"
+// @Success 200 {object} docs.RenameTeamResponse "Команда переименована"
+// @Failure 400 {object} docs.ErrorResponse "Некорректное новое имя"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Команда не найдена"
+// @Failure 409 {object} docs.ErrorResponse "Команда с новым именем уже существует"
+// @Router /team/rename [post]
+func (h *TeamHandlers) Rename(ctx *gin.Context) {
+	log := h.localLogger(ctx, "Rename")
+
+	var request docs.RenameTeamRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	err := h.teamService.Rename(ctx.Request.Context(), request.Name, request.NewName)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, teamErrors.ErrInvalidTeamName):
+			log.Warn().Msg("invalid team name")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				err.Error(),
+			))
+
+		case errors.Is(err, teamErrors.ErrTeamNotFound):
+			log.Warn().Msg("team not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		case errors.Is(err, teamErrors.ErrTeamExists):
+			log.Warn().Msg("team already exists")
+			ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
+				"TEAM_EXISTS",
+				"team_name already exists",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to rename team")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to rename team: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.RenameTeamResponse{
+		Name:         request.NewName,
+		PreviousName: request.Name,
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Msg("successfully renamed team")
+}
+
+// Add godoc
+// @Summary Архивировать команду или вернуть ее из архива
+// @Description В архивной команде нельзя создавать PR, участники и история PR сохраняются.
+// @Tags Teams
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.ArchiveTeamRequest true "Имя команды и признак архива"
+// @Success 200 {object} docs.ArchiveTeamResponse "Состояние команды"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Команда не найдена"
+// @Router /team/archive [post]
+func (h *TeamHandlers) Archive(ctx *gin.Context) {
+	log := h.localLogger(ctx, "Archive")
+
+	var request docs.ArchiveTeamRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	archived := true
+	if request.Archived != nil {
+		archived = *request.Archived
+	}
+
+	err := h.teamService.SetArchived(ctx.Request.Context(), request.Name, archived)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, teamErrors.ErrTeamNotFound):
+			log.Warn().Msg("team not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to archive team")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to archive team: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.ArchiveTeamResponse{
+		Name:     request.Name,
+		Archived: archived,
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Bool("archived", archived).Msg("successfully set archived")
+}
+
+// Add godoc
+// @Summary Удалить команду
+// @Description Удаление отклоняется, пока у команды есть OPEN или DRAFT PR. Режим force=CLOSE закрывает их,
+// @Description force=REASSIGN переносит их в команду target_team_name, ревьюверы не из нее заменяются ее участниками.
+// @Description Завершенные PR сохраняются без команды.
+// @Tags Teams
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.DeleteTeamRequest true "Имя команды и режим обработки незавершенных PR"
+// @Success 200 {object} docs.DeleteTeamResponse "Команда удалена, закрытые и перенесенные PR, замены ревьюверов"
+// @Failure 400 {object} docs.ErrorResponse "Неверный режим или целевая команда"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Команда или целевая команда не найдены"
+// @Failure 409 {object} docs.ErrorResponse "У команды есть незавершенные PR или целевая команда в архиве"
+// @Router /team [delete]
+func (h *TeamHandlers) Delete(ctx *gin.Context) {
+	log := h.localLogger(ctx, "Delete")
+
+	var request docs.DeleteTeamRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	report, err := h.teamService.Delete(
+		ctx.Request.Context(),
+		request.Name,
+		teamEntity.DeleteMode(request.Force),
+		request.TargetTeam,
+	)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, teamErrors.ErrInvalidDeleteMode), errors.Is(err, teamErrors.ErrInvalidTargetTeam):
+			log.Warn().Err(err).Msg("invalid delete mode")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				err.Error(),
+			))
+
+		case errors.Is(err, teamErrors.ErrTeamNotFound):
+			log.Warn().Msg("team not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		case errors.Is(err, teamErrors.ErrTeamHasOpenPRs):
+			log.Warn().Msg("team has open pull requests")
+			ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
+				"TEAM_HAS_OPEN_PRS",
+				"team has open pull requests, use force to close or reassign them",
+			))
+
+		case errors.Is(err, teamErrors.ErrTeamArchived):
+			log.Warn().Msg("target team is archived")
+			ctx.AbortWithStatusJSON(http.StatusConflict, docs.NewErrorResponse(
+				"TEAM_ARCHIVED",
+				"target team is archived",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to delete team")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to delete team: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.ToDeleteTeamResponse(request.Name, report)
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().
+		Int("closed", len(report.Closed)).
+		Int("moved", len(report.Moved)).
+		Int("reassigned", len(report.Reassigned)).
+		Msg("successfully deleted team")
+}
+
+// Add godoc
+// @Summary Добавить участников в команду (создает неизвестных пользователей)
+// @Tags Teams
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.AddTeamMembersRequest true "Имя команды и добавляемые участники"
+// @Success 200 {object} docs.TeamMembersResponse "Команда после изменения"
+// @Failure 400 {object} docs.ErrorResponse "Пустой список участников"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Команда не найдена"
+// @Router /team/members/add [post]
+func (h *TeamHandlers) AddMembers(ctx *gin.Context) {
+	log := h.localLogger(ctx, "AddMembers")
+
+	var request docs.AddTeamMembersRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	membersEntities := make([]memberEntity.Member, 0, len(request.Members))
+
+	for _, member := range request.Members {
+		membersEntities = append(membersEntities, member.ToTeamMemberEntity())
+	}
+
+	team, err := h.teamService.AddMembers(ctx.Request.Context(), request.Name, membersEntities)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, teamErrors.ErrNoMembers):
+			log.Warn().Msg("empty members list")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				err.Error(),
+			))
+
+		case errors.Is(err, teamErrors.ErrTeamNotFound):
+			log.Warn().Msg("team not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to add members")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to add members: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.TeamMembersResponse{
+		Team: docs.ToGetTeamResponse(team),
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Int("count", len(membersEntities)).Msg("successfully added members")
+}
+
+// Add godoc
+// @Summary Удалить участников из команды
+// @Description Назначения удаляемых участников ревьюверами OPEN PR команды снимаются.
+// @Tags Teams
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.RemoveTeamMembersRequest true "Имя команды и id удаляемых участников"
+// @Success 200 {object} docs.TeamMembersResponse "Команда после изменения"
+// @Failure 400 {object} docs.ErrorResponse "Пустой список участников"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Команда не найдена или пользователь не состоит в ней"
+// @Router /team/members/remove [post]
+func (h *TeamHandlers) RemoveMembers(ctx *gin.Context) {
+	log := h.localLogger(ctx, "RemoveMembers")
+
+	var request docs.RemoveTeamMembersRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	team, err := h.teamService.RemoveMembers(ctx.Request.Context(), request.Name, request.UserIds)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, teamErrors.ErrNoMembers):
+			log.Warn().Msg("empty members list")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				err.Error(),
+			))
+
+		case errors.Is(err, teamErrors.ErrTeamNotFound):
+			log.Warn().Msg("team not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		case errors.Is(err, teamErrors.ErrMemberNotInTeam):
+			log.Warn().Msg("member is not in team")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				err.Error(),
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to remove members")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to remove members: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.TeamMembersResponse{
+		Team: docs.ToGetTeamResponse(team),
 	}
 
 	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Int("count", len(request.UserIds)).Msg("successfully removed members")
+}
+
+// Add godoc
+// @Summary Перевести участников в другую команду
+// @Description Участники удаляются из команды так же, как в /team/members/remove, и добавляются в target_team_name.
+// @Description Для тех, у кого исходная команда была основной, основной становится целевая.
+// @Tags Teams
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.MoveTeamMembersRequest true "Исходная и целевая команды, id переводимых участников"
+// @Success 200 {object} docs.MoveTeamMembersResponse "Обе команды после изменения"
+// @Failure 400 {object} docs.ErrorResponse "Пустой список участников или неверная целевая команда"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Команда не найдена или пользователь не состоит в исходной команде"
+// @Router /team/members/move [post]
+func (h *TeamHandlers) MoveMembers(ctx *gin.Context) {
+	log := h.localLogger(ctx, "MoveMembers")
+
+	var request docs.MoveTeamMembersRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	source, target, err := h.teamService.MoveMembers(
+		ctx.Request.Context(),
+		request.Name,
+		request.TargetTeam,
+		request.UserIds,
+	)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, teamErrors.ErrNoMembers) || errors.Is(err, teamErrors.ErrInvalidMoveTarget):
+			log.Warn().Msg("invalid move request")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				err.Error(),
+			))
+
+		case errors.Is(err, teamErrors.ErrTeamNotFound):
+			log.Warn().Msg("team not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		case errors.Is(err, teamErrors.ErrMemberNotInTeam):
+			log.Warn().Msg("member is not in team")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				err.Error(),
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to move members")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to move members: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.MoveTeamMembersResponse{
+		Team:       docs.ToGetTeamResponse(source),
+		TargetTeam: docs.ToGetTeamResponse(target),
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Int("count", len(request.UserIds)).Msg("successfully moved members")
 }
 
 func (h *TeamHandlers) localLogger(ctx *gin.Context, opName string) zerolog.Logger {
@@ -228,5 +820,13 @@ func InitTeamHandlers(r *gin.RouterGroup, log zerolog.Logger, teamService interf
 		group.POST("add", h.Add)
 		group.GET("get", auth.WithAuth(cfg), h.Get)
 		group.POST("deactivateAll", auth.WithAuth(cfg), h.DeactivateAll)
+		group.POST("codeowners", auth.WithAuth(cfg), h.SetCodeowners)
+		group.GET("codeowners", auth.WithAuth(cfg), h.GetCodeowners)
+		group.POST("rename", auth.WithAuth(cfg), h.Rename)
+		group.POST("archive", auth.WithAuth(cfg), h.Archive)
+		group.DELETE("", auth.WithAuth(cfg), h.Delete)
+		group.POST("members/add", auth.WithAuth(cfg), h.AddMembers)
+		group.POST("members/remove", auth.WithAuth(cfg), h.RemoveMembers)
+		group.POST("members/move", auth.WithAuth(cfg), h.MoveMembers)
 	}
 }
@@ -2,14 +2,18 @@ package teamhandlers_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	reviewerpicker "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/reviewer-picker"
 	teamservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/team"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
 	memberEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/entity"
+	prEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/entity"
 	teamEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/entity"
 	teamErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/errors"
 	teamMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/mocks"
@@ -26,8 +30,11 @@ func TestAdd(t *testing.T) {
 	type testCase struct {
 		what string
 
+		query        string
 		body         string
 		expectedTeam teamEntity.Team
+		dryRun       bool
+		repoDiff     teamEntity.UpsertDiff
 		repoError    error
 		expectedCode int
 		expectedBody string
@@ -42,6 +49,15 @@ func TestAdd(t *testing.T) {
 			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid body"}}`,
 		},
 
+		{
+			what: "invalid dry_run param",
+
+			query:        "?dry_run=maybe",
+			body:         `{"members": [], "team_name": "team1"}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid dry_run param"}}`,
+		},
+
 		{
 			what: "team exists",
 
@@ -81,7 +97,7 @@ func TestAdd(t *testing.T) {
 		},
 
 		{
-			what: "member of other team",
+			what: "failed to create team",
 
 			body: `{
 				"members": [
@@ -113,13 +129,14 @@ func TestAdd(t *testing.T) {
 					},
 				},
 			},
-			repoError:    teamErrors.ErrMemberOfOtherTeam,
-			expectedCode: http.StatusConflict,
-			expectedBody: `{"error":{"code":"MEMBER_OF_OTHER_TEAM","message":"User is member of other team"}}`,
+			repoError:    errors.New("db is down"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to create team: ` +
+				`failed to upsert team to repo: db is down"}}`,
 		},
 
 		{
-			what: "failed to create team",
+			what: "successfully created team",
 
 			body: `{
 				"members": [
@@ -151,26 +168,29 @@ func TestAdd(t *testing.T) {
 					},
 				},
 			},
-			repoError:    errors.New("db is down"),
-			expectedCode: http.StatusInternalServerError,
-			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to create team: ` +
-				`failed to upsert team to repo: db is down"}}`,
+			repoDiff: teamEntity.UpsertDiff{
+				Added:          []string{"u1", "u2"},
+				Removed:        []string{},
+				AlsoMemberOf:   []teamEntity.MemberOfOtherTeams{},
+				DroppedReviews: []teamEntity.DroppedReview{},
+			},
+			repoError:    nil,
+			expectedCode: http.StatusCreated,
+			expectedBody: `{"team":{"team_name":"team1","members":[{"user_id":"u1","username":"Bob","is_active":true},` +
+				`{"user_id":"u2","username":"Alice","is_active":true}]},` +
+				`"diff":{"added":["u1","u2"],"removed":[],"also_member_of":[],"dropped_reviews":[]}}`,
 		},
 
 		{
-			what: "successfully created team",
+			what: "dry run of roster update",
 
+			query: "?dry_run=true",
 			body: `{
 				"members": [
 					{
 						"is_active": true,
 						"user_id": "u1",
 						"username": "Bob"
-					},
-					{
-						"is_active": true,
-						"user_id": "u2",
-						"username": "Alice"
 					}
 				],
 				"team_name": "team1"
@@ -183,17 +203,23 @@ func TestAdd(t *testing.T) {
 						Username: "Bob",
 						Activity: memberEntity.MemberActive,
 					},
-					{
-						Id:       "u2",
-						Username: "Alice",
-						Activity: memberEntity.MemberActive,
-					},
 				},
 			},
-			repoError:    nil,
-			expectedCode: http.StatusCreated,
-			expectedBody: `{"team":{"team_name":"team1","members":[{"user_id":"u1","username":"Bob","is_active":true},` +
-				`{"user_id":"u2","username":"Alice","is_active":true}]}}`,
+			dryRun: true,
+			repoDiff: teamEntity.UpsertDiff{
+				Added:   []string{"u1"},
+				Removed: []string{"u2"},
+				AlsoMemberOf: []teamEntity.MemberOfOtherTeams{
+					{MemberId: "u1", Teams: []string{"team2"}},
+				},
+				DroppedReviews: []teamEntity.DroppedReview{
+					{PullRequestId: "pr1", ReviewerId: "u2"},
+				},
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"team":{"team_name":"team1","members":[{"user_id":"u1","username":"Bob","is_active":true}]},` +
+				`"diff":{"added":["u1"],"removed":["u2"],"also_member_of":[{"user_id":"u1","teams":["team2"]}],` +
+				`"dropped_reviews":[{"pull_request_id":"pr1","reviewer_id":"u2"}]},"dry_run":true}`,
 		},
 	}
 
@@ -208,9 +234,10 @@ func TestAdd(t *testing.T) {
 				gomock.Any(),
 				teamEntity.Matcher(tc.expectedTeam),
 				gomock.Any(),
-			).Return(tc.repoError).MaxTimes(1)
+				tc.dryRun,
+			).Return(tc.repoDiff, tc.repoError).MaxTimes(1)
 
-			teamService := teamservice.CreateTeamService(teamRepo)
+			teamService := teamservice.CreateTeamService(teamRepo, reviewerpicker.CreateRandomPicker(), nil, &config.PullRequestConfig{})
 
 			handlers := teamhandlers.CreateTeamHandlers(teamService, log)
 
@@ -219,7 +246,7 @@ func TestAdd(t *testing.T) {
 			router.POST("/", handlers.Add)
 
 			body := bytes.NewBufferString(tc.body)
-			req := httptest.NewRequest("POST", "/", body)
+			req := httptest.NewRequest("POST", "/"+tc.query, body)
 
 			recorder := httptest.NewRecorder()
 
@@ -296,6 +323,27 @@ func TestGet(t *testing.T) {
 			expectedBody: `{"team_name":"team1","members":[{"user_id":"u1","username":"Bob","is_active":true},` +
 				`{"user_id":"u2","username":"Alice","is_active":true}]}`,
 		},
+
+		{
+			what: "members with several teams",
+
+			teamName: "team1",
+			storedTeam: teamEntity.Team{
+				Name: "team1",
+				Members: []memberEntity.Member{
+					{
+						Id:       "u1",
+						Username: "Bob",
+						Activity: memberEntity.MemberActive,
+						Teams:    []string{"team1", "team2"},
+					},
+				},
+			},
+			repoError:    nil,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"team_name":"team1","members":[{"user_id":"u1","username":"Bob","is_active":true,` +
+				`"teams":["team1","team2"]}]}`,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -310,7 +358,7 @@ func TestGet(t *testing.T) {
 				tc.teamName,
 			).Return(tc.storedTeam, tc.repoError).MaxTimes(1)
 
-			teamService := teamservice.CreateTeamService(teamRepo)
+			teamService := teamservice.CreateTeamService(teamRepo, reviewerpicker.CreateRandomPicker(), nil, &config.PullRequestConfig{})
 
 			handlers := teamhandlers.CreateTeamHandlers(teamService, log)
 
@@ -338,6 +386,8 @@ func TestDeactivateAll(t *testing.T) {
 
 		body         string
 		teamName     string
+		keepActive   []string
+		report       teamEntity.DeactivationReport
 		repoError    error
 		expectedCode int
 		expectedBody string
@@ -355,7 +405,7 @@ func TestDeactivateAll(t *testing.T) {
 		},
 
 		{
-			what: "invalid body",
+			what: "team not found",
 
 			body: `{
 				"name": "team1"
@@ -380,15 +430,56 @@ func TestDeactivateAll(t *testing.T) {
 		},
 
 		{
-			what: "failed to deactivate members of team",
+			what: "latency budget exceeded",
 
 			body: `{
 				"name": "team1"
 			}`,
 			teamName:     "team1",
+			repoError:    context.DeadlineExceeded,
+			expectedCode: http.StatusServiceUnavailable,
+			expectedBody: `{"error":{"code":"TIMEOUT","message":"deactivation exceeded latency budget, no changes were made"}}`,
+		},
+
+		{
+			what: "successfully deactivate members of team",
+
+			body: `{
+				"name": "team1"
+			}`,
+			teamName:     "team1",
+			report:       teamEntity.DeactivationReport{Deactivated: 3},
 			repoError:    nil,
 			expectedCode: http.StatusOK,
-			expectedBody: `{"result":"ok"}`,
+			expectedBody: `{"result":"ok","deactivated":3,"reassigned":[],"short_of_reviewers":[]}`,
+		},
+
+		{
+			what: "deactivate with keep list and reassignment",
+
+			body: `{
+				"name": "team1",
+				"keep_active": ["u3"],
+				"reassign_reviews": true
+			}`,
+			teamName:   "team1",
+			keepActive: []string{"u3"},
+			report: teamEntity.DeactivationReport{
+				Deactivated: 2,
+				Reassigned: []prEntity.Reassignment{
+					{
+						PullRequestId: "pr1",
+						OldReviewerId: "u1",
+						NewReviewerId: "u3",
+					},
+				},
+				ShortOfReviewers: []string{"pr2"},
+			},
+			repoError:    nil,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"result":"ok","deactivated":2,` +
+				`"reassigned":[{"pull_request_id":"pr1","old_reviewer_id":"u1","new_reviewer_id":"u3"}],` +
+				`"short_of_reviewers":["pr2"]}`,
 		},
 	}
 
@@ -399,13 +490,14 @@ func TestDeactivateAll(t *testing.T) {
 
 			teamRepo := teamMocks.NewMockTeamRepo(ctrl)
 
-			teamRepo.EXPECT().SetActivityForAll(
+			teamRepo.EXPECT().DeactivateMembers(
 				gomock.Any(),
 				tc.teamName,
-				memberEntity.MemberInactive,
-			).Return(tc.repoError).MaxTimes(1)
+				tc.keepActive,
+				gomock.Any(),
+			).Return(tc.report, tc.repoError).MaxTimes(1)
 
-			teamService := teamservice.CreateTeamService(teamRepo)
+			teamService := teamservice.CreateTeamService(teamRepo, reviewerpicker.CreateRandomPicker(), nil, &config.PullRequestConfig{})
 
 			handlers := teamhandlers.CreateTeamHandlers(teamService, log)
 
@@ -425,3 +517,403 @@ func TestDeactivateAll(t *testing.T) {
 		})
 	}
 }
+
+func TestSetCodeowners(t *testing.T) {
+	log := logger.NewTest()
+
+	type testCase struct {
+		what string
+
+		body         string
+		callRepo     bool
+		repoError    error
+		expectedCode int
+		expectedBody string
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid body",
+
+			body:         "{",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid body"}}`,
+		},
+
+		{
+			what: "invalid rules",
+
+			body: `{
+				"team_name": "team1",
+				"rules": "*.go u1\n!vendor/ u2"
+			}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"INVALID_CODEOWNERS","message":"line 2: invalid codeowners ruleset: ` +
+				`negated pattern !vendor/ is not supported"}}`,
+		},
+
+		{
+			what: "team not found",
+
+			body: `{
+				"team_name": "team1",
+				"rules": "*.go u1"
+			}`,
+			callRepo:     true,
+			repoError:    teamErrors.ErrTeamNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
+		{
+			what: "successfully set codeowners",
+
+			body: `{
+				"team_name": "team1",
+				"rules": "# backend\n*.go @u1 u2\n/docs/ u3\n"
+			}`,
+			callRepo:     true,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"team_name":"team1","source":"api","rules":[` +
+				`{"pattern":"*.go","owners":["u1","u2"]},{"pattern":"/docs/","owners":["u3"]}]}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			teamRepo := teamMocks.NewMockTeamRepo(ctrl)
+
+			if tc.callRepo {
+				teamRepo.EXPECT().SetCodeowners(gomock.Any(), "team1", gomock.Any()).Return(tc.repoError)
+			}
+
+			teamService := teamservice.CreateTeamService(teamRepo, reviewerpicker.CreateRandomPicker(), nil, &config.PullRequestConfig{})
+
+			handlers := teamhandlers.CreateTeamHandlers(teamService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", handlers.SetCodeowners)
+
+			body := bytes.NewBufferString(tc.body)
+			req := httptest.NewRequest("POST", "/", body)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestArchive(t *testing.T) {
+	log := logger.NewTest()
+
+	type testCase struct {
+		what string
+
+		body             string
+		callRepo         bool
+		expectedArchived bool
+		repoError        error
+		expectedCode     int
+		expectedBody     string
+	}
+
+	testCases := []testCase{
+		{
+			what: "team not found",
+
+			body:             `{"team_name": "team1"}`,
+			callRepo:         true,
+			expectedArchived: true,
+			repoError:        teamErrors.ErrTeamNotFound,
+			expectedCode:     http.StatusNotFound,
+			expectedBody:     `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
+		{
+			what: "team is archived by default",
+
+			body:             `{"team_name": "team1"}`,
+			callRepo:         true,
+			expectedArchived: true,
+			expectedCode:     http.StatusOK,
+			expectedBody:     `{"team_name":"team1","archived":true}`,
+		},
+
+		{
+			what: "team is returned from archive",
+
+			body:             `{"team_name": "team1", "archived": false}`,
+			callRepo:         true,
+			expectedArchived: false,
+			expectedCode:     http.StatusOK,
+			expectedBody:     `{"team_name":"team1","archived":false}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			teamRepo := teamMocks.NewMockTeamRepo(ctrl)
+
+			if tc.callRepo {
+				teamRepo.EXPECT().SetArchived(gomock.Any(), "team1", tc.expectedArchived).Return(tc.repoError)
+			}
+
+			teamService := teamservice.CreateTeamService(teamRepo, reviewerpicker.CreateRandomPicker(), nil, &config.PullRequestConfig{})
+
+			handlers := teamhandlers.CreateTeamHandlers(teamService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", handlers.Archive)
+
+			body := bytes.NewBufferString(tc.body)
+			req := httptest.NewRequest("POST", "/", body)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	log := logger.NewTest()
+
+	type testCase struct {
+		what string
+
+		body         string
+		callRepo     bool
+		mode         teamEntity.DeleteMode
+		targetTeam   string
+		repoReport   teamEntity.DeletionReport
+		repoError    error
+		expectedCode int
+		expectedBody string
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid body",
+
+			body:         "{",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid body"}}`,
+		},
+
+		{
+			what: "unknown force mode",
+
+			body:         `{"team_name": "team1", "force": "DROP"}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"force must be CLOSE or REASSIGN"}}`,
+		},
+
+		{
+			what: "team has open pull requests",
+
+			body:         `{"team_name": "team1"}`,
+			callRepo:     true,
+			mode:         teamEntity.DeleteRefuse,
+			repoError:    teamErrors.ErrTeamHasOpenPRs,
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"error":{"code":"TEAM_HAS_OPEN_PRS",` +
+				`"message":"team has open pull requests, use force to close or reassign them"}}`,
+		},
+
+		{
+			what: "target team not found",
+
+			body:         `{"team_name": "team1", "force": "REASSIGN", "target_team_name": "team2"}`,
+			callRepo:     true,
+			mode:         teamEntity.DeleteReassign,
+			targetTeam:   "team2",
+			repoError:    teamErrors.ErrTeamNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
+		{
+			what: "successfully deleted with reassignment",
+
+			body:       `{"team_name": "team1", "force": "REASSIGN", "target_team_name": "team2"}`,
+			callRepo:   true,
+			mode:       teamEntity.DeleteReassign,
+			targetTeam: "team2",
+			repoReport: teamEntity.DeletionReport{
+				Closed: []string{},
+				Moved:  []string{"pr1", "pr2"},
+				Reassigned: []prEntity.Reassignment{
+					{PullRequestId: "pr1", OldReviewerId: "u1", NewReviewerId: "u3"},
+				},
+				ShortOfReviewers: []string{"pr2"},
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"team_name":"team1","closed":[],"moved":["pr1","pr2"],` +
+				`"reassigned":[{"pull_request_id":"pr1","old_reviewer_id":"u1","new_reviewer_id":"u3"}],` +
+				`"short_of_reviewers":["pr2"]}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			teamRepo := teamMocks.NewMockTeamRepo(ctrl)
+
+			if tc.callRepo {
+				teamRepo.EXPECT().Delete(gomock.Any(), "team1", tc.mode, tc.targetTeam, gomock.Any(), gomock.Any()).
+					Return(tc.repoReport, tc.repoError)
+			}
+
+			teamService := teamservice.CreateTeamService(teamRepo, reviewerpicker.CreateRandomPicker(), nil, &config.PullRequestConfig{})
+
+			handlers := teamhandlers.CreateTeamHandlers(teamService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.DELETE("/", handlers.Delete)
+
+			body := bytes.NewBufferString(tc.body)
+			req := httptest.NewRequest("DELETE", "/", body)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestMoveMembers(t *testing.T) {
+	log := logger.NewTest()
+
+	type testCase struct {
+		what string
+
+		body         string
+		callRepo     bool
+		memberIds    []string
+		repoSource   teamEntity.Team
+		repoTarget   teamEntity.Team
+		repoError    error
+		expectedCode int
+		expectedBody string
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid body",
+
+			body:         "{",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid body"}}`,
+		},
+
+		{
+			what: "move to same team",
+
+			body:         `{"team_name": "team1", "target_team_name": "team1", "user_ids": ["u1"]}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"target team must be set and differ from source team"}}`,
+		},
+
+		{
+			what: "member is not in team",
+
+			body:         `{"team_name": "team1", "target_team_name": "team2", "user_ids": ["u3"]}`,
+			callRepo:     true,
+			memberIds:    []string{"u3"},
+			repoError:    teamErrors.ErrMemberNotInTeam,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"error":{"code":"NOT_FOUND","message":"user is not member of team"}}`,
+		},
+
+		{
+			what: "failed to move members",
+
+			body:         `{"team_name": "team1", "target_team_name": "team2", "user_ids": ["u1"]}`,
+			callRepo:     true,
+			memberIds:    []string{"u1"},
+			repoError:    errors.New("db is down"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR",` +
+				`"message":"failed to move members: failed to move members in repo: db is down"}}`,
+		},
+
+		{
+			what: "successfully moved",
+
+			body:      `{"team_name": "team1", "target_team_name": "team2", "user_ids": ["u1"]}`,
+			callRepo:  true,
+			memberIds: []string{"u1"},
+			repoSource: teamEntity.Team{
+				Name: "team1",
+				Members: []memberEntity.Member{
+					{Id: "u2", Username: "user2", Activity: memberEntity.MemberActive, TeamName: "team1", Teams: []string{"team1"}},
+				},
+			},
+			repoTarget: teamEntity.Team{
+				Name: "team2",
+				Members: []memberEntity.Member{
+					{Id: "u1", Username: "user1", Activity: memberEntity.MemberActive, TeamName: "team2", Teams: []string{"team2"}},
+				},
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"team":{"team_name":"team1","members":[` +
+				`{"user_id":"u2","username":"user2","is_active":true,"teams":["team1"]}]},` +
+				`"target_team":{"team_name":"team2","members":[` +
+				`{"user_id":"u1","username":"user1","is_active":true,"teams":["team2"]}]}}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			teamRepo := teamMocks.NewMockTeamRepo(ctrl)
+
+			if tc.callRepo {
+				teamRepo.EXPECT().
+					MoveMembers(gomock.Any(), "team1", "team2", tc.memberIds).
+					Return(tc.repoSource, tc.repoTarget, tc.repoError)
+			}
+
+			teamService := teamservice.CreateTeamService(teamRepo, reviewerpicker.CreateRandomPicker(), nil, &config.PullRequestConfig{})
+
+			handlers := teamhandlers.CreateTeamHandlers(teamService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", handlers.MoveMembers)
+
+			body := bytes.NewBufferString(tc.body)
+			req := httptest.NewRequest("POST", "/", body)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package webhookhandlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/SmokingElk/avito-2025-autumn-intership/docs"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	eventEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/event/entity"
+	webhookEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+	webhookErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/errors"
+	webhookInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/interfaces"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/auth"
+	request_id "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/request-id"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+type WebhookHandlers struct {
+	webhookService webhookInterfaces.WebhookService
+	logger         zerolog.Logger
+}
+
+func CreateWebhookHandlers(webhookService webhookInterfaces.WebhookService, log zerolog.Logger) *WebhookHandlers {
+	return &WebhookHandlers{
+		webhookService: webhookService,
+		logger:         log,
+	}
+}
+
+// Add godoc
+// @Summary Зарегистрировать вебхук для доставки событий
+// @Description Тело запроса доставки подписывается HMAC-SHA256 от "<X-Webhook-Timestamp>.<тело>",
+// @Description подпись передается в заголовке X-Webhook-Signature в виде sha256=<hex>.
+// @Description Доставка выполняется не менее одного раза, получатель должен отбрасывать повторы по X-Webhook-Event-Id.
+// @Tags Webhooks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.RegisterWebhookRequest true "Адрес, секрет и типы событий"
+// @Success 201 {object} docs.RegisterWebhookResponse "Зарегистрированный вебхук и его секрет"
+// @Failure 400 {object} docs.ErrorResponse "Некорректный адрес или тип события"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Router /webhooks/register [post]
+func (h *WebhookHandlers) Register(ctx *gin.Context) {
+	log := h.localLogger(ctx, "Register")
+
+	var request docs.RegisterWebhookRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	eventTypes := make([]eventEntity.EventType, 0, len(request.EventTypes))
+
+	for _, eventType := range request.EventTypes {
+		eventTypes = append(eventTypes, eventEntity.EventType(eventType))
+	}
+
+	webhook, err := h.webhookService.Register(ctx.Request.Context(), request.Url, request.Secret, eventTypes)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, webhookErrors.ErrInvalidWebhook):
+			log.Warn().Msg("invalid webhook")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				"url must be absolute http(s) url and event types must be known",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to register webhook")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to register webhook: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, docs.RegisterWebhookResponse{
+		Webhook: docs.ToWebhookResponse(webhook),
+		Secret:  webhook.Secret,
+	})
+
+	log.Info().Str("webhookId", webhook.Id).Msg("successfully registered webhook")
+}
+
+// Add godoc
+// @Summary Получить зарегистрированные вебхуки
+// @Tags Webhooks
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} docs.ListWebhooksResponse "Вебхуки без секретов"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Router /webhooks/list [get]
+func (h *WebhookHandlers) List(ctx *gin.Context) {
+	log := h.localLogger(ctx, "List")
+
+	webhooks, err := h.webhookService.List(ctx.Request.Context())
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list webhooks")
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+			"INTERNAL_SERVER_ERROR",
+			fmt.Sprintf("failed to list webhooks: %s", err.Error()),
+		))
+		return
+	}
+
+	resp := docs.ListWebhooksResponse{
+		Webhooks: make([]docs.WebhookResponse, 0, len(webhooks)),
+	}
+
+	for _, webhook := range webhooks {
+		resp.Webhooks = append(resp.Webhooks, docs.ToWebhookResponse(webhook))
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Msg("successfully listed webhooks")
+}
+
+// Add godoc
+// @Summary Удалить вебхук вместе с его доставками
+// @Tags Webhooks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.DeleteWebhookRequest true "Идентификатор вебхука"
+// @Success 200 {object} docs.DeleteWebhookResponse "Вебхук удален"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Вебхук не найден"
+// @Router /webhooks/delete [post]
+func (h *WebhookHandlers) Delete(ctx *gin.Context) {
+	log := h.localLogger(ctx, "Delete")
+
+	var request docs.DeleteWebhookRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	if err := h.webhookService.Delete(ctx.Request.Context(), request.Id); err != nil {
+		switch {
+		case errors.Is(err, webhookErrors.ErrWebhookNotFound):
+			log.Warn().Msg("webhook not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to delete webhook")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to delete webhook: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, docs.DeleteWebhookResponse{Result: "ok"})
+
+	log.Info().Msg("successfully deleted webhook")
+}
+
+// Add godoc
+// @Summary Получить доставки событий вебхуку
+// @Tags Webhooks
+// @Security BearerAuth
+// @Produce json
+// @Param webhook_id query string true "Идентификатор вебхука"
+// @Param status query string false "PENDING, DELIVERED или DEAD, по умолчанию DEAD"
+// @Success 200 {object} docs.GetDeliveriesResponse "Последние доставки с указанным статусом"
+// @Failure 400 {object} docs.ErrorResponse "Некорректный статус"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Вебхук не найден"
+// @Router /webhooks/deliveries [get]
+func (h *WebhookHandlers) GetDeliveries(ctx *gin.Context) {
+	log := h.localLogger(ctx, "GetDeliveries")
+
+	webhookId := ctx.Query("webhook_id")
+	status := webhookEntity.DeliveryStatus(ctx.Query("status"))
+
+	deliveries, err := h.webhookService.GetDeliveries(ctx.Request.Context(), webhookId, status)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, webhookErrors.ErrInvalidStatus):
+			log.Warn().Msg("invalid delivery status")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				"status must be one of PENDING, DELIVERED, DEAD",
+			))
+
+		case errors.Is(err, webhookErrors.ErrWebhookNotFound):
+			log.Warn().Msg("webhook not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to get deliveries")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to get deliveries: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	resp := docs.GetDeliveriesResponse{
+		WebhookId:  webhookId,
+		Deliveries: make([]docs.DeliveryResponse, 0, len(deliveries)),
+	}
+
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, docs.ToDeliveryResponse(delivery))
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+
+	log.Info().Msg("successfully got deliveries")
+}
+
+// Add godoc
+// @Summary Повторно доставить события вебхуку
+// @Description Подходящие события ставятся в очередь заново, попытки доставки обнуляются.
+// @Description Нужно указать хотя бы один критерий: event_ids, since или dead_only.
+// @Tags Webhooks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param input body docs.ReplayRequest true "Вебхук и критерии отбора событий"
+// @Success 200 {object} docs.ReplayResponse "Число поставленных в очередь доставок"
+// @Failure 400 {object} docs.ErrorResponse "Не указан ни один критерий"
+// @Failure 401 {object} docs.ErrorResponse "Нет/неверный админский токен"
+// @Failure 404 {object} docs.ErrorResponse "Вебхук не найден"
+// @Router /webhooks/replay [post]
+func (h *WebhookHandlers) Replay(ctx *gin.Context) {
+	log := h.localLogger(ctx, "Replay")
+
+	var request docs.ReplayRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		log.Warn().Msg("invalid body")
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+			"BAD_REQUEST",
+			"invalid body",
+		))
+		return
+	}
+
+	filter := webhookEntity.ReplayFilter{
+		EventIds: request.EventIds,
+		Since:    request.Since,
+		DeadOnly: request.DeadOnly,
+	}
+
+	queued, err := h.webhookService.Replay(ctx.Request.Context(), request.WebhookId, filter)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, webhookErrors.ErrInvalidReplay):
+			log.Warn().Msg("empty replay filter")
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, docs.NewErrorResponse(
+				"BAD_REQUEST",
+				"one of event_ids, since or dead_only is required",
+			))
+
+		case errors.Is(err, webhookErrors.ErrWebhookNotFound):
+			log.Warn().Msg("webhook not found")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, docs.NewErrorResponse(
+				"NOT_FOUND",
+				"resource not found",
+			))
+
+		default:
+			log.Error().Err(err).Msg("failed to replay events")
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, docs.NewErrorResponse(
+				"INTERNAL_SERVER_ERROR",
+				fmt.Sprintf("failed to replay events: %s", err.Error()),
+			))
+		}
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, docs.ReplayResponse{Queued: queued})
+
+	log.Info().Int("queued", queued).Msg("successfully replayed events")
+}
+
+func (h *WebhookHandlers) localLogger(ctx *gin.Context, opName string) zerolog.Logger {
+	log := h.logger.With().
+		Str("op", opName).
+		Str("requestId", ctx.GetString(request_id.REQUEST_ID_PARAM)).
+		Logger()
+
+	return log
+}
+
+func InitWebhookHandlers(
+	r *gin.RouterGroup,
+	log zerolog.Logger,
+	webhookService webhookInterfaces.WebhookService,
+	cfg *config.RestConfig,
+) {
+	handlers := CreateWebhookHandlers(webhookService, log)
+
+	group := r.Group("webhooks")
+
+	{
+		group.POST("register", auth.WithAuth(cfg), handlers.Register)
+		group.GET("list", auth.WithAuth(cfg), handlers.List)
+		group.POST("delete", auth.WithAuth(cfg), handlers.Delete)
+		group.GET("deliveries", auth.WithAuth(cfg), handlers.GetDeliveries)
+		group.POST("replay", auth.WithAuth(cfg), handlers.Replay)
+	}
+}
@@ -0,0 +1,237 @@
+package webhookhandlers_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	webhookservice "github.com/SmokingElk/avito-2025-autumn-intership/internal/application/webhook"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	eventEntity "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/event/entity"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/entity"
+	webhookErrors "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/errors"
+	webhookMocks "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/mocks"
+	"github.com/SmokingElk/avito-2025-autumn-intership/internal/logger"
+	webhookhandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/webhook"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegister(t *testing.T) {
+	log := logger.NewTest()
+
+	config := config.WebhookConfig{
+		OutLimit: 10,
+	}
+
+	type testCase struct {
+		what string
+
+		body          string
+		callRepo      bool
+		repoError     error
+		expectedCode  int
+		expectedBody  string
+		expectedTypes []eventEntity.EventType
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid body",
+
+			body:         `{"url": 1}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid body"}}`,
+		},
+
+		{
+			what: "unknown event type",
+
+			body:         `{"url": "https://example.com/hook", "event_types": ["team.created"]}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST",` +
+				`"message":"url must be absolute http(s) url and event types must be known"}}`,
+		},
+
+		{
+			what: "failed to register webhook",
+
+			body:         `{"url": "https://example.com/hook", "secret": "s3cret"}`,
+			callRepo:     true,
+			repoError:    errors.New("db is down"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to register webhook: ` +
+				`failed to register webhook in repo: db is down"}}`,
+		},
+
+		{
+			what: "successfully registered",
+
+			body:          `{"url": "https://example.com/hook", "secret": "s3cret", "event_types": ["pull_request.merged"]}`,
+			callRepo:      true,
+			expectedCode:  http.StatusCreated,
+			expectedTypes: []eventEntity.EventType{eventEntity.EventPRMerged},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookRepo := webhookMocks.NewMockWebhookRepo(ctrl)
+
+			var registered entity.Webhook
+
+			if tc.callRepo {
+				mockWebhookRepo.EXPECT().Register(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ any, webhook entity.Webhook) error {
+						registered = webhook
+						return tc.repoError
+					},
+				)
+			}
+
+			webhookService := webhookservice.CreateWebhookService(mockWebhookRepo, &config)
+
+			handlers := webhookhandlers.CreateWebhookHandlers(webhookService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", handlers.Register)
+
+			body := bytes.NewBufferString(tc.body)
+			req := httptest.NewRequest("POST", "/", body)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+
+			if tc.expectedCode != http.StatusCreated {
+				assert.Equal(t, tc.expectedBody, recorder.Body.String())
+				return
+			}
+
+			assert.Equal(t, tc.expectedTypes, registered.EventTypes)
+
+			expectedBody := fmt.Sprintf(
+				`{"webhook":{"webhook_id":"%s","url":"https://example.com/hook",`+
+					`"event_types":["pull_request.merged"],"created_at":"%s"},"secret":"s3cret"}`,
+				registered.Id,
+				registered.CreatedAt.Format(time.RFC3339Nano),
+			)
+
+			assert.Equal(t, expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestReplay(t *testing.T) {
+	log := logger.NewTest()
+
+	config := config.WebhookConfig{
+		OutLimit: 10,
+	}
+
+	type testCase struct {
+		what string
+
+		body           string
+		callRepo       bool
+		expectedFilter entity.ReplayFilter
+		repoQueued     int
+		repoError      error
+		expectedCode   int
+		expectedBody   string
+	}
+
+	testCases := []testCase{
+		{
+			what: "invalid body",
+
+			body:         `{"webhook_id": "w1", "dead_only": "yes"}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"invalid body"}}`,
+		},
+
+		{
+			what: "empty filter",
+
+			body:         `{"webhook_id": "w1"}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"error":{"code":"BAD_REQUEST","message":"one of event_ids, since or dead_only is required"}}`,
+		},
+
+		{
+			what: "webhook not found",
+
+			body:           `{"webhook_id": "w1", "dead_only": true}`,
+			callRepo:       true,
+			expectedFilter: entity.ReplayFilter{DeadOnly: true},
+			repoError:      webhookErrors.ErrWebhookNotFound,
+			expectedCode:   http.StatusNotFound,
+			expectedBody:   `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`,
+		},
+
+		{
+			what: "failed to replay events",
+
+			body:           `{"webhook_id": "w1", "event_ids": ["e1"]}`,
+			callRepo:       true,
+			expectedFilter: entity.ReplayFilter{EventIds: []string{"e1"}},
+			repoError:      errors.New("db is down"),
+			expectedCode:   http.StatusInternalServerError,
+			expectedBody: `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"failed to replay events: ` +
+				`failed to replay events in repo: db is down"}}`,
+		},
+
+		{
+			what: "successfully replayed",
+
+			body:           `{"webhook_id": "w1", "event_ids": ["e1", "e2"], "dead_only": true}`,
+			callRepo:       true,
+			expectedFilter: entity.ReplayFilter{EventIds: []string{"e1", "e2"}, DeadOnly: true},
+			repoQueued:     2,
+			expectedCode:   http.StatusOK,
+			expectedBody:   `{"queued":2}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d: %s", i, tc.what), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookRepo := webhookMocks.NewMockWebhookRepo(ctrl)
+
+			if tc.callRepo {
+				mockWebhookRepo.EXPECT().Replay(gomock.Any(), "w1", tc.expectedFilter).Return(tc.repoQueued, tc.repoError)
+			}
+
+			webhookService := webhookservice.CreateWebhookService(mockWebhookRepo, &config)
+
+			handlers := webhookhandlers.CreateWebhookHandlers(webhookService, log)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/", handlers.Replay)
+
+			body := bytes.NewBufferString(tc.body)
+			req := httptest.NewRequest("POST", "/", body)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
@@ -2,14 +2,24 @@ package rest
 
 import (
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/config"
+	integrationInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/integration/interfaces"
 	memberInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/member/interfaces"
+	poolInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pool/interfaces"
 	pullRequestInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/pull-request/interfaces"
+	reviewStreamInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/review-stream/interfaces"
+	scimInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/scim/interfaces"
 	statsInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/statistics/interfaces"
 	teamInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/team/interfaces"
+	webhookInterfaces "github.com/SmokingElk/avito-2025-autumn-intership/internal/domain/webhook/interfaces"
+	integrationhandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/integration"
 	memberhandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/member"
+	poolhandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/pool"
 	pullrequesthandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/pull-request"
+	reviewstreamhandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/review-stream"
+	scimhandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/scim"
 	statshandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/statistics"
 	teamhandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/team"
+	webhookhandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/handlers/webhook"
 	healthhandlers "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/health"
 	"github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/cors"
 	ginlogger "github.com/SmokingElk/avito-2025-autumn-intership/internal/presentation/rest/gin/middleware/gin-logger"
@@ -42,6 +52,13 @@ func InitRoutes(
 	teamService teamInterfaces.TeamService,
 	pullRequestService pullRequestInterfaces.PullRequestService,
 	statsService statsInterfaces.StatsService,
+	webhookService webhookInterfaces.WebhookService,
+	reviewStreamService reviewStreamInterfaces.ReviewStreamService,
+	githubIngestService integrationInterfaces.IngestService,
+	gitlabIngestService integrationInterfaces.IngestService,
+	integrationsCfg *config.IntegrationsConfig,
+	poolService poolInterfaces.PoolService,
+	scimService scimInterfaces.ScimService,
 ) {
 	r.Use(ginlogger.SkipLogger(cfg))
 	r.Use(gin.Recovery())
@@ -57,5 +74,10 @@ func InitRoutes(
 	teamhandlers.InitTeamHandlers(api, log, teamService, cfg)
 	pullrequesthandlers.InitPullRequestHandlers(api, log, pullRequestService, cfg)
 	statshandlers.InitStatsHandlers(api, statsService)
+	webhookhandlers.InitWebhookHandlers(api, log, webhookService, cfg)
+	reviewstreamhandlers.InitReviewStreamHandlers(api, log, reviewStreamService, cfg)
+	integrationhandlers.InitIntegrationHandlers(api, log, githubIngestService, gitlabIngestService, integrationsCfg)
+	poolhandlers.InitPoolHandlers(api, log, poolService, cfg)
+	scimhandlers.InitScimHandlers(api, log, scimService, cfg)
 	healthhandlers.InitHealthHandlers(api)
 }
@@ -25,6 +25,13 @@ VALUES
     ('u22', 'Frank Sinatra', 'INACTIVE', 'team3'),
     ('u23', 'Ella Fitzgerald', 'INACTIVE', 'team3');
 
+INSERT INTO team_membership(team_id, member_id)
+SELECT team_id, id FROM team_member;
+
+-- member of two teams, Backend stays the primary one
+INSERT INTO team_membership(team_id, member_id)
+VALUES ('team3', 'u07');
+
 INSERT INTO pull_request(id, pr_name, author_id, pr_status, team_id, created_at, merged_at)
 VALUES
     ('pr1', 'Added user authentication middleware', 'u01', 'MERGED', 'team1', '2024-01-15 10:00:00', '2024-01-16 14:30:00'),
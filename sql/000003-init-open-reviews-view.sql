@@ -0,0 +1,11 @@
+-- current load of reviewers, unlike assignments_per_members counts only OPEN pull requests
+CREATE VIEW open_reviews_per_members AS
+SELECT a.member_id, COUNT(a.pr_id) AS open_reviews
+FROM assigned_reviewer AS a
+INNER JOIN pull_request AS pr
+    ON pr.id = a.pr_id
+WHERE pr.pr_status = 'OPEN'
+GROUP BY a.member_id;
+
+-- the view is read on every assignment
+CREATE INDEX IF NOT EXISTS idx_pr_status ON pull_request(pr_status);
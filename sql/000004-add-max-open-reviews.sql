@@ -0,0 +1,2 @@
+-- limit of OPEN reviews for member, NULL means no limit
+ALTER TABLE team_member ADD COLUMN IF NOT EXISTS max_open_reviews INTEGER CHECK (max_open_reviews >= 0);
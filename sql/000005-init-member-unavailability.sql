@@ -0,0 +1,20 @@
+-- scheduled ranges, when member can not review (vacation, sick leave, on-call)
+CREATE TABLE IF NOT EXISTS member_unavailability (
+    id        VARCHAR(36) PRIMARY KEY,
+    member_id VARCHAR(36) REFERENCES team_member(id) ON DELETE CASCADE NOT NULL,
+    kind      VARCHAR(16) NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at   TIMESTAMPTZ NOT NULL,
+    -- set by background job, when open reviews of member are reassigned after range start
+    reviews_reassigned BOOLEAN NOT NULL DEFAULT FALSE,
+
+    CHECK (starts_at < ends_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_unavailability_member_id ON member_unavailability(member_id);
+
+-- members inside an active range, assignment treats them as inactive
+CREATE VIEW unavailable_members AS
+SELECT DISTINCT member_id
+FROM member_unavailability
+WHERE starts_at <= NOW() AND NOW() < ends_at;
@@ -0,0 +1,3 @@
+-- last verdict of reviewer, NULL while review is pending
+ALTER TABLE assigned_reviewer ADD COLUMN IF NOT EXISTS verdict VARCHAR(32);
+ALTER TABLE assigned_reviewer ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMP WITHOUT TIME ZONE;
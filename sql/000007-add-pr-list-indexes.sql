@@ -0,0 +1,5 @@
+-- keyset pagination of PRs list
+CREATE INDEX IF NOT EXISTS idx_pr_created_at_id ON pull_request(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_pr_name_id ON pull_request(pr_name, id);
+CREATE INDEX IF NOT EXISTS idx_pr_author_id ON pull_request(author_id);
+CREATE INDEX IF NOT EXISTS idx_pr_team_id ON pull_request(team_id);
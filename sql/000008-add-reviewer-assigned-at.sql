@@ -0,0 +1,12 @@
+-- time of assignment of reviewer, existing reviewers are considered assigned on pr creation
+ALTER TABLE assigned_reviewer ADD COLUMN IF NOT EXISTS assigned_at TIMESTAMP WITHOUT TIME ZONE;
+
+UPDATE assigned_reviewer AS a
+SET assigned_at = pr.created_at
+FROM pull_request AS pr
+WHERE pr.id = a.pr_id AND a.assigned_at IS NULL;
+
+ALTER TABLE assigned_reviewer ALTER COLUMN assigned_at SET DEFAULT NOW();
+ALTER TABLE assigned_reviewer ALTER COLUMN assigned_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_assigned_reviewer_member_id ON assigned_reviewer(member_id);
@@ -0,0 +1,36 @@
+-- domain events, written in the transaction of the change itself
+CREATE TABLE IF NOT EXISTS outbox (
+    id         VARCHAR(36) PRIMARY KEY,
+    event_type VARCHAR(64) NOT NULL,
+    payload    JSONB NOT NULL,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_created_at ON outbox(created_at);
+
+CREATE TABLE IF NOT EXISTS webhook (
+    id          VARCHAR(36) PRIMARY KEY,
+    url         VARCHAR(2048) NOT NULL,
+    secret      VARCHAR(256) NOT NULL,
+    -- empty array subscribes webhook to every event type
+    event_types VARCHAR(64)[] NOT NULL DEFAULT '{}',
+    created_at  TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- delivery of event to webhook, rows are created together with event for every subscribed webhook
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    event_id        VARCHAR(36) REFERENCES outbox(id) ON DELETE CASCADE,
+    webhook_id      VARCHAR(36) REFERENCES webhook(id) ON DELETE CASCADE,
+    -- PENDING, DELIVERED or DEAD
+    status          VARCHAR(16) NOT NULL DEFAULT 'PENDING',
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    last_error      TEXT,
+    delivered_at    TIMESTAMP WITHOUT TIME ZONE,
+
+    PRIMARY KEY (event_id, webhook_id)
+);
+
+-- dispatcher scans only pending deliveries
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_due ON webhook_delivery(next_attempt_at) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_webhook ON webhook_delivery(webhook_id, status);
@@ -0,0 +1,13 @@
+-- per reviewer feed of assignment changes, seq is used as SSE event id to replay missed events
+CREATE TABLE IF NOT EXISTS review_event (
+    seq         BIGSERIAL PRIMARY KEY,
+    reviewer_id VARCHAR(36) NOT NULL,
+    -- assigned, unassigned or merged
+    event_type  VARCHAR(16) NOT NULL,
+    pr_id       VARCHAR(36) NOT NULL,
+    pr_name     VARCHAR(128) NOT NULL,
+    author_id   VARCHAR(36) NOT NULL,
+    created_at  TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_event_reviewer ON review_event(reviewer_id, seq);
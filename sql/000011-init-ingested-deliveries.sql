@@ -0,0 +1,8 @@
+-- deliveries of git hosting webhooks, which were ingested, redelivered ones are skipped
+CREATE TABLE IF NOT EXISTS ingested_delivery (
+    -- github or gitlab
+    source      VARCHAR(16) NOT NULL,
+    delivery_id VARCHAR(128) NOT NULL,
+    created_at  TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (source, delivery_id)
+);
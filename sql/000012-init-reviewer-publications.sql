@@ -0,0 +1,27 @@
+-- code host pr, from which pull request was ingested
+CREATE TABLE IF NOT EXISTS pr_host_link (
+    pr_id      VARCHAR(36) PRIMARY KEY,
+    -- github or gitlab
+    source     VARCHAR(16) NOT NULL,
+    -- owner/name for github, project id for gitlab
+    repository VARCHAR(256) NOT NULL,
+    -- pr number for github, mr iid for gitlab
+    number     BIGINT NOT NULL
+);
+
+-- reviewer changes to push to code host, rows are written in the transaction of the change itself
+CREATE TABLE IF NOT EXISTS reviewer_publication (
+    id              BIGSERIAL PRIMARY KEY,
+    pr_id           VARCHAR(36) NOT NULL,
+    added           VARCHAR(36)[] NOT NULL DEFAULT '{}',
+    removed         VARCHAR(36)[] NOT NULL DEFAULT '{}',
+    -- PENDING, PUBLISHED or DEAD
+    status          VARCHAR(16) NOT NULL DEFAULT 'PENDING',
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    last_error      TEXT,
+    published_at    TIMESTAMP WITHOUT TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviewer_publication_due ON reviewer_publication(next_attempt_at) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_reviewer_publication_pr ON reviewer_publication(pr_id, id) WHERE status = 'PENDING';
@@ -0,0 +1,6 @@
+-- CODEOWNERS-style rules of team uploaded through api, they take precedence over ones from config
+CREATE TABLE IF NOT EXISTS team_codeowners (
+    team_id    VARCHAR(36) PRIMARY KEY REFERENCES team(id) ON DELETE CASCADE,
+    rules      TEXT NOT NULL,
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
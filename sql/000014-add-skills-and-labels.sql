@@ -0,0 +1,26 @@
+-- free-form skill tags of member, matched against labels of pull requests on assignment
+CREATE TABLE IF NOT EXISTS member_skill (
+    member_id VARCHAR(36) REFERENCES team_member(id) ON DELETE CASCADE,
+    skill     VARCHAR(64),
+
+    PRIMARY KEY (member_id, skill)
+);
+
+ALTER TABLE pull_request ADD COLUMN IF NOT EXISTS labels VARCHAR(64)[] NOT NULL DEFAULT '{}';
+
+-- new columns of view can be only appended, so labels go last
+CREATE OR REPLACE VIEW pr_with_members AS 
+SELECT
+    pr.id,
+    pr.pr_name,
+    pr.author_id,
+    pr.pr_status,
+    pr.created_at,
+    pr.merged_at,
+    pr.team_id,
+    ARRAY_AGG(a.member_id) FILTER (WHERE a.member_id IS NOT NULL) AS reviewers,
+    pr.labels
+FROM pull_request AS pr
+LEFT JOIN assigned_reviewer AS a
+    ON pr.id = a.pr_id
+GROUP BY pr.id;
\ No newline at end of file
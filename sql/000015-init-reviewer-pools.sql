@@ -0,0 +1,21 @@
+-- named sets of reviewers, members of pool can belong to any team
+CREATE TABLE IF NOT EXISTS reviewer_pool (
+    id        VARCHAR(36) PRIMARY KEY,
+    pool_name VARCHAR(64) UNIQUE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS reviewer_pool_member (
+    pool_id   VARCHAR(36) REFERENCES reviewer_pool(id) ON DELETE CASCADE,
+    member_id VARCHAR(36) REFERENCES team_member(id) ON DELETE CASCADE,
+
+    PRIMARY KEY (pool_id, member_id)
+);
+
+-- team draws reviewers_count reviewers from pool in addition to its own ones
+CREATE TABLE IF NOT EXISTS team_pool (
+    team_id         VARCHAR(36) REFERENCES team(id) ON DELETE CASCADE,
+    pool_id         VARCHAR(36) REFERENCES reviewer_pool(id) ON DELETE CASCADE,
+    reviewers_count INTEGER NOT NULL CHECK (reviewers_count > 0),
+
+    PRIMARY KEY (team_id, pool_id)
+);
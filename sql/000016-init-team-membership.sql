@@ -0,0 +1,15 @@
+-- member can belong to several teams, team_id of team_member is kept as primary team of member,
+-- which is used for pull requests created without team
+CREATE TABLE IF NOT EXISTS team_membership (
+    team_id   VARCHAR(36) REFERENCES team(id) ON DELETE CASCADE,
+    member_id VARCHAR(36) REFERENCES team_member(id) ON DELETE CASCADE,
+
+    PRIMARY KEY (team_id, member_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_membership_member_id ON team_membership(member_id);
+
+-- existing single team members become members of their primary team
+INSERT INTO team_membership(team_id, member_id)
+SELECT team_id, id FROM team_member WHERE team_id IS NOT NULL
+ON CONFLICT DO NOTHING;
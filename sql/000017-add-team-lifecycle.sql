@@ -0,0 +1,25 @@
+-- archived team keeps its members and history, but new pull requests can not be created in it
+ALTER TABLE team ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP WITHOUT TIME ZONE;
+
+-- pull requests outlive deleted team, their team becomes unknown
+ALTER TABLE pull_request ALTER COLUMN team_id DROP NOT NULL;
+ALTER TABLE pull_request DROP CONSTRAINT IF EXISTS pull_request_team_id_fkey;
+ALTER TABLE pull_request
+    ADD CONSTRAINT pull_request_team_id_fkey FOREIGN KEY (team_id) REFERENCES team(id) ON DELETE SET NULL;
+
+-- pull requests of deleted team are read with empty team id
+CREATE OR REPLACE VIEW pr_with_members AS 
+SELECT
+    pr.id,
+    pr.pr_name,
+    pr.author_id,
+    pr.pr_status,
+    pr.created_at,
+    pr.merged_at,
+    COALESCE(pr.team_id, '')::VARCHAR(36) AS team_id,
+    ARRAY_AGG(a.member_id) FILTER (WHERE a.member_id IS NOT NULL) AS reviewers,
+    pr.labels
+FROM pull_request AS pr
+LEFT JOIN assigned_reviewer AS a
+    ON pr.id = a.pr_id
+GROUP BY pr.id;